@@ -0,0 +1,88 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/rs/xid"
+
+	"pepo/internal/auth"
+	"pepo/internal/config"
+	"pepo/internal/database"
+	"pepo/internal/db"
+	"pepo/internal/logging"
+
+	"go.uber.org/zap"
+)
+
+// createmanager adds a manager account that can sign in to the web interface.
+// The password is read from PEPO_MANAGER_PASSWORD, or from the first line of stdin.
+func main() {
+	email := flag.String("email", "", "email address used to sign in")
+	name := flag.String("name", "", "display name")
+	flag.Parse()
+
+	logger, err := logging.Init()
+	if err != nil {
+		panic(err)
+	}
+	defer logger.Sync()
+
+	if strings.TrimSpace(*email) == "" || strings.TrimSpace(*name) == "" {
+		fmt.Fprintln(os.Stderr, "usage: createmanager -email <email> -name <name>")
+		os.Exit(2)
+	}
+
+	password, err := readPassword()
+	if err != nil {
+		zap.L().Fatal("failed to read password", zap.Error(err))
+	}
+	if len(password) < 8 {
+		zap.L().Fatal("password must be at least 8 characters")
+	}
+
+	hash, err := auth.HashPassword(password)
+	if err != nil {
+		zap.L().Fatal("failed to hash password", zap.Error(err))
+	}
+
+	cfg := config.Load()
+	dbConn, queries, err := database.Initialize(cfg.DatabaseURL, database.DefaultConnectionConfig())
+	if err != nil {
+		zap.L().Fatal("failed to initialize database", zap.Error(err))
+	}
+	defer func() {
+		if err := database.Close(dbConn); err != nil {
+			zap.L().Error("error closing database", zap.Error(err))
+		}
+	}()
+
+	row, err := queries.CreateManager(context.Background(), db.CreateManagerParams{
+		ID:           xid.New().String(),
+		Email:        strings.TrimSpace(*email),
+		Name:         strings.TrimSpace(*name),
+		PasswordHash: hash,
+	})
+	if err != nil {
+		zap.L().Fatal("failed to create manager", zap.Error(err))
+	}
+
+	fmt.Printf("created manager %s (%s)\n", row.Manager.Email, row.Manager.ID.String())
+}
+
+func readPassword() (string, error) {
+	if password := os.Getenv("PEPO_MANAGER_PASSWORD"); password != "" {
+		return password, nil
+	}
+
+	fmt.Fprint(os.Stderr, "Password: ")
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil && line == "" {
+		return "", err
+	}
+	return strings.TrimRight(line, "\r\n"), nil
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"pepo/internal/config"
+	"pepo/internal/database"
+	"pepo/internal/framework"
+	"pepo/internal/logging"
+
+	"go.uber.org/zap"
+)
+
+// importframework loads a YAML or JSON competency framework into a manager's
+// framework themes and career ladder. Re-running it with an edited file updates
+// descriptions and adds new competencies and levels; nothing is removed.
+func main() {
+	email := flag.String("email", "", "email address of the manager who owns the framework")
+	file := flag.String("file", "", "path to the YAML or JSON framework file")
+	flag.Parse()
+
+	logger, err := logging.Init()
+	if err != nil {
+		panic(err)
+	}
+	defer logger.Sync()
+
+	if strings.TrimSpace(*email) == "" || strings.TrimSpace(*file) == "" {
+		fmt.Fprintln(os.Stderr, "usage: importframework -email <email> -file <framework.yaml>")
+		os.Exit(2)
+	}
+
+	data, err := os.ReadFile(*file)
+	if err != nil {
+		zap.L().Fatal("failed to read framework file", zap.Error(err))
+	}
+	f, err := framework.Parse(data)
+	if err != nil {
+		zap.L().Fatal("failed to parse framework file", zap.Error(err))
+	}
+
+	cfg := config.Load()
+	dbConn, queries, err := database.Initialize(cfg.DatabaseURL, database.DefaultConnectionConfig())
+	if err != nil {
+		zap.L().Fatal("failed to initialize database", zap.Error(err))
+	}
+	defer func() {
+		if err := database.Close(dbConn); err != nil {
+			zap.L().Error("error closing database", zap.Error(err))
+		}
+	}()
+
+	ctx := context.Background()
+	manager, err := queries.GetManagerByEmail(ctx, strings.TrimSpace(*email))
+	if err != nil {
+		zap.L().Fatal("failed to find manager", zap.Error(err))
+	}
+
+	n, err := framework.Import(ctx, dbConn, queries, manager.Manager.ID.String(), f)
+	if err != nil {
+		zap.L().Fatal("failed to import framework", zap.Error(err))
+	}
+
+	fmt.Printf("imported %d competencies for %s\n", n, manager.Manager.Email)
+}
@@ -3,11 +3,14 @@ package main
 import (
 	"context"
 	"fmt"
+	"os"
 	"time"
 
 	mcp "github.com/mark3labs/mcp-go/mcp"
 	mcpserver "github.com/mark3labs/mcp-go/server"
 
+	"pepo/internal/audit"
+	"pepo/internal/auth"
 	"pepo/internal/config"
 	"pepo/internal/database"
 	"pepo/internal/db"
@@ -23,14 +26,21 @@ type ListActionsRequest struct {
 }
 
 type Action struct {
-	ID          string    `json:"id"`
-	PersonID    string    `json:"person_id"`
-	OccurredAt  time.Time `json:"occurred_at"`
-	Description string    `json:"description"`
-	References  string    `json:"references,omitempty"`
-	Valence     string    `json:"valence"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          string      `json:"id"`
+	PersonID    string      `json:"person_id"`
+	OccurredAt  time.Time   `json:"occurred_at"`
+	Description string      `json:"description"`
+	References  []Reference `json:"references,omitempty"`
+	Valence     string      `json:"valence" jsonschema_description:"Whether the action reflects well (positive) or badly (negative) on the person, or is neutral: worth recording but neither" jsonschema:"enum=positive,enum=negative,enum=neutral"`
+	Impact      string      `json:"impact,omitempty" jsonschema_description:"How much the action mattered; left out when not rated" jsonschema:"enum=low,enum=medium,enum=high"`
+	CreatedAt   time.Time   `json:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at"`
+}
+
+type Reference struct {
+	Kind  string `json:"kind"`
+	Value string `json:"value"`
+	Title string `json:"title,omitempty"`
 }
 
 func main() {
@@ -52,20 +62,59 @@ func main() {
 		}
 	}()
 
+	// Tools act as the manager who issued PEPO_API_TOKEN. The token is checked again
+	// on every call so revoking or expiring it takes effect without a restart.
+	token := os.Getenv("PEPO_API_TOKEN")
+	if token == "" {
+		zap.L().Fatal("PEPO_API_TOKEN is required")
+	}
+	tokens := auth.NewTokens(queries)
+	owner, _, ok := tokens.Lookup(context.Background(), token)
+	if !ok {
+		zap.L().Fatal("PEPO_API_TOKEN is not a valid API token")
+	}
+
+	// Every tool call goes in the audit log under the token's owner, with the
+	// ids found in its arguments
+	auditLog := audit.NewLog(queries)
+	auditTools := func(next mcpserver.ToolHandlerFunc) mcpserver.ToolHandlerFunc {
+		return func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			result, err := next(ctx, request)
+			outcome := "ok"
+			if err != nil || (result != nil && result.IsError) {
+				outcome = "error"
+			}
+			auditLog.Record(ctx, audit.Entry{
+				ManagerID: owner.ID,
+				Operation: request.Params.Name,
+				EntityIDs: audit.EntityIDs(request.GetArguments()),
+				Via:       audit.ViaMCP,
+				Outcome:   outcome,
+			})
+			return result, err
+		}
+	}
+
 	s := mcpserver.NewMCPServer(
 		"Pepo MCP Server",
 		"1.0.0",
 		mcpserver.WithToolCapabilities(false),
+		mcpserver.WithToolHandlerMiddleware(auditTools),
 	)
 
 	listTool := mcp.NewTool(
 		"list_actions_by_person",
-		mcp.WithDescription("List actions for a specific person"),
+		mcp.WithDescription("List actions for a specific person, newest first. Each action has a valence of positive, negative or neutral, and an optional impact of low, medium or high."),
 		mcp.WithInputSchema[ListActionsRequest](),
 		mcp.WithOutputSchema[[]Action](),
 	)
 
 	s.AddTool(listTool, mcp.NewStructuredToolHandler(func(ctx context.Context, request mcp.CallToolRequest, args ListActionsRequest) ([]Action, error) {
+		manager, _, ok := tokens.Lookup(ctx, token)
+		if !ok {
+			return nil, fmt.Errorf("API token is no longer valid")
+		}
+
 		limit := args.Limit
 		if limit <= 0 {
 			limit = 10
@@ -76,14 +125,32 @@ func main() {
 		}
 
 		rows, err := queries.ListActionsByPersonID(ctx, db.ListActionsByPersonIDParams{
-			PersonID: args.PersonID,
-			Offset:   int32(offset),
-			Limit:    int32(limit),
+			PersonID:  args.PersonID,
+			ManagerID: manager.ID,
+			Offset:    int32(offset),
+			Limit:     int32(limit),
 		})
 		if err != nil {
 			return nil, fmt.Errorf("failed to list actions: %w", err)
 		}
 
+		ids := make([]string, len(rows))
+		for i, row := range rows {
+			ids[i] = row.Action.ID.String()
+		}
+		refRows, err := queries.ListReferencesByActionIDs(ctx, db.ListReferencesByActionIDsParams{
+			ActionIds: ids,
+			ManagerID: manager.ID,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list references: %w", err)
+		}
+		refs := map[string][]Reference{}
+		for _, r := range refRows {
+			ref := Reference{Kind: string(r.Kind), Value: r.Value, Title: r.Title.String}
+			refs[r.ActionID] = append(refs[r.ActionID], ref)
+		}
+
 		actions := make([]Action, 0, len(rows))
 		for _, row := range rows {
 			a := row.Action
@@ -92,12 +159,13 @@ func main() {
 				PersonID:    a.PersonID.String(),
 				OccurredAt:  a.OccurredAt,
 				Description: a.Description,
+				References:  refs[a.ID.String()],
 				Valence:     string(a.Valence),
 				CreatedAt:   a.CreatedAt,
 				UpdatedAt:   a.UpdatedAt,
 			}
-			if a.References.Valid {
-				action.References = a.References.String
+			if a.Impact.Valid {
+				action.Impact = string(a.Impact.ImpactLevel)
 			}
 			actions = append(actions, action)
 		}
@@ -1,6 +1,9 @@
 package main
 
 import (
+	"pepo/internal/audit"
+	"pepo/internal/auth"
+	"pepo/internal/blobstore"
 	"pepo/internal/config"
 	"pepo/internal/database"
 	"pepo/internal/handlers"
@@ -35,14 +38,32 @@ func main() {
 		}
 	}()
 
+	blobs, err := blobstore.NewLocal(cfg.AttachmentsDir)
+	if err != nil {
+		zap.L().Fatal("failed to initialize attachment storage", zap.Error(err))
+	}
+
 	zap.L().Info("initializing application handlers")
 	personHandler := handlers.NewPersonHandler(queries)
-	actionHandler := handlers.NewActionHandler(queries)
-	conversationHandler := handlers.NewConversationHandler(queries)
-	combinedAPIHandler := handlers.NewCombinedAPIHandler(personHandler, actionHandler, conversationHandler)
+	actionHandler := handlers.NewActionHandler(db, queries)
+	conversationHandler := handlers.NewConversationHandler(db, queries)
+	followUpHandler := handlers.NewFollowUpHandler(queries)
+	themeHandler := handlers.NewThemeHandler(db, queries)
+	ladderHandler := handlers.NewLadderHandler(queries)
+	searchHandler := handlers.NewSearchHandler(queries)
+	trashHandler := handlers.NewTrashHandler(queries, blobs)
+	auditHandler := handlers.NewAuditHandler(queries)
+	combinedAPIHandler := handlers.NewCombinedAPIHandler(personHandler, actionHandler, conversationHandler, followUpHandler, themeHandler, ladderHandler, searchHandler, trashHandler, auditHandler)
+	sessions := auth.NewSessions(queries, cfg.SessionTTL, cfg.IsProduction())
+	authHandler := handlers.NewAuthHandler(queries, sessions)
+	tokens := auth.NewTokens(queries)
+	tokenHandler := handlers.NewTokenHandler(queries, tokens)
+	frameworkHandler := handlers.NewFrameworkHandler(db, queries)
+	attachmentHandler := handlers.NewAttachmentHandler(queries, blobs)
+	auditLog := audit.NewLog(queries)
 
 	zap.L().Info("setting up HTTP server")
-	srv, err := server.New(cfg, combinedAPIHandler, personHandler, actionHandler)
+	srv, err := server.New(cfg, combinedAPIHandler, personHandler, actionHandler, conversationHandler, authHandler, tokenHandler, frameworkHandler, attachmentHandler, sessions, tokens, auditLog)
 	if err != nil {
 		zap.L().Fatal("failed to create server", zap.Error(err))
 	}
@@ -0,0 +1,34 @@
+-- migrate:up
+CREATE TABLE manager (
+    id BYTEA PRIMARY KEY,
+    email TEXT NOT NULL UNIQUE CHECK (LENGTH(TRIM(BOTH FROM email)) > 0),
+    name TEXT NOT NULL CHECK (LENGTH(TRIM(BOTH FROM name)) > 0),
+    password_hash TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER update_manager_updated_at
+    BEFORE UPDATE ON manager
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- Sessions are looked up by the SHA-256 of the cookie value so a leaked
+-- database dump cannot be replayed as a login.
+CREATE TABLE manager_session (
+    token_hash BYTEA PRIMARY KEY,
+    manager_id BYTEA NOT NULL REFERENCES manager(id) ON DELETE CASCADE,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_manager_session_manager_id ON manager_session(manager_id);
+CREATE INDEX idx_manager_session_expires_at ON manager_session(expires_at);
+
+-- migrate:down
+DROP INDEX IF EXISTS idx_manager_session_expires_at;
+DROP INDEX IF EXISTS idx_manager_session_manager_id;
+DROP TABLE IF EXISTS manager_session;
+
+DROP TRIGGER IF EXISTS update_manager_updated_at ON manager;
+DROP TABLE IF EXISTS manager;
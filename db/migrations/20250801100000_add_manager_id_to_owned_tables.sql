@@ -0,0 +1,50 @@
+-- migrate:up
+ALTER TABLE person
+    ADD COLUMN manager_id BYTEA REFERENCES manager(id) ON DELETE CASCADE;
+ALTER TABLE action
+    ADD COLUMN manager_id BYTEA REFERENCES manager(id) ON DELETE CASCADE;
+ALTER TABLE theme
+    ADD COLUMN manager_id BYTEA REFERENCES manager(id) ON DELETE CASCADE;
+ALTER TABLE conversation
+    ADD COLUMN manager_id BYTEA REFERENCES manager(id) ON DELETE CASCADE;
+
+-- Rows recorded before manager accounts existed are handed to the first manager.
+DO $$
+DECLARE
+    owner BYTEA;
+BEGIN
+    SELECT id INTO owner FROM manager ORDER BY created_at LIMIT 1;
+
+    IF owner IS NULL THEN
+        IF EXISTS (SELECT 1 FROM person) THEN
+            RAISE EXCEPTION 'existing people need an owner: create a manager (make create-manager) and re-run the migration';
+        END IF;
+        RETURN;
+    END IF;
+
+    UPDATE person SET manager_id = owner WHERE manager_id IS NULL;
+    UPDATE action SET manager_id = owner WHERE manager_id IS NULL;
+    UPDATE theme SET manager_id = owner WHERE manager_id IS NULL;
+    UPDATE conversation SET manager_id = owner WHERE manager_id IS NULL;
+END $$;
+
+ALTER TABLE person ALTER COLUMN manager_id SET NOT NULL;
+ALTER TABLE action ALTER COLUMN manager_id SET NOT NULL;
+ALTER TABLE theme ALTER COLUMN manager_id SET NOT NULL;
+ALTER TABLE conversation ALTER COLUMN manager_id SET NOT NULL;
+
+CREATE INDEX idx_person_manager_id ON person(manager_id);
+CREATE INDEX idx_action_manager_id ON action(manager_id);
+CREATE INDEX idx_theme_manager_id ON theme(manager_id);
+CREATE INDEX idx_conversation_manager_id ON conversation(manager_id);
+
+-- migrate:down
+DROP INDEX IF EXISTS idx_conversation_manager_id;
+DROP INDEX IF EXISTS idx_theme_manager_id;
+DROP INDEX IF EXISTS idx_action_manager_id;
+DROP INDEX IF EXISTS idx_person_manager_id;
+
+ALTER TABLE conversation DROP COLUMN IF EXISTS manager_id;
+ALTER TABLE theme DROP COLUMN IF EXISTS manager_id;
+ALTER TABLE action DROP COLUMN IF EXISTS manager_id;
+ALTER TABLE person DROP COLUMN IF EXISTS manager_id;
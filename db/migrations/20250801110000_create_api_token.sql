@@ -0,0 +1,23 @@
+-- migrate:up
+CREATE TYPE api_token_scope AS ENUM ('read', 'write');
+
+-- Like sessions, tokens are stored as the SHA-256 of the secret; the secret
+-- itself is only shown once, when the token is issued.
+CREATE TABLE api_token (
+    id BYTEA PRIMARY KEY,
+    manager_id BYTEA NOT NULL REFERENCES manager(id) ON DELETE CASCADE,
+    name TEXT NOT NULL CHECK (LENGTH(TRIM(BOTH FROM name)) > 0),
+    token_hash BYTEA NOT NULL UNIQUE,
+    scope api_token_scope NOT NULL DEFAULT 'read',
+    expires_at TIMESTAMPTZ,
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_api_token_manager_id ON api_token(manager_id);
+
+-- migrate:down
+DROP INDEX IF EXISTS idx_api_token_manager_id;
+DROP TABLE IF EXISTS api_token;
+DROP TYPE IF EXISTS api_token_scope;
@@ -0,0 +1,13 @@
+-- migrate:up
+ALTER TABLE person
+    ADD COLUMN reports_to BYTEA REFERENCES person(id) ON DELETE SET NULL,
+    ADD CONSTRAINT person_reports_to_check CHECK (reports_to <> id);
+
+CREATE INDEX idx_person_reports_to ON person(reports_to);
+
+-- migrate:down
+DROP INDEX IF EXISTS idx_person_reports_to;
+
+ALTER TABLE person
+    DROP CONSTRAINT IF EXISTS person_reports_to_check,
+    DROP COLUMN IF EXISTS reports_to;
@@ -0,0 +1,20 @@
+-- migrate:up
+ALTER TABLE person
+    ADD COLUMN cadence_days INTEGER,
+    ADD CONSTRAINT person_cadence_days_check CHECK (cadence_days > 0);
+
+-- The next 1:1 is due one cadence after the latest conversation, or after the
+-- person was added if there has not been one yet. NULL when no cadence is set.
+CREATE OR REPLACE FUNCTION person_next_due_at(person_id BYTEA, cadence_days INTEGER, created_at TIMESTAMPTZ) RETURNS TIMESTAMPTZ AS $$
+    SELECT COALESCE(
+        (SELECT MAX(c.occurred_at) FROM conversation c WHERE c.person_id = $1),
+        $3
+    ) + make_interval(days => $2);
+$$ LANGUAGE sql STABLE;
+
+-- migrate:down
+DROP FUNCTION IF EXISTS person_next_due_at(BYTEA, INTEGER, TIMESTAMPTZ);
+
+ALTER TABLE person
+    DROP CONSTRAINT IF EXISTS person_cadence_days_check,
+    DROP COLUMN IF EXISTS cadence_days;
@@ -0,0 +1,29 @@
+-- migrate:up
+CREATE TYPE follow_up_owner AS ENUM ('manager', 'report');
+
+CREATE TABLE follow_up (
+    id BYTEA PRIMARY KEY,
+    conversation_id BYTEA NOT NULL REFERENCES conversation(id) ON DELETE CASCADE,
+    manager_id BYTEA NOT NULL REFERENCES manager(id) ON DELETE CASCADE,
+    description TEXT NOT NULL CHECK (LENGTH(TRIM(BOTH FROM description)) > 0),
+    owner follow_up_owner NOT NULL,
+    due_on DATE,
+    completed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_follow_up_conversation_id ON follow_up(conversation_id);
+CREATE INDEX idx_follow_up_manager_id_open ON follow_up(manager_id) WHERE completed_at IS NULL;
+
+CREATE TRIGGER update_follow_up_updated_at
+    BEFORE UPDATE ON follow_up
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+-- migrate:down
+DROP TRIGGER IF EXISTS update_follow_up_updated_at ON follow_up;
+DROP INDEX IF EXISTS idx_follow_up_manager_id_open;
+DROP INDEX IF EXISTS idx_follow_up_conversation_id;
+DROP TABLE IF EXISTS follow_up;
+DROP TYPE IF EXISTS follow_up_owner;
@@ -0,0 +1,5 @@
+-- migrate:up
+ALTER TABLE theme ADD COLUMN archived_at TIMESTAMPTZ;
+
+-- migrate:down
+ALTER TABLE theme DROP COLUMN IF EXISTS archived_at;
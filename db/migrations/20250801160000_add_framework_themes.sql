@@ -0,0 +1,12 @@
+-- migrate:up
+-- A theme without a person belongs to the manager's competency framework and can be
+-- attached to any report's actions and conversations.
+ALTER TABLE theme ALTER COLUMN person_id DROP NOT NULL;
+ALTER TABLE theme ADD COLUMN description TEXT;
+CREATE UNIQUE INDEX idx_theme_framework_text ON theme (manager_id, LOWER(text)) WHERE person_id IS NULL;
+
+-- migrate:down
+DELETE FROM theme WHERE person_id IS NULL;
+DROP INDEX IF EXISTS idx_theme_framework_text;
+ALTER TABLE theme DROP COLUMN IF EXISTS description;
+ALTER TABLE theme ALTER COLUMN person_id SET NOT NULL;
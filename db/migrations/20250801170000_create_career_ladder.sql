@@ -0,0 +1,49 @@
+-- migrate:up
+-- Levels of the manager's career ladder, ordered by rank (L3 below L4, and so on).
+CREATE TABLE ladder_level (
+    id BYTEA PRIMARY KEY,
+    manager_id BYTEA NOT NULL REFERENCES manager(id) ON DELETE CASCADE,
+    name TEXT NOT NULL CHECK (LENGTH(TRIM(BOTH FROM name)) > 0),
+    rank INTEGER NOT NULL,
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (manager_id, name)
+);
+
+-- What a level expects for one framework competency
+CREATE TABLE level_expectation (
+    id BYTEA PRIMARY KEY,
+    level_id BYTEA NOT NULL REFERENCES ladder_level(id) ON DELETE CASCADE,
+    theme_id BYTEA NOT NULL REFERENCES theme(id) ON DELETE CASCADE,
+    description TEXT NOT NULL CHECK (LENGTH(TRIM(BOTH FROM description)) > 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (level_id, theme_id)
+);
+
+CREATE INDEX idx_ladder_level_manager_id ON ladder_level(manager_id, rank);
+CREATE INDEX idx_level_expectation_theme_id ON level_expectation(theme_id);
+
+CREATE TRIGGER update_ladder_level_updated_at
+    BEFORE UPDATE ON ladder_level
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE TRIGGER update_level_expectation_updated_at
+    BEFORE UPDATE ON level_expectation
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
+
+ALTER TABLE person ADD COLUMN current_level_id BYTEA REFERENCES ladder_level(id) ON DELETE SET NULL;
+ALTER TABLE person ADD COLUMN target_level_id BYTEA REFERENCES ladder_level(id) ON DELETE SET NULL;
+
+-- migrate:down
+ALTER TABLE person DROP COLUMN IF EXISTS target_level_id;
+ALTER TABLE person DROP COLUMN IF EXISTS current_level_id;
+DROP TRIGGER IF EXISTS update_level_expectation_updated_at ON level_expectation;
+DROP TRIGGER IF EXISTS update_ladder_level_updated_at ON ladder_level;
+DROP INDEX IF EXISTS idx_level_expectation_theme_id;
+DROP INDEX IF EXISTS idx_ladder_level_manager_id;
+DROP TABLE IF EXISTS level_expectation;
+DROP TABLE IF EXISTS ladder_level;
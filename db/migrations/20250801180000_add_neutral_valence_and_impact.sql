@@ -0,0 +1,19 @@
+-- migrate:up
+ALTER TYPE valence_type ADD VALUE IF NOT EXISTS 'neutral';
+
+CREATE TYPE impact_level AS ENUM ('low', 'medium', 'high');
+
+ALTER TABLE action ADD COLUMN impact impact_level;
+
+-- migrate:down
+ALTER TABLE action DROP COLUMN IF EXISTS impact;
+
+DROP TYPE IF EXISTS impact_level;
+
+-- Postgres cannot drop a value from an enum, so rebuild valence_type without
+-- 'neutral'; neutral actions have no equivalent and are removed
+DELETE FROM action WHERE valence = 'neutral';
+ALTER TYPE valence_type RENAME TO valence_type_old;
+CREATE TYPE valence_type AS ENUM ('positive', 'negative');
+ALTER TABLE action ALTER COLUMN valence TYPE valence_type USING valence::text::valence_type;
+DROP TYPE valence_type_old;
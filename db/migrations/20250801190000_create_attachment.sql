@@ -0,0 +1,22 @@
+-- migrate:up
+-- Files kept as evidence on an action or a conversation. The bytes live in the
+-- blob store under storage_key; sha256 is the hex digest of the contents.
+CREATE TABLE attachment (
+    id BYTEA PRIMARY KEY,
+    manager_id BYTEA NOT NULL REFERENCES manager(id) ON DELETE CASCADE,
+    action_id BYTEA REFERENCES action(id) ON DELETE CASCADE,
+    conversation_id BYTEA REFERENCES conversation(id) ON DELETE CASCADE,
+    filename TEXT NOT NULL CHECK (LENGTH(TRIM(BOTH FROM filename)) > 0),
+    content_type TEXT NOT NULL,
+    size_bytes BIGINT NOT NULL CHECK (size_bytes >= 0),
+    sha256 TEXT NOT NULL,
+    storage_key TEXT NOT NULL UNIQUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK ((action_id IS NULL) <> (conversation_id IS NULL))
+);
+
+CREATE INDEX idx_attachment_action_id ON attachment(action_id);
+CREATE INDEX idx_attachment_conversation_id ON attachment(conversation_id);
+
+-- migrate:down
+DROP TABLE IF EXISTS attachment;
@@ -0,0 +1,51 @@
+-- migrate:up
+CREATE TYPE reference_kind AS ENUM ('url', 'ticket', 'pull_request', 'note');
+
+-- Typed references on an action, in the order they were entered
+CREATE TABLE action_reference (
+    action_id BYTEA NOT NULL REFERENCES action(id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    kind reference_kind NOT NULL,
+    value TEXT NOT NULL CHECK (LENGTH(TRIM(BOTH FROM value)) > 0),
+    title TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (action_id, position)
+);
+
+-- Split the old free-text column on newlines, commas and semicolons, and before
+-- any URL that follows other text. The patterns match internal/references.
+INSERT INTO action_reference (action_id, position, kind, value)
+SELECT a.id,
+       e.position,
+       (CASE
+           WHEN e.value ~ '^https?://\S+$' THEN 'url'
+           WHEN e.value ~ '^([A-Za-z0-9_.-]+/[A-Za-z0-9_.-]+#[0-9]+|([Pp][Rr]\s*)?#[0-9]+)$' THEN 'pull_request'
+           WHEN e.value ~ '^[A-Z][A-Z0-9]+-[0-9]+$' THEN 'ticket'
+           ELSE 'note'
+       END)::reference_kind,
+       e.value
+FROM action a
+CROSS JOIN LATERAL (
+    SELECT BTRIM(part) AS value, (ROW_NUMBER() OVER (ORDER BY ord))::INTEGER AS position
+    FROM regexp_split_to_table(a."references", '\s*[\n,;]\s*|\s+(?=https?://)') WITH ORDINALITY AS t(part, ord)
+    WHERE BTRIM(part) <> ''
+) e
+WHERE a."references" IS NOT NULL;
+
+ALTER TABLE action DROP COLUMN "references";
+
+-- migrate:down
+ALTER TABLE action ADD COLUMN "references" TEXT;
+
+UPDATE action a
+SET "references" = r.text
+FROM (
+    SELECT action_id,
+           STRING_AGG(CASE WHEN title IS NULL THEN value ELSE title || ' | ' || value END, E'\n' ORDER BY position) AS text
+    FROM action_reference
+    GROUP BY action_id
+) r
+WHERE r.action_id = a.id;
+
+DROP TABLE IF EXISTS action_reference;
+DROP TYPE IF EXISTS reference_kind;
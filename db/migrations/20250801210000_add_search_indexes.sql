@@ -0,0 +1,13 @@
+-- migrate:up
+-- Expression indexes for full-text search; queries must repeat these
+-- expressions exactly for the planner to use them.
+CREATE INDEX idx_action_description_search ON action USING GIN (to_tsvector('english', description));
+CREATE INDEX idx_action_reference_search ON action_reference USING GIN (to_tsvector('english', COALESCE(title, '') || ' ' || value));
+CREATE INDEX idx_conversation_description_search ON conversation USING GIN (to_tsvector('english', description));
+CREATE INDEX idx_theme_search ON theme USING GIN (to_tsvector('english', text || ' ' || COALESCE(description, '')));
+
+-- migrate:down
+DROP INDEX IF EXISTS idx_theme_search;
+DROP INDEX IF EXISTS idx_conversation_description_search;
+DROP INDEX IF EXISTS idx_action_reference_search;
+DROP INDEX IF EXISTS idx_action_description_search;
@@ -0,0 +1,43 @@
+-- migrate:up
+-- Deleting a person, action, conversation or theme stamps deleted_at and moves
+-- it to the trash, from where it can be restored or purged. A person's actions,
+-- conversations and themes are trashed with them under the same timestamp.
+ALTER TABLE person ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE action ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE conversation ADD COLUMN deleted_at TIMESTAMPTZ;
+ALTER TABLE theme ADD COLUMN deleted_at TIMESTAMPTZ;
+
+-- Framework theme names only need to be unique among themes still in use, so a
+-- trashed theme does not block creating another with the same text
+DROP INDEX idx_theme_framework_text;
+CREATE UNIQUE INDEX idx_theme_framework_text ON theme (manager_id, LOWER(text)) WHERE person_id IS NULL AND deleted_at IS NULL;
+
+-- A conversation in the trash no longer counts as the latest 1:1
+CREATE OR REPLACE FUNCTION person_next_due_at(person_id BYTEA, cadence_days INTEGER, created_at TIMESTAMPTZ) RETURNS TIMESTAMPTZ AS $$
+    SELECT COALESCE(
+        (SELECT MAX(c.occurred_at) FROM conversation c WHERE c.person_id = $1 AND c.deleted_at IS NULL),
+        $3
+    ) + make_interval(days => $2);
+$$ LANGUAGE sql STABLE;
+
+-- migrate:down
+CREATE OR REPLACE FUNCTION person_next_due_at(person_id BYTEA, cadence_days INTEGER, created_at TIMESTAMPTZ) RETURNS TIMESTAMPTZ AS $$
+    SELECT COALESCE(
+        (SELECT MAX(c.occurred_at) FROM conversation c WHERE c.person_id = $1),
+        $3
+    ) + make_interval(days => $2);
+$$ LANGUAGE sql STABLE;
+
+-- Whatever is still in the trash is purged rather than brought back
+DELETE FROM person WHERE deleted_at IS NOT NULL;
+DELETE FROM action WHERE deleted_at IS NOT NULL;
+DELETE FROM conversation WHERE deleted_at IS NOT NULL;
+DELETE FROM theme WHERE deleted_at IS NOT NULL;
+
+DROP INDEX idx_theme_framework_text;
+ALTER TABLE theme DROP COLUMN deleted_at;
+ALTER TABLE conversation DROP COLUMN deleted_at;
+ALTER TABLE action DROP COLUMN deleted_at;
+ALTER TABLE person DROP COLUMN deleted_at;
+
+CREATE UNIQUE INDEX idx_theme_framework_text ON theme (manager_id, LOWER(text)) WHERE person_id IS NULL;
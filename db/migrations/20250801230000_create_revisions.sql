@@ -0,0 +1,65 @@
+-- migrate:up
+-- Saved versions of an action's content. References are a JSON array in entry
+-- order; themes are parallel arrays of xids and the text each had at the time,
+-- ordered by id so two snapshots of the same set compare equal.
+CREATE TABLE action_revision (
+    id BYTEA PRIMARY KEY,
+    action_id BYTEA NOT NULL REFERENCES action(id) ON DELETE CASCADE,
+    manager_id BYTEA NOT NULL REFERENCES manager(id) ON DELETE CASCADE,
+    description TEXT NOT NULL,
+    valence valence_type NOT NULL,
+    impact impact_level,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    "references" JSONB NOT NULL DEFAULT '[]',
+    theme_ids TEXT[] NOT NULL DEFAULT '{}',
+    theme_texts TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_action_revision_action_id ON action_revision(action_id, created_at);
+
+CREATE TABLE conversation_revision (
+    id BYTEA PRIMARY KEY,
+    conversation_id BYTEA NOT NULL REFERENCES conversation(id) ON DELETE CASCADE,
+    manager_id BYTEA NOT NULL REFERENCES manager(id) ON DELETE CASCADE,
+    description TEXT NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    theme_ids TEXT[] NOT NULL DEFAULT '{}',
+    theme_texts TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_conversation_revision_conversation_id ON conversation_revision(conversation_id, created_at);
+
+-- Existing rows start with one revision holding their current content. It
+-- borrows the row's own id, which is unique here and dates from its creation.
+INSERT INTO action_revision (id, action_id, manager_id, description, valence, impact, occurred_at, "references", theme_ids, theme_texts, created_at)
+SELECT a.id, a.id, a.manager_id, a.description, a.valence, a.impact, a.occurred_at,
+       COALESCE(r.refs, '[]'), COALESCE(t.ids, '{}'), COALESCE(t.texts, '{}'), a.updated_at
+FROM action a
+LEFT JOIN LATERAL (
+    SELECT JSONB_AGG(JSONB_BUILD_OBJECT('kind', ar.kind, 'value', ar.value, 'title', ar.title) ORDER BY ar.position) AS refs
+    FROM action_reference ar
+    WHERE ar.action_id = a.id
+) r ON TRUE
+LEFT JOIN LATERAL (
+    SELECT ARRAY_AGG(b2x(th.id) ORDER BY th.id) AS ids, ARRAY_AGG(th.text ORDER BY th.id) AS texts
+    FROM action_theme at
+    JOIN theme th ON th.id = at.theme_id
+    WHERE at.action_id = a.id AND th.deleted_at IS NULL
+) t ON TRUE;
+
+INSERT INTO conversation_revision (id, conversation_id, manager_id, description, occurred_at, theme_ids, theme_texts, created_at)
+SELECT c.id, c.id, c.manager_id, c.description, c.occurred_at,
+       COALESCE(t.ids, '{}'), COALESCE(t.texts, '{}'), c.updated_at
+FROM conversation c
+LEFT JOIN LATERAL (
+    SELECT ARRAY_AGG(b2x(th.id) ORDER BY th.id) AS ids, ARRAY_AGG(th.text ORDER BY th.id) AS texts
+    FROM conversation_theme ct
+    JOIN theme th ON th.id = ct.theme_id
+    WHERE ct.conversation_id = c.id AND th.deleted_at IS NULL
+) t ON TRUE;
+
+-- migrate:down
+DROP TABLE IF EXISTS conversation_revision;
+DROP TABLE IF EXISTS action_revision;
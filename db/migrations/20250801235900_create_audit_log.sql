@@ -0,0 +1,43 @@
+-- migrate:up
+-- Who read or changed what. Rows outlive the records they mention, so
+-- entity_ids holds plain xid strings with no foreign keys, and manager_id has
+-- none either so removing a manager does not take their trail with them.
+CREATE TABLE audit_log (
+    id BYTEA PRIMARY KEY,
+    manager_id BYTEA NOT NULL,
+    operation TEXT NOT NULL,
+    entity_ids TEXT[] NOT NULL DEFAULT '{}',
+    auth_method TEXT NOT NULL,
+    method TEXT NOT NULL DEFAULT '',
+    path TEXT NOT NULL DEFAULT '',
+    remote_addr TEXT NOT NULL DEFAULT '',
+    user_agent TEXT NOT NULL DEFAULT '',
+    outcome TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_audit_log_manager_id ON audit_log(manager_id, created_at DESC);
+CREATE INDEX idx_audit_log_entity_ids ON audit_log USING GIN (entity_ids);
+
+-- The log is append-only: the application role cannot edit or remove entries
+CREATE FUNCTION audit_log_append_only() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$;
+
+CREATE TRIGGER audit_log_no_update_or_delete
+    BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW
+    EXECUTE FUNCTION audit_log_append_only();
+
+CREATE TRIGGER audit_log_no_truncate
+    BEFORE TRUNCATE ON audit_log
+    FOR EACH STATEMENT
+    EXECUTE FUNCTION audit_log_append_only();
+
+-- migrate:down
+DROP TABLE IF EXISTS audit_log;
+DROP FUNCTION IF EXISTS audit_log_append_only();
@@ -1,3 +1,41 @@
 -- name: AddActionToConversation :exec
 INSERT INTO action_conversation (action_id, conversation_id)
-VALUES (x2b(sqlc.arg(action_id)), x2b(sqlc.arg(conversation_id)));
+SELECT a.id, c.id
+FROM action a
+JOIN conversation c ON c.manager_id = a.manager_id
+WHERE a.id = x2b(sqlc.arg(action_id))
+  AND c.id = x2b(sqlc.arg(conversation_id))
+  AND a.manager_id = x2b(sqlc.arg(manager_id))
+  AND a.deleted_at IS NULL AND c.deleted_at IS NULL;
+
+-- name: RemoveActionFromConversation :exec
+DELETE FROM action_conversation ac
+USING conversation c
+WHERE c.id = ac.conversation_id
+  AND ac.conversation_id = x2b(sqlc.arg(conversation_id))
+  AND ac.action_id = x2b(sqlc.arg(action_id))
+  AND c.manager_id = x2b(sqlc.arg(manager_id));
+
+-- name: ListActionsByConversationID :many
+SELECT sqlc.embed(action)
+FROM action_conversation ac
+JOIN action ON ac.action_id = action.id
+WHERE ac.conversation_id = x2b(sqlc.arg(conversation_id)) AND action.manager_id = x2b(sqlc.arg(manager_id)) AND action.deleted_at IS NULL
+ORDER BY action.occurred_at DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: ListActionsByConversationIDs :many
+SELECT b2x(ac.conversation_id) AS conversation_id, sqlc.embed(action)
+FROM action_conversation ac
+JOIN action ON ac.action_id = action.id
+WHERE ac.conversation_id IN (SELECT x2b(id) FROM unnest(sqlc.arg(conversation_ids)::text[]) AS id)
+  AND action.manager_id = x2b(sqlc.arg(manager_id)) AND action.deleted_at IS NULL
+ORDER BY ac.conversation_id, action.occurred_at DESC;
+
+-- name: ListConversationsByActionID :many
+SELECT sqlc.embed(conversation)
+FROM action_conversation ac
+JOIN conversation ON ac.conversation_id = conversation.id
+WHERE ac.action_id = x2b(sqlc.arg(action_id)) AND conversation.manager_id = x2b(sqlc.arg(manager_id)) AND conversation.deleted_at IS NULL
+ORDER BY conversation.occurred_at DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
@@ -0,0 +1,21 @@
+-- name: AddActionReference :exec
+INSERT INTO action_reference (action_id, position, kind, value, title)
+VALUES (
+    x2b(sqlc.arg(action_id)),
+    sqlc.arg(position),
+    sqlc.arg(kind),
+    sqlc.arg(value),
+    sqlc.narg(title)
+);
+
+-- name: DeleteActionReferences :exec
+DELETE FROM action_reference
+WHERE action_id = x2b(sqlc.arg(action_id));
+
+-- name: ListReferencesByActionIDs :many
+SELECT b2x(r.action_id) AS action_id, r.position, r.kind, r.value, r.title
+FROM action_reference r
+JOIN action a ON a.id = r.action_id
+WHERE r.action_id IN (SELECT x2b(id) FROM unnest(sqlc.arg(action_ids)::text[]) AS id)
+  AND a.manager_id = x2b(sqlc.arg(manager_id)) AND a.deleted_at IS NULL
+ORDER BY r.action_id, r.position;
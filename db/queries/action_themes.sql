@@ -1,16 +1,26 @@
 -- name: AddThemeToAction :exec
 INSERT INTO action_theme (action_id, theme_id)
-VALUES (x2b(sqlc.arg(action_id)), x2b(sqlc.arg(theme_id)));
+SELECT a.id, t.id
+FROM action a
+JOIN theme t ON t.manager_id = a.manager_id
+WHERE a.id = x2b(sqlc.arg(action_id))
+  AND t.id = x2b(sqlc.arg(theme_id))
+  AND a.manager_id = x2b(sqlc.arg(manager_id))
+  AND a.deleted_at IS NULL AND t.deleted_at IS NULL;
 
 -- name: RemoveThemeFromAction :exec
-DELETE FROM action_theme
-WHERE action_id = x2b(sqlc.arg(action_id)) AND theme_id = x2b(sqlc.arg(theme_id));
+DELETE FROM action_theme at
+USING action a
+WHERE a.id = at.action_id
+  AND at.action_id = x2b(sqlc.arg(action_id))
+  AND at.theme_id = x2b(sqlc.arg(theme_id))
+  AND a.manager_id = x2b(sqlc.arg(manager_id));
 
 -- name: ListThemesByActionID :many
 SELECT sqlc.embed(theme)
 FROM action_theme at
 JOIN theme ON at.theme_id = theme.id
-WHERE at.action_id = x2b(sqlc.arg(action_id))
+WHERE at.action_id = x2b(sqlc.arg(action_id)) AND theme.manager_id = x2b(sqlc.arg(manager_id)) AND theme.deleted_at IS NULL
 ORDER BY theme.created_at DESC
 LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
@@ -18,6 +28,30 @@ LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 SELECT sqlc.embed(action)
 FROM action_theme at
 JOIN action ON at.action_id = action.id
-WHERE at.theme_id = x2b(sqlc.arg(theme_id))
+WHERE at.theme_id = x2b(sqlc.arg(theme_id)) AND action.manager_id = x2b(sqlc.arg(manager_id)) AND action.deleted_at IS NULL
+  AND (sqlc.narg(person_id)::text IS NULL OR action.person_id = x2b(sqlc.narg(person_id)))
 ORDER BY action.created_at DESC
 LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: MoveActionThemes :exec
+-- The source theme's links are removed as they are copied, so the source is left
+-- with none. Actions already linked to the target keep their one link.
+WITH moved AS (
+    DELETE FROM action_theme at
+    USING theme t
+    WHERE at.theme_id = x2b(sqlc.arg(source_theme_id))
+      AND t.id = x2b(sqlc.arg(target_theme_id)) AND t.manager_id = x2b(sqlc.arg(manager_id))
+    RETURNING at.action_id, t.id AS theme_id
+)
+INSERT INTO action_theme (action_id, theme_id)
+SELECT action_id, theme_id FROM moved
+ON CONFLICT DO NOTHING;
+
+-- name: MoveActionToTheme :execrows
+UPDATE action_theme at
+SET theme_id = x2b(sqlc.arg(target_theme_id))
+FROM action a
+WHERE a.id = at.action_id
+  AND at.action_id = x2b(sqlc.arg(action_id))
+  AND at.theme_id = x2b(sqlc.arg(source_theme_id))
+  AND a.manager_id = x2b(sqlc.arg(manager_id));
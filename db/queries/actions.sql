@@ -1,73 +1,46 @@
 -- name: CreateAction :one
-INSERT INTO action (id, person_id, occurred_at, description, "references", valence)
+INSERT INTO action (id, person_id, occurred_at, description, valence, impact, manager_id)
 VALUES (
     x2b(sqlc.arg(id)),
     x2b(sqlc.arg(person_id)),
     sqlc.arg(occurred_at),
     sqlc.arg(description),
-    sqlc.arg('references'),
-    sqlc.arg(valence)
+    sqlc.arg(valence),
+    sqlc.arg(impact),
+    x2b(sqlc.arg(manager_id))
 )
 RETURNING sqlc.embed(action);
 
 -- name: GetActionByID :one
 SELECT sqlc.embed(action)
 FROM action
-WHERE id = x2b(sqlc.arg(id));
-
--- name: ListActions :many
-SELECT sqlc.embed(action), person.name as person_name
-FROM action
-JOIN person ON action.person_id = person.id
-ORDER BY occurred_at DESC
-LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL;
 
 -- name: ListActionsByPersonID :many
 SELECT sqlc.embed(action)
 FROM action
-WHERE person_id = x2b(sqlc.arg(person_id))
+WHERE person_id = x2b(sqlc.arg(person_id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL
 ORDER BY occurred_at DESC
 LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
--- name: CountActions :one
-SELECT COUNT(*) FROM action;
-
 -- name: CountActionsByPersonID :one
-SELECT COUNT(*) FROM action WHERE person_id = x2b(sqlc.arg(person_id));
+SELECT COUNT(*) FROM action WHERE person_id = x2b(sqlc.arg(person_id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL;
 
 -- name: UpdateAction :one
 UPDATE action
 SET person_id = x2b(sqlc.arg(person_id)),
     occurred_at = sqlc.arg(occurred_at),
     description = sqlc.arg(description),
-    "references" = sqlc.arg('references'),
     valence = sqlc.arg(valence),
+    impact = sqlc.arg(impact),
     updated_at = NOW()
-WHERE id = x2b(sqlc.arg(id))
+WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL
 RETURNING sqlc.embed(action);
 
--- name: DeleteAction :exec
-DELETE FROM action
-WHERE id = x2b(sqlc.arg(id));
-
--- name: ListActionsByValence :many
-SELECT sqlc.embed(action)
-FROM action
-WHERE valence = sqlc.arg(valence)
-ORDER BY occurred_at DESC
-LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
-
 -- name: ListActionsByPersonIDAndValence :many
 SELECT sqlc.embed(action)
 FROM action
-WHERE person_id = x2b(sqlc.arg(person_id)) AND valence = sqlc.arg(valence)
-ORDER BY occurred_at DESC
-LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
-
--- name: SearchActionsByDescription :many
-SELECT sqlc.embed(action)
-FROM action
-WHERE description ILIKE '%' || sqlc.arg('search') || '%'
+WHERE person_id = x2b(sqlc.arg(person_id)) AND valence = sqlc.arg(valence) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL
 ORDER BY occurred_at DESC
 LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
@@ -78,25 +51,113 @@ SELECT
     p.name as person_name,
     a.occurred_at,
     a.description,
-    a."references",
     a.valence,
     a.created_at,
     a.updated_at
 FROM action a
 JOIN person p ON a.person_id = p.id
+WHERE a.manager_id = x2b(sqlc.arg(manager_id)) AND a.deleted_at IS NULL
 ORDER BY a.occurred_at DESC
 LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
--- name: GetActionsByDateRange :many
+-- name: GetRecentActionsByPersonID :many
 SELECT sqlc.embed(action)
 FROM action
-WHERE occurred_at >= sqlc.arg(start_time) AND occurred_at <= sqlc.arg(end_time)
+WHERE person_id = x2b(sqlc.arg(person_id)) AND occurred_at >= sqlc.arg(since) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL
 ORDER BY occurred_at DESC
 LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
--- name: GetRecentActionsByPersonID :many
-SELECT sqlc.embed(action)
+-- name: ListAgendaActions :many
+-- Each action's themes come back as parallel arrays, newest theme first, so the
+-- agenda can group actions without a query per action.
+SELECT sqlc.embed(a),
+       COALESCE(t.ids, '{}')::TEXT[] AS theme_ids,
+       COALESCE(t.texts, '{}')::TEXT[] AS theme_texts
+FROM action a
+LEFT JOIN LATERAL (
+    SELECT ARRAY_AGG(b2x(th.id) ORDER BY th.created_at DESC) AS ids,
+           ARRAY_AGG(th.text ORDER BY th.created_at DESC) AS texts
+    FROM action_theme at
+    JOIN theme th ON th.id = at.theme_id
+    WHERE at.action_id = a.id AND th.manager_id = a.manager_id AND th.deleted_at IS NULL
+) t ON TRUE
+WHERE a.person_id = x2b(sqlc.arg(person_id))
+  AND a.manager_id = x2b(sqlc.arg(manager_id))
+  AND a.deleted_at IS NULL
+  AND a.occurred_at > COALESCE(
+      (SELECT MAX(c.occurred_at) FROM conversation c WHERE c.person_id = a.person_id AND c.deleted_at IS NULL),
+      '-infinity'::timestamptz
+  )
+  AND NOT EXISTS (
+      SELECT 1 FROM action_conversation ac
+      JOIN conversation c ON c.id = ac.conversation_id
+      WHERE ac.action_id = a.id AND c.deleted_at IS NULL
+  )
+ORDER BY a.occurred_at DESC;
+
+-- name: ListActionsFiltered :many
+-- Every filter is optional; the WHERE clause must stay in step with CountActionsFiltered.
+-- The cursor is the sort column and ID of the last row already returned.
+SELECT sqlc.embed(action), p.name AS person_name
 FROM action
-WHERE person_id = x2b(sqlc.arg(person_id)) AND occurred_at >= sqlc.arg(since)
-ORDER BY occurred_at DESC
+JOIN person p ON p.id = action.person_id
+WHERE action.manager_id = x2b(sqlc.arg(manager_id))
+  AND action.deleted_at IS NULL
+  AND (sqlc.narg(person_id)::TEXT IS NULL OR action.person_id = x2b(sqlc.narg(person_id)))
+  AND (sqlc.narg(valence)::valence_type IS NULL OR action.valence = sqlc.narg(valence))
+  AND (sqlc.narg(theme_id)::TEXT IS NULL OR EXISTS (
+        SELECT 1 FROM action_theme atm
+        WHERE atm.action_id = action.id AND atm.theme_id = x2b(sqlc.narg(theme_id))))
+  AND (sqlc.narg(since)::TIMESTAMPTZ IS NULL OR action.occurred_at >= sqlc.narg(since))
+  AND (sqlc.narg(before)::TIMESTAMPTZ IS NULL OR action.occurred_at < sqlc.narg(before))
+  AND (sqlc.narg(query)::TEXT IS NULL
+       OR to_tsvector('english', action.description) @@ websearch_to_tsquery('english', sqlc.narg(query))
+       OR EXISTS (
+            SELECT 1 FROM action_reference ar
+            WHERE ar.action_id = action.id
+              AND to_tsvector('english', COALESCE(ar.title, '') || ' ' || ar.value) @@ websearch_to_tsquery('english', sqlc.narg(query))))
+  AND (sqlc.narg(discussed)::BOOLEAN IS NULL
+       OR EXISTS (
+            SELECT 1 FROM action_conversation ac
+            JOIN conversation c ON c.id = ac.conversation_id
+            WHERE ac.action_id = action.id AND c.deleted_at IS NULL) = sqlc.narg(discussed))
+  AND (sqlc.narg(cursor_at)::TIMESTAMPTZ IS NULL OR CASE sqlc.arg(sort)::TEXT
+        WHEN 'occurred_at_asc' THEN (action.occurred_at, action.id) > (sqlc.narg(cursor_at), x2b(sqlc.narg(cursor_id)))
+        WHEN 'created_at_desc' THEN (action.created_at, action.id) < (sqlc.narg(cursor_at), x2b(sqlc.narg(cursor_id)))
+        WHEN 'created_at_asc' THEN (action.created_at, action.id) > (sqlc.narg(cursor_at), x2b(sqlc.narg(cursor_id)))
+        ELSE (action.occurred_at, action.id) < (sqlc.narg(cursor_at), x2b(sqlc.narg(cursor_id)))
+      END)
+ORDER BY
+    CASE WHEN sqlc.arg(sort)::TEXT = 'occurred_at_asc' THEN action.occurred_at END ASC,
+    CASE WHEN sqlc.arg(sort)::TEXT = 'occurred_at_asc' THEN action.id END ASC,
+    CASE WHEN sqlc.arg(sort)::TEXT = 'created_at_desc' THEN action.created_at END DESC,
+    CASE WHEN sqlc.arg(sort)::TEXT = 'created_at_desc' THEN action.id END DESC,
+    CASE WHEN sqlc.arg(sort)::TEXT = 'created_at_asc' THEN action.created_at END ASC,
+    CASE WHEN sqlc.arg(sort)::TEXT = 'created_at_asc' THEN action.id END ASC,
+    action.occurred_at DESC,
+    action.id DESC
 LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountActionsFiltered :one
+SELECT COUNT(*)
+FROM action
+WHERE action.manager_id = x2b(sqlc.arg(manager_id))
+  AND action.deleted_at IS NULL
+  AND (sqlc.narg(person_id)::TEXT IS NULL OR action.person_id = x2b(sqlc.narg(person_id)))
+  AND (sqlc.narg(valence)::valence_type IS NULL OR action.valence = sqlc.narg(valence))
+  AND (sqlc.narg(theme_id)::TEXT IS NULL OR EXISTS (
+        SELECT 1 FROM action_theme atm
+        WHERE atm.action_id = action.id AND atm.theme_id = x2b(sqlc.narg(theme_id))))
+  AND (sqlc.narg(since)::TIMESTAMPTZ IS NULL OR action.occurred_at >= sqlc.narg(since))
+  AND (sqlc.narg(before)::TIMESTAMPTZ IS NULL OR action.occurred_at < sqlc.narg(before))
+  AND (sqlc.narg(query)::TEXT IS NULL
+       OR to_tsvector('english', action.description) @@ websearch_to_tsquery('english', sqlc.narg(query))
+       OR EXISTS (
+            SELECT 1 FROM action_reference ar
+            WHERE ar.action_id = action.id
+              AND to_tsvector('english', COALESCE(ar.title, '') || ' ' || ar.value) @@ websearch_to_tsquery('english', sqlc.narg(query))))
+  AND (sqlc.narg(discussed)::BOOLEAN IS NULL
+       OR EXISTS (
+            SELECT 1 FROM action_conversation ac
+            JOIN conversation c ON c.id = ac.conversation_id
+            WHERE ac.action_id = action.id AND c.deleted_at IS NULL) = sqlc.narg(discussed));
@@ -0,0 +1,30 @@
+-- name: CreateAPIToken :one
+INSERT INTO api_token (id, manager_id, name, token_hash, scope, expires_at)
+VALUES (x2b(sqlc.arg(id)), x2b(sqlc.arg(manager_id)), sqlc.arg(name), sqlc.arg(token_hash), sqlc.arg(scope), sqlc.narg(expires_at))
+RETURNING sqlc.embed(api_token);
+
+-- name: GetManagerByAPIToken :one
+SELECT sqlc.embed(manager), sqlc.embed(api_token)
+FROM api_token
+JOIN manager ON manager.id = api_token.manager_id
+WHERE api_token.token_hash = sqlc.arg(token_hash)
+  AND api_token.revoked_at IS NULL
+  AND (api_token.expires_at IS NULL OR api_token.expires_at > NOW());
+
+-- name: TouchAPIToken :exec
+UPDATE api_token
+SET last_used_at = NOW()
+WHERE id = x2b(sqlc.arg(id));
+
+-- name: ListAPITokens :many
+SELECT sqlc.embed(api_token)
+FROM api_token
+WHERE manager_id = x2b(sqlc.arg(manager_id))
+ORDER BY created_at DESC;
+
+-- name: RevokeAPIToken :exec
+UPDATE api_token
+SET revoked_at = NOW()
+WHERE id = x2b(sqlc.arg(id))
+  AND manager_id = x2b(sqlc.arg(manager_id))
+  AND revoked_at IS NULL;
@@ -0,0 +1,35 @@
+-- name: CreateAttachment :one
+INSERT INTO attachment (id, manager_id, action_id, conversation_id, filename, content_type, size_bytes, sha256, storage_key)
+VALUES (
+    x2b(sqlc.arg(id)),
+    x2b(sqlc.arg(manager_id)),
+    x2b(sqlc.narg(action_id)),
+    x2b(sqlc.narg(conversation_id)),
+    sqlc.arg(filename),
+    sqlc.arg(content_type),
+    sqlc.arg(size_bytes),
+    sqlc.arg(sha256),
+    sqlc.arg(storage_key)
+)
+RETURNING sqlc.embed(attachment);
+
+-- name: GetAttachmentByID :one
+SELECT sqlc.embed(attachment)
+FROM attachment
+WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id))
+  AND NOT EXISTS (SELECT 1 FROM action a WHERE a.id = attachment.action_id AND a.deleted_at IS NOT NULL)
+  AND NOT EXISTS (SELECT 1 FROM conversation c WHERE c.id = attachment.conversation_id AND c.deleted_at IS NOT NULL);
+
+-- name: ListAttachments :many
+-- Includes the attachments of trashed actions and conversations, so that
+-- purging them can find the files to remove.
+SELECT sqlc.embed(attachment)
+FROM attachment
+WHERE manager_id = x2b(sqlc.arg(manager_id))
+  AND (sqlc.narg(action_id)::text IS NULL OR action_id = x2b(sqlc.narg(action_id)))
+  AND (sqlc.narg(conversation_id)::text IS NULL OR conversation_id = x2b(sqlc.narg(conversation_id)))
+ORDER BY created_at ASC;
+
+-- name: DeleteAttachment :execrows
+DELETE FROM attachment
+WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id));
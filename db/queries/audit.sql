@@ -0,0 +1,37 @@
+-- name: RecordAudit :exec
+INSERT INTO audit_log (id, manager_id, operation, entity_ids, auth_method, method, path, remote_addr, user_agent, outcome)
+VALUES (x2b(sqlc.arg(id)), x2b(sqlc.arg(manager_id)), sqlc.arg(operation), sqlc.arg(entity_ids)::TEXT[], sqlc.arg(auth_method), sqlc.arg(method), sqlc.arg(path), sqlc.arg(remote_addr), sqlc.arg(user_agent), sqlc.arg(outcome));
+
+-- name: ListAuditLog :many
+-- A person filter also matches entries about the person's actions,
+-- conversations and themes, including ones in the trash.
+SELECT b2x(id) AS id, operation, entity_ids, auth_method, method, path, remote_addr, user_agent, outcome, created_at
+FROM audit_log
+WHERE manager_id = x2b(sqlc.arg(manager_id))
+  AND (sqlc.narg(operation)::TEXT IS NULL OR operation ILIKE '%' || sqlc.narg(operation) || '%')
+  AND (sqlc.narg(auth_method)::TEXT IS NULL OR auth_method = sqlc.narg(auth_method))
+  AND (sqlc.narg(entity_id)::TEXT IS NULL OR entity_ids @> ARRAY[sqlc.narg(entity_id)::TEXT])
+  AND (sqlc.narg(person_id)::TEXT IS NULL OR entity_ids && ARRAY(
+        SELECT sqlc.narg(person_id)::TEXT
+        UNION ALL SELECT b2x(a.id) FROM action a WHERE a.person_id = x2b(sqlc.narg(person_id))
+        UNION ALL SELECT b2x(c.id) FROM conversation c WHERE c.person_id = x2b(sqlc.narg(person_id))
+        UNION ALL SELECT b2x(t.id) FROM theme t WHERE t.person_id = x2b(sqlc.narg(person_id))))
+  AND (sqlc.narg(since)::TIMESTAMPTZ IS NULL OR created_at >= sqlc.narg(since))
+  AND (sqlc.narg(before)::TIMESTAMPTZ IS NULL OR created_at < sqlc.narg(before))
+ORDER BY created_at DESC, id DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountAuditLog :one
+SELECT COUNT(*)
+FROM audit_log
+WHERE manager_id = x2b(sqlc.arg(manager_id))
+  AND (sqlc.narg(operation)::TEXT IS NULL OR operation ILIKE '%' || sqlc.narg(operation) || '%')
+  AND (sqlc.narg(auth_method)::TEXT IS NULL OR auth_method = sqlc.narg(auth_method))
+  AND (sqlc.narg(entity_id)::TEXT IS NULL OR entity_ids @> ARRAY[sqlc.narg(entity_id)::TEXT])
+  AND (sqlc.narg(person_id)::TEXT IS NULL OR entity_ids && ARRAY(
+        SELECT sqlc.narg(person_id)::TEXT
+        UNION ALL SELECT b2x(a.id) FROM action a WHERE a.person_id = x2b(sqlc.narg(person_id))
+        UNION ALL SELECT b2x(c.id) FROM conversation c WHERE c.person_id = x2b(sqlc.narg(person_id))
+        UNION ALL SELECT b2x(t.id) FROM theme t WHERE t.person_id = x2b(sqlc.narg(person_id))))
+  AND (sqlc.narg(since)::TIMESTAMPTZ IS NULL OR created_at >= sqlc.narg(since))
+  AND (sqlc.narg(before)::TIMESTAMPTZ IS NULL OR created_at < sqlc.narg(before));
@@ -1,11 +1,46 @@
 -- name: AddThemeToConversation :exec
 INSERT INTO conversation_theme (conversation_id, theme_id)
-VALUES (x2b(sqlc.arg(conversation_id)), x2b(sqlc.arg(theme_id)));
+SELECT c.id, t.id
+FROM conversation c
+JOIN theme t ON t.manager_id = c.manager_id
+WHERE c.id = x2b(sqlc.arg(conversation_id))
+  AND t.id = x2b(sqlc.arg(theme_id))
+  AND c.manager_id = x2b(sqlc.arg(manager_id))
+  AND c.deleted_at IS NULL AND t.deleted_at IS NULL;
 
 -- name: ListThemesByConversationID :many
 SELECT sqlc.embed(theme)
 FROM conversation_theme ct
 JOIN theme ON ct.theme_id = theme.id
-WHERE ct.conversation_id = x2b(sqlc.arg(conversation_id))
+WHERE ct.conversation_id = x2b(sqlc.arg(conversation_id)) AND theme.manager_id = x2b(sqlc.arg(manager_id)) AND theme.deleted_at IS NULL
 ORDER BY theme.created_at DESC
 LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: ListThemesByConversationIDs :many
+SELECT b2x(ct.conversation_id) AS conversation_id, sqlc.embed(theme)
+FROM conversation_theme ct
+JOIN theme ON ct.theme_id = theme.id
+WHERE ct.conversation_id IN (SELECT x2b(id) FROM unnest(sqlc.arg(conversation_ids)::text[]) AS id)
+  AND theme.manager_id = x2b(sqlc.arg(manager_id)) AND theme.deleted_at IS NULL
+ORDER BY ct.conversation_id, theme.created_at DESC;
+
+-- name: RemoveThemeFromConversation :exec
+DELETE FROM conversation_theme ct
+USING conversation c
+WHERE c.id = ct.conversation_id
+  AND ct.conversation_id = x2b(sqlc.arg(conversation_id))
+  AND ct.theme_id = x2b(sqlc.arg(theme_id))
+  AND c.manager_id = x2b(sqlc.arg(manager_id));
+
+-- name: MoveConversationThemes :exec
+-- Moves rather than copies, like MoveActionThemes.
+WITH moved AS (
+    DELETE FROM conversation_theme ct
+    USING theme t
+    WHERE ct.theme_id = x2b(sqlc.arg(source_theme_id))
+      AND t.id = x2b(sqlc.arg(target_theme_id)) AND t.manager_id = x2b(sqlc.arg(manager_id))
+    RETURNING ct.conversation_id, t.id AS theme_id
+)
+INSERT INTO conversation_theme (conversation_id, theme_id)
+SELECT conversation_id, theme_id FROM moved
+ON CONFLICT DO NOTHING;
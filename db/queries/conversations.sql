@@ -1,25 +1,62 @@
 -- name: CreateConversation :one
-INSERT INTO conversation (id, person_id, description, occurred_at)
+INSERT INTO conversation (id, person_id, description, occurred_at, manager_id)
 VALUES (
     x2b(sqlc.arg(id)),
     x2b(sqlc.arg(person_id)),
     sqlc.arg(description),
-    sqlc.arg(occurred_at)
+    sqlc.arg(occurred_at),
+    x2b(sqlc.arg(manager_id))
 )
 RETURNING sqlc.embed(conversation);
+
 -- name: ListConversationsByPersonID :many
-SELECT DISTINCT ON (c.id)
-    sqlc.embed(c)
+SELECT sqlc.embed(c)
 FROM conversation c
-JOIN action_conversation ac ON ac.conversation_id = c.id
-JOIN action a ON a.id = ac.action_id
-WHERE a.person_id = x2b(sqlc.arg(person_id))
-ORDER BY c.id, c.occurred_at DESC
+WHERE c.person_id = x2b(sqlc.arg(person_id)) AND c.manager_id = x2b(sqlc.arg(manager_id)) AND c.deleted_at IS NULL
+ORDER BY c.occurred_at DESC
 LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
 -- name: CountConversationsByPersonID :one
-SELECT COUNT(DISTINCT c.id)
+SELECT COUNT(*)
 FROM conversation c
-JOIN action_conversation ac ON ac.conversation_id = c.id
-JOIN action a ON a.id = ac.action_id
-WHERE a.person_id = x2b(sqlc.arg(person_id));
+WHERE c.person_id = x2b(sqlc.arg(person_id)) AND c.manager_id = x2b(sqlc.arg(manager_id)) AND c.deleted_at IS NULL;
+
+-- name: GetConversationByID :one
+SELECT sqlc.embed(conversation)
+FROM conversation
+WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL;
+
+-- name: ListConversations :many
+SELECT sqlc.embed(c)
+FROM conversation c
+WHERE c.manager_id = x2b(sqlc.arg(manager_id)) AND c.deleted_at IS NULL
+  AND (sqlc.narg(person_id)::text IS NULL OR c.person_id = x2b(sqlc.narg(person_id)))
+  AND (sqlc.narg(theme_id)::text IS NULL OR EXISTS (
+      SELECT 1 FROM conversation_theme ct
+      WHERE ct.conversation_id = c.id AND ct.theme_id = x2b(sqlc.narg(theme_id))
+  ))
+  AND (sqlc.narg(occurred_from)::timestamptz IS NULL OR c.occurred_at >= sqlc.narg(occurred_from))
+  AND (sqlc.narg(occurred_before)::timestamptz IS NULL OR c.occurred_at < sqlc.narg(occurred_before))
+ORDER BY c.occurred_at DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountConversations :one
+SELECT COUNT(*)
+FROM conversation c
+WHERE c.manager_id = x2b(sqlc.arg(manager_id)) AND c.deleted_at IS NULL
+  AND (sqlc.narg(person_id)::text IS NULL OR c.person_id = x2b(sqlc.narg(person_id)))
+  AND (sqlc.narg(theme_id)::text IS NULL OR EXISTS (
+      SELECT 1 FROM conversation_theme ct
+      WHERE ct.conversation_id = c.id AND ct.theme_id = x2b(sqlc.narg(theme_id))
+  ))
+  AND (sqlc.narg(occurred_from)::timestamptz IS NULL OR c.occurred_at >= sqlc.narg(occurred_from))
+  AND (sqlc.narg(occurred_before)::timestamptz IS NULL OR c.occurred_at < sqlc.narg(occurred_before));
+
+-- name: UpdateConversation :one
+UPDATE conversation
+SET person_id = x2b(sqlc.arg(person_id)),
+    description = sqlc.arg(description),
+    occurred_at = sqlc.arg(occurred_at),
+    updated_at = NOW()
+WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL
+RETURNING sqlc.embed(conversation);
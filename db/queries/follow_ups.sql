@@ -0,0 +1,40 @@
+-- name: CreateFollowUp :one
+INSERT INTO follow_up (id, conversation_id, manager_id, description, owner, due_on)
+SELECT x2b(sqlc.arg(id)), c.id, c.manager_id, sqlc.arg(description), sqlc.arg(owner), sqlc.narg(due_on)
+FROM conversation c
+WHERE c.id = x2b(sqlc.arg(conversation_id)) AND c.manager_id = x2b(sqlc.arg(manager_id)) AND c.deleted_at IS NULL
+RETURNING sqlc.embed(follow_up);
+
+-- name: GetFollowUpByID :one
+SELECT sqlc.embed(f), b2x(c.person_id) AS person_id, p.name AS person_name
+FROM follow_up f
+JOIN conversation c ON c.id = f.conversation_id
+JOIN person p ON p.id = c.person_id
+WHERE f.id = x2b(sqlc.arg(id)) AND f.manager_id = x2b(sqlc.arg(manager_id)) AND c.deleted_at IS NULL;
+
+-- name: ListFollowUps :many
+SELECT sqlc.embed(f), b2x(c.person_id) AS person_id, p.name AS person_name
+FROM follow_up f
+JOIN conversation c ON c.id = f.conversation_id
+JOIN person p ON p.id = c.person_id
+WHERE f.manager_id = x2b(sqlc.arg(manager_id)) AND c.deleted_at IS NULL
+  AND (sqlc.narg(person_id)::text IS NULL OR c.person_id = x2b(sqlc.narg(person_id)))
+  AND (sqlc.narg(conversation_id)::text IS NULL OR f.conversation_id = x2b(sqlc.narg(conversation_id)))
+  AND (sqlc.narg(done)::boolean IS NULL OR (f.completed_at IS NOT NULL) = sqlc.narg(done))
+ORDER BY f.completed_at IS NOT NULL, f.due_on ASC NULLS LAST, f.created_at ASC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: CountFollowUps :one
+SELECT COUNT(*)
+FROM follow_up f
+JOIN conversation c ON c.id = f.conversation_id
+WHERE f.manager_id = x2b(sqlc.arg(manager_id)) AND c.deleted_at IS NULL
+  AND (sqlc.narg(person_id)::text IS NULL OR c.person_id = x2b(sqlc.narg(person_id)))
+  AND (sqlc.narg(conversation_id)::text IS NULL OR f.conversation_id = x2b(sqlc.narg(conversation_id)))
+  AND (sqlc.narg(done)::boolean IS NULL OR (f.completed_at IS NOT NULL) = sqlc.narg(done));
+
+-- name: CompleteFollowUp :execrows
+UPDATE follow_up
+SET completed_at = COALESCE(completed_at, NOW())
+WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id))
+  AND EXISTS (SELECT 1 FROM conversation c WHERE c.id = follow_up.conversation_id AND c.deleted_at IS NULL);
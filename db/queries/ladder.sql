@@ -0,0 +1,44 @@
+-- name: UpsertLadderLevel :one
+INSERT INTO ladder_level (id, manager_id, name, rank, description)
+VALUES (x2b(sqlc.arg(id)), x2b(sqlc.arg(manager_id)), sqlc.arg(name), sqlc.arg(rank), sqlc.narg(description))
+ON CONFLICT (manager_id, name)
+DO UPDATE SET rank = EXCLUDED.rank, description = EXCLUDED.description, updated_at = NOW()
+RETURNING sqlc.embed(ladder_level);
+
+-- name: UpsertLevelExpectation :exec
+INSERT INTO level_expectation (id, level_id, theme_id, description)
+VALUES (x2b(sqlc.arg(id)), x2b(sqlc.arg(level_id)), x2b(sqlc.arg(theme_id)), sqlc.arg(description))
+ON CONFLICT (level_id, theme_id)
+DO UPDATE SET description = EXCLUDED.description, updated_at = NOW();
+
+-- name: GetLadderLevelByID :one
+SELECT sqlc.embed(ladder_level)
+FROM ladder_level
+WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id));
+
+-- name: ListLadderLevels :many
+SELECT sqlc.embed(ladder_level)
+FROM ladder_level
+WHERE manager_id = x2b(sqlc.arg(manager_id))
+ORDER BY rank, name;
+
+-- name: ListLevelExpectations :many
+SELECT sqlc.embed(e), t.text AS theme_text
+FROM level_expectation e
+JOIN ladder_level l ON l.id = e.level_id
+JOIN theme t ON t.id = e.theme_id
+WHERE l.manager_id = x2b(sqlc.arg(manager_id)) AND t.deleted_at IS NULL
+  AND (sqlc.narg(level_id)::text IS NULL OR e.level_id = x2b(sqlc.narg(level_id)))
+ORDER BY l.rank, t.text;
+
+-- name: GetPersonLevels :one
+SELECT current_level_id, target_level_id
+FROM person
+WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL;
+
+-- name: SetPersonLevels :execrows
+UPDATE person
+SET current_level_id = x2b(sqlc.narg(current_level_id)),
+    target_level_id = x2b(sqlc.narg(target_level_id)),
+    updated_at = NOW()
+WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL;
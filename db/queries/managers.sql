@@ -0,0 +1,9 @@
+-- name: CreateManager :one
+INSERT INTO manager (id, email, name, password_hash)
+VALUES (x2b(sqlc.arg(id)), sqlc.arg(email), sqlc.arg(name), sqlc.arg(password_hash))
+RETURNING sqlc.embed(manager);
+
+-- name: GetManagerByEmail :one
+SELECT sqlc.embed(manager)
+FROM manager
+WHERE email = sqlc.arg(email);
@@ -1,16 +1,19 @@
 -- name: CreatePerson :one
-INSERT INTO person (id, name)
-VALUES (x2b(sqlc.arg(id)), sqlc.arg(name))
-RETURNING b2x(id) as id, name, created_at, updated_at;
+INSERT INTO person (id, name, manager_id, reports_to, cadence_days)
+VALUES (x2b(sqlc.arg(id)), sqlc.arg(name), x2b(sqlc.arg(manager_id)), x2b(sqlc.narg(reports_to)), sqlc.narg(cadence_days))
+RETURNING b2x(id) as id, name, COALESCE(b2x(reports_to), '') AS reports_to, cadence_days, person_next_due_at(id, cadence_days, created_at) AS next_due_at, created_at, updated_at;
 
 -- name: GetPersonByID :one
-SELECT b2x(id) as id, name, created_at, updated_at
+SELECT b2x(id) as id, name, COALESCE(b2x(reports_to), '') AS reports_to, cadence_days, person_next_due_at(id, cadence_days, created_at) AS next_due_at, created_at, updated_at
 FROM person
-WHERE id = x2b(sqlc.arg(id));
+WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL;
 
 -- name: ListPersons :many
-SELECT b2x(id) as id, name, created_at, updated_at
+SELECT b2x(id) as id, name, COALESCE(b2x(reports_to), '') AS reports_to, cadence_days, person_next_due_at(id, cadence_days, created_at) AS next_due_at, created_at, updated_at
 FROM person
+WHERE manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL
+  AND (sqlc.narg(overdue)::boolean IS NULL
+       OR COALESCE(person_next_due_at(id, cadence_days, created_at) < NOW(), FALSE) = sqlc.narg(overdue))
 ORDER BY created_at DESC
 LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
@@ -20,6 +23,8 @@ SELECT
     p.name,
     p.created_at,
     p.updated_at,
+    p.cadence_days,
+    person_next_due_at(p.id, p.cadence_days, p.created_at) AS next_due_at,
     COALESCE(la.description, '') AS last_action_desc,
     COALESCE(la.occurred_at, '0001-01-01T00:00:00Z'::timestamptz) AS last_action_at,
     COALESCE(lc.description, '') AS last_conversation_desc,
@@ -28,42 +33,51 @@ FROM person p
 LEFT JOIN LATERAL (
     SELECT description, occurred_at
     FROM action
-    WHERE person_id = p.id
+    WHERE person_id = p.id AND deleted_at IS NULL
     ORDER BY occurred_at DESC
     LIMIT 1
 ) la ON TRUE
 LEFT JOIN LATERAL (
     SELECT description, occurred_at
     FROM conversation
-    WHERE person_id = p.id
+    WHERE person_id = p.id AND deleted_at IS NULL
     ORDER BY occurred_at DESC
     LIMIT 1
 ) lc ON TRUE
+WHERE p.manager_id = x2b(sqlc.arg(manager_id)) AND p.deleted_at IS NULL
+  AND (sqlc.narg(reports_to)::text IS NULL OR p.reports_to = x2b(sqlc.narg(reports_to)))
+  AND (sqlc.narg(overdue)::boolean IS NULL
+       OR COALESCE(person_next_due_at(p.id, p.cadence_days, p.created_at) < NOW(), FALSE) = sqlc.narg(overdue))
 ORDER BY p.created_at DESC
 LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
 -- name: CountPersons :one
-SELECT COUNT(*) FROM person;
+SELECT COUNT(*)
+FROM person
+WHERE manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL
+  AND (sqlc.narg(overdue)::boolean IS NULL
+       OR COALESCE(person_next_due_at(id, cadence_days, created_at) < NOW(), FALSE) = sqlc.narg(overdue));
 
 -- name: UpdatePerson :one
 UPDATE person
-SET name = sqlc.arg(name), updated_at = NOW()
-WHERE id = x2b(sqlc.arg(id))
-RETURNING b2x(id) as id, name, created_at, updated_at;
-
--- name: DeletePerson :exec
-DELETE FROM person
-WHERE id = x2b(sqlc.arg(id));
+SET name = sqlc.arg(name), reports_to = x2b(sqlc.narg(reports_to)), cadence_days = sqlc.narg(cadence_days), updated_at = NOW()
+WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL
+RETURNING b2x(id) as id, name, COALESCE(b2x(reports_to), '') AS reports_to, cadence_days, person_next_due_at(id, cadence_days, created_at) AS next_due_at, created_at, updated_at;
 
 -- name: GetPersonByName :one
 SELECT b2x(id) as id, name, created_at, updated_at
 FROM person
-WHERE name = sqlc.arg(name);
+WHERE name = sqlc.arg(name) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL;
 
 -- name: SearchPersonsByName :many
 SELECT b2x(id) as id, name, created_at, updated_at
 FROM person
-WHERE name ILIKE '%' || sqlc.arg('search') || '%'
+WHERE name ILIKE '%' || sqlc.arg('search') || '%' AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL
 ORDER BY name
 LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
+-- name: ListOrgChart :many
+SELECT b2x(id) as id, name, COALESCE(b2x(reports_to), '') AS reports_to
+FROM person
+WHERE manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL
+ORDER BY name;
@@ -0,0 +1,92 @@
+-- name: RecordActionRevision :exec
+-- Snapshots the action as it now stands. Nothing is written when the content
+-- matches the latest revision, so saving an unchanged form adds no history.
+WITH snapshot AS (
+    SELECT a.id AS action_id, a.manager_id, a.description, a.valence, a.impact, a.occurred_at,
+           COALESCE((
+               SELECT JSONB_AGG(JSONB_BUILD_OBJECT('kind', ar.kind, 'value', ar.value, 'title', ar.title) ORDER BY ar.position)
+               FROM action_reference ar
+               WHERE ar.action_id = a.id
+           ), '[]') AS refs,
+           COALESCE(t.ids, '{}') AS theme_ids,
+           COALESCE(t.texts, '{}') AS theme_texts
+    FROM action a
+    LEFT JOIN LATERAL (
+        SELECT ARRAY_AGG(b2x(th.id) ORDER BY th.id) AS ids, ARRAY_AGG(th.text ORDER BY th.id) AS texts
+        FROM action_theme at
+        JOIN theme th ON th.id = at.theme_id
+        WHERE at.action_id = a.id AND th.deleted_at IS NULL
+    ) t ON TRUE
+    WHERE a.id = x2b(sqlc.arg(action_id)) AND a.manager_id = x2b(sqlc.arg(manager_id))
+), latest AS (
+    SELECT r.description, r.valence, r.impact, r.occurred_at, r."references", r.theme_ids
+    FROM action_revision r
+    WHERE r.action_id = x2b(sqlc.arg(action_id))
+    ORDER BY r.created_at DESC, r.id DESC
+    LIMIT 1
+)
+INSERT INTO action_revision (id, action_id, manager_id, description, valence, impact, occurred_at, "references", theme_ids, theme_texts)
+SELECT x2b(sqlc.arg(id)), s.action_id, s.manager_id, s.description, s.valence, s.impact, s.occurred_at, s.refs, s.theme_ids, s.theme_texts
+FROM snapshot s
+WHERE NOT EXISTS (
+    SELECT 1 FROM latest l
+    WHERE l.description = s.description AND l.valence = s.valence AND l.impact IS NOT DISTINCT FROM s.impact
+      AND l.occurred_at = s.occurred_at AND l."references" = s.refs AND l.theme_ids = s.theme_ids
+);
+
+-- name: ListActionRevisions :many
+-- Oldest first, the order changes are worked out in.
+SELECT b2x(r.id) AS id, r.description, r.valence, r.impact, r.occurred_at, r."references", r.theme_ids, r.theme_texts, r.created_at
+FROM action_revision r
+JOIN action a ON a.id = r.action_id
+WHERE r.action_id = x2b(sqlc.arg(action_id)) AND a.manager_id = x2b(sqlc.arg(manager_id)) AND a.deleted_at IS NULL
+ORDER BY r.created_at, r.id;
+
+-- name: GetActionRevision :one
+SELECT b2x(r.id) AS id, r.description, r.valence, r.impact, r.occurred_at, r."references", r.theme_ids, r.theme_texts, r.created_at
+FROM action_revision r
+JOIN action a ON a.id = r.action_id
+WHERE r.id = x2b(sqlc.arg(id)) AND r.action_id = x2b(sqlc.arg(action_id))
+  AND a.manager_id = x2b(sqlc.arg(manager_id)) AND a.deleted_at IS NULL;
+
+-- name: RecordConversationRevision :exec
+WITH snapshot AS (
+    SELECT c.id AS conversation_id, c.manager_id, c.description, c.occurred_at,
+           COALESCE(t.ids, '{}') AS theme_ids,
+           COALESCE(t.texts, '{}') AS theme_texts
+    FROM conversation c
+    LEFT JOIN LATERAL (
+        SELECT ARRAY_AGG(b2x(th.id) ORDER BY th.id) AS ids, ARRAY_AGG(th.text ORDER BY th.id) AS texts
+        FROM conversation_theme ct
+        JOIN theme th ON th.id = ct.theme_id
+        WHERE ct.conversation_id = c.id AND th.deleted_at IS NULL
+    ) t ON TRUE
+    WHERE c.id = x2b(sqlc.arg(conversation_id)) AND c.manager_id = x2b(sqlc.arg(manager_id))
+), latest AS (
+    SELECT r.description, r.occurred_at, r.theme_ids
+    FROM conversation_revision r
+    WHERE r.conversation_id = x2b(sqlc.arg(conversation_id))
+    ORDER BY r.created_at DESC, r.id DESC
+    LIMIT 1
+)
+INSERT INTO conversation_revision (id, conversation_id, manager_id, description, occurred_at, theme_ids, theme_texts)
+SELECT x2b(sqlc.arg(id)), s.conversation_id, s.manager_id, s.description, s.occurred_at, s.theme_ids, s.theme_texts
+FROM snapshot s
+WHERE NOT EXISTS (
+    SELECT 1 FROM latest l
+    WHERE l.description = s.description AND l.occurred_at = s.occurred_at AND l.theme_ids = s.theme_ids
+);
+
+-- name: ListConversationRevisions :many
+SELECT b2x(r.id) AS id, r.description, r.occurred_at, r.theme_ids, r.theme_texts, r.created_at
+FROM conversation_revision r
+JOIN conversation c ON c.id = r.conversation_id
+WHERE r.conversation_id = x2b(sqlc.arg(conversation_id)) AND c.manager_id = x2b(sqlc.arg(manager_id)) AND c.deleted_at IS NULL
+ORDER BY r.created_at, r.id;
+
+-- name: GetConversationRevision :one
+SELECT b2x(r.id) AS id, r.description, r.occurred_at, r.theme_ids, r.theme_texts, r.created_at
+FROM conversation_revision r
+JOIN conversation c ON c.id = r.conversation_id
+WHERE r.id = x2b(sqlc.arg(id)) AND r.conversation_id = x2b(sqlc.arg(conversation_id))
+  AND c.manager_id = x2b(sqlc.arg(manager_id)) AND c.deleted_at IS NULL;
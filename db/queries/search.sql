@@ -0,0 +1,65 @@
+-- name: Search :many
+-- The tsvector expressions match the GIN indexes in the add_search_indexes
+-- migration. Matches are wrapped in \x02 and \x03 so callers can highlight
+-- them without trusting any markup in the stored text.
+WITH q AS (
+    SELECT websearch_to_tsquery('english', sqlc.arg(query)) AS query
+),
+hits AS (
+    SELECT 'action' AS kind,
+           a.id,
+           a.person_id,
+           a.occurred_at,
+           ts_rank(to_tsvector('english', a.description), q.query) + COALESCE(r.rank, 0) AS rank,
+           a.description || COALESCE(' · ' || r.text, '') AS body
+    FROM action a
+    CROSS JOIN q
+    LEFT JOIN LATERAL (
+        SELECT STRING_AGG(COALESCE(ar.title || ' ', '') || ar.value, ' · ' ORDER BY ar.position) AS text,
+               MAX(ts_rank(to_tsvector('english', COALESCE(ar.title, '') || ' ' || ar.value), q.query)) AS rank
+        FROM action_reference ar
+        WHERE ar.action_id = a.id
+          AND to_tsvector('english', COALESCE(ar.title, '') || ' ' || ar.value) @@ q.query
+    ) r ON TRUE
+    WHERE a.manager_id = x2b(sqlc.arg(manager_id)) AND a.deleted_at IS NULL
+      AND (to_tsvector('english', a.description) @@ q.query OR r.text IS NOT NULL)
+    UNION ALL
+    SELECT 'conversation',
+           c.id,
+           c.person_id,
+           c.occurred_at,
+           ts_rank(to_tsvector('english', c.description), q.query),
+           c.description
+    FROM conversation c
+    CROSS JOIN q
+    WHERE c.manager_id = x2b(sqlc.arg(manager_id)) AND c.deleted_at IS NULL
+      AND to_tsvector('english', c.description) @@ q.query
+    UNION ALL
+    SELECT 'theme',
+           t.id,
+           t.person_id,
+           t.created_at,
+           ts_rank(to_tsvector('english', t.text || ' ' || COALESCE(t.description, '')), q.query),
+           t.text || COALESCE(' · ' || t.description, '')
+    FROM theme t
+    CROSS JOIN q
+    WHERE t.manager_id = x2b(sqlc.arg(manager_id)) AND t.deleted_at IS NULL
+      AND to_tsvector('english', t.text || ' ' || COALESCE(t.description, '')) @@ q.query
+)
+SELECT h.kind,
+       b2x(h.id) AS id,
+       COALESCE(b2x(h.person_id), '') AS person_id,
+       COALESCE(p.name, '') AS person_name,
+       h.occurred_at,
+       h.rank::REAL AS rank,
+       ts_headline('english', h.body, q.query,
+                   'StartSel=' || CHR(2) || ', StopSel=' || CHR(3) || ', MaxWords=30, MinWords=10, MaxFragments=2') AS snippet
+FROM hits h
+CROSS JOIN q
+LEFT JOIN person p ON p.id = h.person_id
+WHERE (sqlc.narg(kind)::TEXT IS NULL OR h.kind = sqlc.narg(kind))
+  AND (sqlc.narg(person_id)::TEXT IS NULL OR h.person_id = x2b(sqlc.narg(person_id)))
+  AND (sqlc.narg(since)::TIMESTAMPTZ IS NULL OR h.occurred_at >= sqlc.narg(since))
+  AND (sqlc.narg(before)::TIMESTAMPTZ IS NULL OR h.occurred_at < sqlc.narg(before))
+ORDER BY h.rank DESC, h.occurred_at DESC
+LIMIT sqlc.arg('limit');
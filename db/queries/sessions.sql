@@ -0,0 +1,17 @@
+-- name: CreateSession :exec
+INSERT INTO manager_session (token_hash, manager_id, expires_at)
+VALUES (sqlc.arg(token_hash), x2b(sqlc.arg(manager_id)), sqlc.arg(expires_at));
+
+-- name: GetManagerBySessionToken :one
+SELECT sqlc.embed(manager)
+FROM manager_session s
+JOIN manager ON manager.id = s.manager_id
+WHERE s.token_hash = sqlc.arg(token_hash) AND s.expires_at > NOW();
+
+-- name: DeleteSession :exec
+DELETE FROM manager_session
+WHERE token_hash = sqlc.arg(token_hash);
+
+-- name: DeleteExpiredSessions :exec
+DELETE FROM manager_session
+WHERE expires_at <= NOW();
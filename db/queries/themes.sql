@@ -1,26 +1,71 @@
 -- name: CreateTheme :one
-INSERT INTO theme (id, person_id, text)
-VALUES (x2b(sqlc.arg(id)), x2b(sqlc.arg(person_id)), sqlc.arg(text))
+INSERT INTO theme (id, person_id, text, description, manager_id)
+VALUES (x2b(sqlc.arg(id)), x2b(sqlc.narg(person_id)), sqlc.arg(text), sqlc.narg(description), x2b(sqlc.arg(manager_id)))
+RETURNING sqlc.embed(theme);
+
+-- name: UpsertFrameworkTheme :one
+INSERT INTO theme (id, person_id, text, description, manager_id)
+VALUES (x2b(sqlc.arg(id)), NULL, sqlc.arg(text), sqlc.narg(description), x2b(sqlc.arg(manager_id)))
+ON CONFLICT (manager_id, LOWER(text)) WHERE person_id IS NULL AND deleted_at IS NULL
+DO UPDATE SET description = EXCLUDED.description, updated_at = NOW()
 RETURNING sqlc.embed(theme);
 
 -- name: GetThemeByID :one
 SELECT sqlc.embed(theme)
 FROM theme
-WHERE id = x2b(sqlc.arg(id));
+WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL;
 
 -- name: ListThemes :many
 SELECT sqlc.embed(theme)
 FROM theme
+WHERE manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL
 ORDER BY created_at DESC
 LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
 -- name: ListThemesByPersonID :many
 SELECT sqlc.embed(theme)
 FROM theme
-WHERE person_id = x2b(sqlc.arg(person_id))
-ORDER BY created_at DESC
+WHERE (person_id = x2b(sqlc.arg(person_id)) OR person_id IS NULL) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL
+ORDER BY person_id IS NULL DESC, created_at DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: ListThemesWithCounts :many
+SELECT
+    sqlc.embed(t),
+    (SELECT COUNT(*) FROM action_theme at JOIN action a ON a.id = at.action_id
+     WHERE at.theme_id = t.id AND a.deleted_at IS NULL) AS action_count,
+    (SELECT COUNT(*) FROM conversation_theme ct JOIN conversation c ON c.id = ct.conversation_id
+     WHERE ct.theme_id = t.id AND c.deleted_at IS NULL) AS conversation_count
+FROM theme t
+WHERE t.manager_id = x2b(sqlc.arg(manager_id)) AND t.deleted_at IS NULL
+  AND (sqlc.narg(person_id)::text IS NULL OR t.person_id = x2b(sqlc.narg(person_id)))
+  AND (sqlc.arg(include_archived)::boolean OR t.archived_at IS NULL)
+  AND (sqlc.narg(framework)::boolean IS NULL OR (t.person_id IS NULL) = sqlc.narg(framework))
+ORDER BY t.text
 LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
 
--- name: DeleteTheme :exec
-DELETE FROM theme
-WHERE id = x2b(sqlc.arg(id));
+-- name: CountThemes :one
+SELECT COUNT(*)
+FROM theme t
+WHERE t.manager_id = x2b(sqlc.arg(manager_id)) AND t.deleted_at IS NULL
+  AND (sqlc.narg(person_id)::text IS NULL OR t.person_id = x2b(sqlc.narg(person_id)))
+  AND (sqlc.arg(include_archived)::boolean OR t.archived_at IS NULL)
+  AND (sqlc.narg(framework)::boolean IS NULL OR (t.person_id IS NULL) = sqlc.narg(framework));
+
+-- name: GetThemeWithCounts :one
+SELECT
+    sqlc.embed(t),
+    (SELECT COUNT(*) FROM action_theme at JOIN action a ON a.id = at.action_id
+     WHERE at.theme_id = t.id AND a.deleted_at IS NULL) AS action_count,
+    (SELECT COUNT(*) FROM conversation_theme ct JOIN conversation c ON c.id = ct.conversation_id
+     WHERE ct.theme_id = t.id AND c.deleted_at IS NULL) AS conversation_count
+FROM theme t
+WHERE t.id = x2b(sqlc.arg(id)) AND t.manager_id = x2b(sqlc.arg(manager_id)) AND t.deleted_at IS NULL;
+
+-- name: UpdateTheme :one
+UPDATE theme
+SET text = sqlc.arg(text),
+    archived_at = CASE WHEN sqlc.arg(archived)::boolean THEN COALESCE(archived_at, NOW()) ELSE NULL END,
+    updated_at = NOW()
+WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL
+RETURNING sqlc.embed(theme);
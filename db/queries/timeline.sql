@@ -0,0 +1,138 @@
+-- name: ListTimelineByPersonID :many
+-- One page of a person's actions and conversations, newest first, with the
+-- themes of each entry aggregated alongside it. theme_ids and theme_texts are
+-- built in the same order so they can be zipped back together. A valence
+-- filter only matches actions.
+WITH page AS (
+    SELECT 'action' AS kind,
+           a.id,
+           a.occurred_at,
+           a.description,
+           a.valence::TEXT AS valence,
+           a.created_at,
+           a.updated_at
+    FROM action a
+    WHERE a.person_id = x2b(sqlc.arg(person_id)) AND a.manager_id = x2b(sqlc.arg(manager_id))
+      AND a.deleted_at IS NULL
+      AND (sqlc.narg(kind)::TEXT IS NULL OR sqlc.narg(kind) = 'action')
+      AND (sqlc.narg(valence)::TEXT IS NULL OR a.valence::TEXT = sqlc.narg(valence))
+      AND (sqlc.narg(theme_id)::TEXT IS NULL OR EXISTS (
+          SELECT 1 FROM action_theme atm
+          WHERE atm.action_id = a.id AND atm.theme_id = x2b(sqlc.narg(theme_id))))
+      AND (sqlc.narg(since)::TIMESTAMPTZ IS NULL OR a.occurred_at >= sqlc.narg(since))
+      AND (sqlc.narg(before)::TIMESTAMPTZ IS NULL OR a.occurred_at < sqlc.narg(before))
+      AND (sqlc.narg(cursor_at)::TIMESTAMPTZ IS NULL
+           OR (a.occurred_at, a.id) < (sqlc.narg(cursor_at), x2b(sqlc.narg(cursor_id))))
+    UNION ALL
+    SELECT 'conversation',
+           c.id,
+           c.occurred_at,
+           c.description,
+           NULL,
+           c.created_at,
+           c.updated_at
+    FROM conversation c
+    WHERE c.person_id = x2b(sqlc.arg(person_id)) AND c.manager_id = x2b(sqlc.arg(manager_id))
+      AND c.deleted_at IS NULL
+      AND (sqlc.narg(kind)::TEXT IS NULL OR sqlc.narg(kind) = 'conversation')
+      AND sqlc.narg(valence)::TEXT IS NULL
+      AND (sqlc.narg(theme_id)::TEXT IS NULL OR EXISTS (
+          SELECT 1 FROM conversation_theme ctm
+          WHERE ctm.conversation_id = c.id AND ctm.theme_id = x2b(sqlc.narg(theme_id))))
+      AND (sqlc.narg(since)::TIMESTAMPTZ IS NULL OR c.occurred_at >= sqlc.narg(since))
+      AND (sqlc.narg(before)::TIMESTAMPTZ IS NULL OR c.occurred_at < sqlc.narg(before))
+      AND (sqlc.narg(cursor_at)::TIMESTAMPTZ IS NULL
+           OR (c.occurred_at, c.id) < (sqlc.narg(cursor_at), x2b(sqlc.narg(cursor_id))))
+    ORDER BY occurred_at DESC, id DESC
+    LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset')
+)
+SELECT p.kind,
+       b2x(p.id) AS id,
+       p.occurred_at,
+       p.description,
+       COALESCE(p.valence, '') AS valence,
+       p.created_at,
+       p.updated_at,
+       COALESCE(t.ids, '{}')::TEXT[] AS theme_ids,
+       COALESCE(t.texts, '{}')::TEXT[] AS theme_texts
+FROM page p
+LEFT JOIN LATERAL (
+    SELECT ARRAY_AGG(b2x(th.id) ORDER BY th.created_at DESC) AS ids,
+           ARRAY_AGG(th.text ORDER BY th.created_at DESC) AS texts
+    FROM theme th
+    WHERE th.manager_id = x2b(sqlc.arg(manager_id)) AND th.deleted_at IS NULL
+      AND th.id IN (
+          SELECT at.theme_id FROM action_theme at
+          WHERE p.kind = 'action' AND at.action_id = p.id
+          UNION ALL
+          SELECT ct.theme_id FROM conversation_theme ct
+          WHERE p.kind = 'conversation' AND ct.conversation_id = p.id
+      )
+) t ON TRUE
+ORDER BY p.occurred_at DESC, p.id DESC;
+
+-- name: CountTimelineByPersonID :one
+-- Takes the same filters as ListTimelineByPersonID.
+WITH entries AS (
+    SELECT a.id
+    FROM action a
+    WHERE a.person_id = x2b(sqlc.arg(person_id)) AND a.manager_id = x2b(sqlc.arg(manager_id))
+      AND a.deleted_at IS NULL
+      AND (sqlc.narg(kind)::TEXT IS NULL OR sqlc.narg(kind) = 'action')
+      AND (sqlc.narg(valence)::TEXT IS NULL OR a.valence::TEXT = sqlc.narg(valence))
+      AND (sqlc.narg(theme_id)::TEXT IS NULL OR EXISTS (
+          SELECT 1 FROM action_theme atm
+          WHERE atm.action_id = a.id AND atm.theme_id = x2b(sqlc.narg(theme_id))))
+      AND (sqlc.narg(since)::TIMESTAMPTZ IS NULL OR a.occurred_at >= sqlc.narg(since))
+      AND (sqlc.narg(before)::TIMESTAMPTZ IS NULL OR a.occurred_at < sqlc.narg(before))
+    UNION ALL
+    SELECT c.id
+    FROM conversation c
+    WHERE c.person_id = x2b(sqlc.arg(person_id)) AND c.manager_id = x2b(sqlc.arg(manager_id))
+      AND c.deleted_at IS NULL
+      AND (sqlc.narg(kind)::TEXT IS NULL OR sqlc.narg(kind) = 'conversation')
+      AND sqlc.narg(valence)::TEXT IS NULL
+      AND (sqlc.narg(theme_id)::TEXT IS NULL OR EXISTS (
+          SELECT 1 FROM conversation_theme ctm
+          WHERE ctm.conversation_id = c.id AND ctm.theme_id = x2b(sqlc.narg(theme_id))))
+      AND (sqlc.narg(since)::TIMESTAMPTZ IS NULL OR c.occurred_at >= sqlc.narg(since))
+      AND (sqlc.narg(before)::TIMESTAMPTZ IS NULL OR c.occurred_at < sqlc.narg(before))
+)
+SELECT COUNT(*) AS total
+FROM entries;
+
+-- name: ListTimelineGroups :many
+-- Summarises the filtered timeline by period (week, month or quarter), taking
+-- periods in UTC so they line up with the dates the API reports.
+WITH entries AS (
+    SELECT a.occurred_at, a.valence::TEXT AS valence
+    FROM action a
+    WHERE a.person_id = x2b(sqlc.arg(person_id)) AND a.manager_id = x2b(sqlc.arg(manager_id))
+      AND a.deleted_at IS NULL
+      AND (sqlc.narg(kind)::TEXT IS NULL OR sqlc.narg(kind) = 'action')
+      AND (sqlc.narg(valence)::TEXT IS NULL OR a.valence::TEXT = sqlc.narg(valence))
+      AND (sqlc.narg(theme_id)::TEXT IS NULL OR EXISTS (
+          SELECT 1 FROM action_theme atm
+          WHERE atm.action_id = a.id AND atm.theme_id = x2b(sqlc.narg(theme_id))))
+      AND (sqlc.narg(since)::TIMESTAMPTZ IS NULL OR a.occurred_at >= sqlc.narg(since))
+      AND (sqlc.narg(before)::TIMESTAMPTZ IS NULL OR a.occurred_at < sqlc.narg(before))
+    UNION ALL
+    SELECT c.occurred_at, NULL
+    FROM conversation c
+    WHERE c.person_id = x2b(sqlc.arg(person_id)) AND c.manager_id = x2b(sqlc.arg(manager_id))
+      AND c.deleted_at IS NULL
+      AND (sqlc.narg(kind)::TEXT IS NULL OR sqlc.narg(kind) = 'conversation')
+      AND sqlc.narg(valence)::TEXT IS NULL
+      AND (sqlc.narg(theme_id)::TEXT IS NULL OR EXISTS (
+          SELECT 1 FROM conversation_theme ctm
+          WHERE ctm.conversation_id = c.id AND ctm.theme_id = x2b(sqlc.narg(theme_id))))
+      AND (sqlc.narg(since)::TIMESTAMPTZ IS NULL OR c.occurred_at >= sqlc.narg(since))
+      AND (sqlc.narg(before)::TIMESTAMPTZ IS NULL OR c.occurred_at < sqlc.narg(before))
+)
+SELECT DATE_TRUNC(sqlc.arg(period)::TEXT, e.occurred_at AT TIME ZONE 'UTC')::DATE AS period_start,
+       COUNT(*) AS total,
+       COUNT(*) FILTER (WHERE e.valence = 'positive') AS positive,
+       COUNT(*) FILTER (WHERE e.valence = 'negative') AS negative
+FROM entries e
+GROUP BY 1
+ORDER BY 1 DESC;
@@ -0,0 +1,111 @@
+-- name: TrashPerson :execrows
+-- The person's actions, conversations and themes go to the trash with them.
+-- NOW() is fixed for the statement, so everything shares one deleted_at and
+-- RestorePerson can bring back exactly that set.
+WITH trashed_actions AS (
+    UPDATE action SET deleted_at = NOW()
+    WHERE person_id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL
+), trashed_conversations AS (
+    UPDATE conversation SET deleted_at = NOW()
+    WHERE person_id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL
+), trashed_themes AS (
+    UPDATE theme SET deleted_at = NOW()
+    WHERE person_id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL
+)
+UPDATE person SET deleted_at = NOW()
+WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL;
+
+-- name: RestorePerson :execrows
+-- Anything of theirs trashed separately before the person stays in the trash.
+WITH p AS (
+    SELECT id, deleted_at FROM person
+    WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NOT NULL
+), restored_actions AS (
+    UPDATE action a SET deleted_at = NULL
+    FROM p WHERE a.person_id = p.id AND a.deleted_at = p.deleted_at
+), restored_conversations AS (
+    UPDATE conversation c SET deleted_at = NULL
+    FROM p WHERE c.person_id = p.id AND c.deleted_at = p.deleted_at
+), restored_themes AS (
+    UPDATE theme t SET deleted_at = NULL
+    FROM p WHERE t.person_id = p.id AND t.deleted_at = p.deleted_at
+)
+UPDATE person SET deleted_at = NULL
+FROM p WHERE person.id = p.id;
+
+-- name: PurgePerson :execrows
+DELETE FROM person
+WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NOT NULL;
+
+-- name: TrashAction :execrows
+UPDATE action SET deleted_at = NOW()
+WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL;
+
+-- name: RestoreAction :execrows
+-- An action cannot come back while its person is still in the trash.
+UPDATE action SET deleted_at = NULL
+WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NOT NULL
+  AND NOT EXISTS (SELECT 1 FROM person p WHERE p.id = action.person_id AND p.deleted_at IS NOT NULL);
+
+-- name: PurgeAction :execrows
+DELETE FROM action
+WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NOT NULL;
+
+-- name: TrashConversation :execrows
+UPDATE conversation SET deleted_at = NOW()
+WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL;
+
+-- name: RestoreConversation :execrows
+UPDATE conversation SET deleted_at = NULL
+WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NOT NULL
+  AND NOT EXISTS (SELECT 1 FROM person p WHERE p.id = conversation.person_id AND p.deleted_at IS NOT NULL);
+
+-- name: PurgeConversation :execrows
+DELETE FROM conversation
+WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NOT NULL;
+
+-- name: TrashTheme :execrows
+UPDATE theme SET deleted_at = NOW()
+WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NULL;
+
+-- name: RestoreTheme :execrows
+UPDATE theme SET deleted_at = NULL
+WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NOT NULL
+  AND NOT EXISTS (SELECT 1 FROM person p WHERE p.id = theme.person_id AND p.deleted_at IS NOT NULL);
+
+-- name: PurgeTheme :execrows
+DELETE FROM theme
+WHERE id = x2b(sqlc.arg(id)) AND manager_id = x2b(sqlc.arg(manager_id)) AND deleted_at IS NOT NULL;
+
+-- name: ListTrash :many
+-- Records trashed along with their person are listed under the person only.
+SELECT 'person' AS kind, b2x(p.id) AS id, p.name AS label, '' AS person_name, p.deleted_at
+FROM person p
+WHERE p.manager_id = x2b(sqlc.arg(manager_id)) AND p.deleted_at IS NOT NULL
+UNION ALL
+SELECT 'action', b2x(a.id), a.description, p.name, a.deleted_at
+FROM action a
+JOIN person p ON p.id = a.person_id
+WHERE a.manager_id = x2b(sqlc.arg(manager_id)) AND a.deleted_at IS NOT NULL AND p.deleted_at IS NULL
+UNION ALL
+SELECT 'conversation', b2x(c.id), c.description, p.name, c.deleted_at
+FROM conversation c
+JOIN person p ON p.id = c.person_id
+WHERE c.manager_id = x2b(sqlc.arg(manager_id)) AND c.deleted_at IS NOT NULL AND p.deleted_at IS NULL
+UNION ALL
+SELECT 'theme', b2x(t.id), t.text, COALESCE(p.name, ''), t.deleted_at
+FROM theme t
+LEFT JOIN person p ON p.id = t.person_id
+WHERE t.manager_id = x2b(sqlc.arg(manager_id)) AND t.deleted_at IS NOT NULL AND p.deleted_at IS NULL
+ORDER BY deleted_at DESC
+LIMIT sqlc.arg('limit') OFFSET sqlc.arg('offset');
+
+-- name: ListPersonAttachmentKeys :many
+-- Storage keys of every file attached to the person's actions and
+-- conversations, trashed or not, for removing them when the person is purged.
+SELECT att.storage_key
+FROM attachment att
+LEFT JOIN action a ON a.id = att.action_id
+LEFT JOIN conversation c ON c.id = att.conversation_id
+WHERE att.manager_id = x2b(sqlc.arg(manager_id))
+  AND (a.person_id = x2b(sqlc.arg(person_id)) OR c.person_id = x2b(sqlc.arg(person_id)));
@@ -9,16 +9,73 @@ SET xmloption = content;
 SET client_min_messages = warning;
 SET row_security = off;
 
+--
+-- Name: api_token_scope; Type: TYPE; Schema: public; Owner: -
+--
+
+CREATE TYPE public.api_token_scope AS ENUM (
+    'read',
+    'write'
+);
+
+
+--
+-- Name: follow_up_owner; Type: TYPE; Schema: public; Owner: -
+--
+
+CREATE TYPE public.follow_up_owner AS ENUM (
+    'manager',
+    'report'
+);
+
+
+--
+-- Name: impact_level; Type: TYPE; Schema: public; Owner: -
+--
+
+CREATE TYPE public.impact_level AS ENUM (
+    'low',
+    'medium',
+    'high'
+);
+
+
+--
+-- Name: reference_kind; Type: TYPE; Schema: public; Owner: -
+--
+
+CREATE TYPE public.reference_kind AS ENUM (
+    'url',
+    'ticket',
+    'pull_request',
+    'note'
+);
+
+
 --
 -- Name: valence_type; Type: TYPE; Schema: public; Owner: -
 --
 
 CREATE TYPE public.valence_type AS ENUM (
     'positive',
-    'negative'
+    'negative',
+    'neutral'
 );
 
 
+--
+-- Name: audit_log_append_only(); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.audit_log_append_only() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$;
+
+
 --
 -- Name: b2x(bytea); Type: FUNCTION; Schema: public; Owner: -
 --
@@ -73,6 +130,20 @@ END;
 $$;
 
 
+--
+-- Name: person_next_due_at(bytea, integer, timestamp with time zone); Type: FUNCTION; Schema: public; Owner: -
+--
+
+CREATE FUNCTION public.person_next_due_at(person_id bytea, cadence_days integer, created_at timestamp with time zone) RETURNS timestamp with time zone
+    LANGUAGE sql STABLE
+    AS $_$
+    SELECT COALESCE(
+        (SELECT MAX(c.occurred_at) FROM conversation c WHERE c.person_id = $1 AND c.deleted_at IS NULL),
+        $3
+    ) + make_interval(days => $2);
+$_$;
+
+
 --
 -- Name: update_updated_at_column(); Type: FUNCTION; Schema: public; Owner: -
 --
@@ -151,10 +222,12 @@ CREATE TABLE public.action (
     person_id bytea NOT NULL,
     occurred_at timestamp with time zone DEFAULT now() NOT NULL,
     description text NOT NULL,
-    "references" text,
     valence public.valence_type NOT NULL,
     created_at timestamp with time zone DEFAULT now() NOT NULL,
     updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    manager_id bytea NOT NULL,
+    impact public.impact_level,
+    deleted_at timestamp with time zone,
     CONSTRAINT action_description_check CHECK ((length(TRIM(BOTH FROM description)) > 0))
 );
 
@@ -171,6 +244,40 @@ CREATE TABLE public.action_conversation (
 );
 
 
+--
+-- Name: action_reference; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.action_reference (
+    action_id bytea NOT NULL,
+    "position" integer NOT NULL,
+    kind public.reference_kind NOT NULL,
+    value text NOT NULL,
+    title text,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT action_reference_value_check CHECK ((length(TRIM(BOTH FROM value)) > 0))
+);
+
+
+--
+-- Name: action_revision; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.action_revision (
+    id bytea NOT NULL,
+    action_id bytea NOT NULL,
+    manager_id bytea NOT NULL,
+    description text NOT NULL,
+    valence public.valence_type NOT NULL,
+    impact public.impact_level,
+    occurred_at timestamp with time zone NOT NULL,
+    "references" jsonb DEFAULT '[]'::jsonb NOT NULL,
+    theme_ids text[] DEFAULT '{}'::text[] NOT NULL,
+    theme_texts text[] DEFAULT '{}'::text[] NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
 --
 -- Name: action_theme; Type: TABLE; Schema: public; Owner: -
 --
@@ -183,6 +290,64 @@ CREATE TABLE public.action_theme (
 );
 
 
+--
+-- Name: api_token; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.api_token (
+    id bytea NOT NULL,
+    manager_id bytea NOT NULL,
+    name text NOT NULL,
+    token_hash bytea NOT NULL,
+    scope public.api_token_scope DEFAULT 'read'::public.api_token_scope NOT NULL,
+    expires_at timestamp with time zone,
+    last_used_at timestamp with time zone,
+    revoked_at timestamp with time zone,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT api_token_name_check CHECK ((length(TRIM(BOTH FROM name)) > 0))
+);
+
+
+--
+-- Name: attachment; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.attachment (
+    id bytea NOT NULL,
+    manager_id bytea NOT NULL,
+    action_id bytea,
+    conversation_id bytea,
+    filename text NOT NULL,
+    content_type text NOT NULL,
+    size_bytes bigint NOT NULL,
+    sha256 text NOT NULL,
+    storage_key text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT attachment_check CHECK (((action_id IS NULL) <> (conversation_id IS NULL))),
+    CONSTRAINT attachment_filename_check CHECK ((length(TRIM(BOTH FROM filename)) > 0)),
+    CONSTRAINT attachment_size_bytes_check CHECK ((size_bytes >= 0))
+);
+
+
+--
+-- Name: audit_log; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.audit_log (
+    id bytea NOT NULL,
+    manager_id bytea NOT NULL,
+    operation text NOT NULL,
+    entity_ids text[] DEFAULT '{}'::text[] NOT NULL,
+    auth_method text NOT NULL,
+    method text DEFAULT ''::text NOT NULL,
+    path text DEFAULT ''::text NOT NULL,
+    remote_addr text DEFAULT ''::text NOT NULL,
+    user_agent text DEFAULT ''::text NOT NULL,
+    outcome text DEFAULT ''::text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
 --
 -- Name: conversation; Type: TABLE; Schema: public; Owner: -
 --
@@ -194,10 +359,28 @@ CREATE TABLE public.conversation (
     created_at timestamp with time zone DEFAULT now() NOT NULL,
     updated_at timestamp with time zone DEFAULT now() NOT NULL,
     person_id bytea NOT NULL,
+    manager_id bytea NOT NULL,
+    deleted_at timestamp with time zone,
     CONSTRAINT conversation_description_check CHECK ((length(TRIM(BOTH FROM description)) > 0))
 );
 
 
+--
+-- Name: conversation_revision; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.conversation_revision (
+    id bytea NOT NULL,
+    conversation_id bytea NOT NULL,
+    manager_id bytea NOT NULL,
+    description text NOT NULL,
+    occurred_at timestamp with time zone NOT NULL,
+    theme_ids text[] DEFAULT '{}'::text[] NOT NULL,
+    theme_texts text[] DEFAULT '{}'::text[] NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
 --
 -- Name: conversation_theme; Type: TABLE; Schema: public; Owner: -
 --
@@ -210,6 +393,83 @@ CREATE TABLE public.conversation_theme (
 );
 
 
+--
+-- Name: follow_up; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.follow_up (
+    id bytea NOT NULL,
+    conversation_id bytea NOT NULL,
+    manager_id bytea NOT NULL,
+    description text NOT NULL,
+    owner public.follow_up_owner NOT NULL,
+    due_on date,
+    completed_at timestamp with time zone,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT follow_up_description_check CHECK ((length(TRIM(BOTH FROM description)) > 0))
+);
+
+
+--
+-- Name: ladder_level; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.ladder_level (
+    id bytea NOT NULL,
+    manager_id bytea NOT NULL,
+    name text NOT NULL,
+    rank integer NOT NULL,
+    description text,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT ladder_level_name_check CHECK ((length(TRIM(BOTH FROM name)) > 0))
+);
+
+
+--
+-- Name: level_expectation; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.level_expectation (
+    id bytea NOT NULL,
+    level_id bytea NOT NULL,
+    theme_id bytea NOT NULL,
+    description text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT level_expectation_description_check CHECK ((length(TRIM(BOTH FROM description)) > 0))
+);
+
+
+--
+-- Name: manager; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.manager (
+    id bytea NOT NULL,
+    email text NOT NULL,
+    name text NOT NULL,
+    password_hash text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL,
+    updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    CONSTRAINT manager_email_check CHECK ((length(TRIM(BOTH FROM email)) > 0)),
+    CONSTRAINT manager_name_check CHECK ((length(TRIM(BOTH FROM name)) > 0))
+);
+
+
+--
+-- Name: manager_session; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE public.manager_session (
+    token_hash bytea NOT NULL,
+    manager_id bytea NOT NULL,
+    expires_at timestamp with time zone NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+
 --
 -- Name: person; Type: TABLE; Schema: public; Owner: -
 --
@@ -219,7 +479,15 @@ CREATE TABLE public.person (
     name text NOT NULL,
     created_at timestamp with time zone DEFAULT now() NOT NULL,
     updated_at timestamp with time zone DEFAULT now() NOT NULL,
-    CONSTRAINT person_name_check CHECK ((length(TRIM(BOTH FROM name)) > 0))
+    manager_id bytea NOT NULL,
+    reports_to bytea,
+    cadence_days integer,
+    current_level_id bytea,
+    target_level_id bytea,
+    deleted_at timestamp with time zone,
+    CONSTRAINT person_cadence_days_check CHECK ((cadence_days > 0)),
+    CONSTRAINT person_name_check CHECK ((length(TRIM(BOTH FROM name)) > 0)),
+    CONSTRAINT person_reports_to_check CHECK ((reports_to <> id))
 );
 
 
@@ -238,10 +506,14 @@ CREATE TABLE public.schema_migrations (
 
 CREATE TABLE public.theme (
     id bytea NOT NULL,
-    person_id bytea NOT NULL,
+    person_id bytea,
     text text NOT NULL,
     created_at timestamp with time zone DEFAULT now() NOT NULL,
     updated_at timestamp with time zone DEFAULT now() NOT NULL,
+    manager_id bytea NOT NULL,
+    archived_at timestamp with time zone,
+    description text,
+    deleted_at timestamp with time zone,
     CONSTRAINT theme_text_check CHECK ((length(TRIM(BOTH FROM text)) > 0))
 );
 
@@ -262,6 +534,22 @@ ALTER TABLE ONLY public.action
     ADD CONSTRAINT action_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: action_reference action_reference_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.action_reference
+    ADD CONSTRAINT action_reference_pkey PRIMARY KEY (action_id, "position");
+
+
+--
+-- Name: action_revision action_revision_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.action_revision
+    ADD CONSTRAINT action_revision_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: action_theme action_theme_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -270,6 +558,46 @@ ALTER TABLE ONLY public.action_theme
     ADD CONSTRAINT action_theme_pkey PRIMARY KEY (action_id, theme_id);
 
 
+--
+-- Name: api_token api_token_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.api_token
+    ADD CONSTRAINT api_token_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: api_token api_token_token_hash_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.api_token
+    ADD CONSTRAINT api_token_token_hash_key UNIQUE (token_hash);
+
+
+--
+-- Name: attachment attachment_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.attachment
+    ADD CONSTRAINT attachment_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: attachment attachment_storage_key_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.attachment
+    ADD CONSTRAINT attachment_storage_key_key UNIQUE (storage_key);
+
+
+--
+-- Name: audit_log audit_log_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.audit_log
+    ADD CONSTRAINT audit_log_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: conversation conversation_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -278,6 +606,14 @@ ALTER TABLE ONLY public.conversation
     ADD CONSTRAINT conversation_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: conversation_revision conversation_revision_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.conversation_revision
+    ADD CONSTRAINT conversation_revision_pkey PRIMARY KEY (id);
+
+
 --
 -- Name: conversation_theme conversation_theme_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -286,6 +622,70 @@ ALTER TABLE ONLY public.conversation_theme
     ADD CONSTRAINT conversation_theme_pkey PRIMARY KEY (conversation_id, theme_id);
 
 
+--
+-- Name: follow_up follow_up_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.follow_up
+    ADD CONSTRAINT follow_up_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: ladder_level ladder_level_manager_id_name_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.ladder_level
+    ADD CONSTRAINT ladder_level_manager_id_name_key UNIQUE (manager_id, name);
+
+
+--
+-- Name: ladder_level ladder_level_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.ladder_level
+    ADD CONSTRAINT ladder_level_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: level_expectation level_expectation_level_id_theme_id_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.level_expectation
+    ADD CONSTRAINT level_expectation_level_id_theme_id_key UNIQUE (level_id, theme_id);
+
+
+--
+-- Name: level_expectation level_expectation_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.level_expectation
+    ADD CONSTRAINT level_expectation_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: manager manager_email_key; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.manager
+    ADD CONSTRAINT manager_email_key UNIQUE (email);
+
+
+--
+-- Name: manager manager_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.manager
+    ADD CONSTRAINT manager_pkey PRIMARY KEY (id);
+
+
+--
+-- Name: manager_session manager_session_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.manager_session
+    ADD CONSTRAINT manager_session_pkey PRIMARY KEY (token_hash);
+
+
 --
 -- Name: person person_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -338,6 +738,20 @@ CREATE INDEX idx_action_conversation_created_at ON public.action_conversation US
 CREATE INDEX idx_action_created_at ON public.action USING btree (created_at);
 
 
+--
+-- Name: idx_action_description_search; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_action_description_search ON public.action USING gin (to_tsvector('english'::regconfig, description));
+
+
+--
+-- Name: idx_action_manager_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_action_manager_id ON public.action USING btree (manager_id);
+
+
 --
 -- Name: idx_action_occurred_at; Type: INDEX; Schema: public; Owner: -
 --
@@ -352,6 +766,20 @@ CREATE INDEX idx_action_occurred_at ON public.action USING btree (occurred_at DE
 CREATE INDEX idx_action_person_id ON public.action USING btree (person_id);
 
 
+--
+-- Name: idx_action_reference_search; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_action_reference_search ON public.action_reference USING gin (to_tsvector('english'::regconfig, ((COALESCE(title, ''::text) || ' '::text) || value)));
+
+
+--
+-- Name: idx_action_revision_action_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_action_revision_action_id ON public.action_revision USING btree (action_id, created_at);
+
+
 --
 -- Name: idx_action_theme_action_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -380,6 +808,41 @@ CREATE INDEX idx_action_theme_theme_id ON public.action_theme USING btree (theme
 CREATE INDEX idx_action_valence ON public.action USING btree (valence);
 
 
+--
+-- Name: idx_api_token_manager_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_api_token_manager_id ON public.api_token USING btree (manager_id);
+
+
+--
+-- Name: idx_attachment_action_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_attachment_action_id ON public.attachment USING btree (action_id);
+
+
+--
+-- Name: idx_attachment_conversation_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_attachment_conversation_id ON public.attachment USING btree (conversation_id);
+
+
+--
+-- Name: idx_audit_log_entity_ids; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_audit_log_entity_ids ON public.audit_log USING gin (entity_ids);
+
+
+--
+-- Name: idx_audit_log_manager_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_audit_log_manager_id ON public.audit_log USING btree (manager_id, created_at DESC);
+
+
 --
 -- Name: idx_conversation_created_at; Type: INDEX; Schema: public; Owner: -
 --
@@ -387,6 +850,20 @@ CREATE INDEX idx_action_valence ON public.action USING btree (valence);
 CREATE INDEX idx_conversation_created_at ON public.conversation USING btree (created_at);
 
 
+--
+-- Name: idx_conversation_description_search; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_conversation_description_search ON public.conversation USING gin (to_tsvector('english'::regconfig, description));
+
+
+--
+-- Name: idx_conversation_manager_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_conversation_manager_id ON public.conversation USING btree (manager_id);
+
+
 --
 -- Name: idx_conversation_occurred_at; Type: INDEX; Schema: public; Owner: -
 --
@@ -401,6 +878,13 @@ CREATE INDEX idx_conversation_occurred_at ON public.conversation USING btree (oc
 CREATE INDEX idx_conversation_person_id ON public.conversation USING btree (person_id);
 
 
+--
+-- Name: idx_conversation_revision_conversation_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_conversation_revision_conversation_id ON public.conversation_revision USING btree (conversation_id, created_at);
+
+
 --
 -- Name: idx_conversation_theme_conversation_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -422,6 +906,48 @@ CREATE INDEX idx_conversation_theme_created_at ON public.conversation_theme USIN
 CREATE INDEX idx_conversation_theme_theme_id ON public.conversation_theme USING btree (theme_id);
 
 
+--
+-- Name: idx_follow_up_conversation_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_follow_up_conversation_id ON public.follow_up USING btree (conversation_id);
+
+
+--
+-- Name: idx_follow_up_manager_id_open; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_follow_up_manager_id_open ON public.follow_up USING btree (manager_id) WHERE (completed_at IS NULL);
+
+
+--
+-- Name: idx_ladder_level_manager_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_ladder_level_manager_id ON public.ladder_level USING btree (manager_id, rank);
+
+
+--
+-- Name: idx_level_expectation_theme_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_level_expectation_theme_id ON public.level_expectation USING btree (theme_id);
+
+
+--
+-- Name: idx_manager_session_expires_at; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_manager_session_expires_at ON public.manager_session USING btree (expires_at);
+
+
+--
+-- Name: idx_manager_session_manager_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_manager_session_manager_id ON public.manager_session USING btree (manager_id);
+
+
 --
 -- Name: idx_person_created_at; Type: INDEX; Schema: public; Owner: -
 --
@@ -429,6 +955,13 @@ CREATE INDEX idx_conversation_theme_theme_id ON public.conversation_theme USING
 CREATE INDEX idx_person_created_at ON public.person USING btree (created_at);
 
 
+--
+-- Name: idx_person_manager_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_person_manager_id ON public.person USING btree (manager_id);
+
+
 --
 -- Name: idx_person_name; Type: INDEX; Schema: public; Owner: -
 --
@@ -436,6 +969,13 @@ CREATE INDEX idx_person_created_at ON public.person USING btree (created_at);
 CREATE INDEX idx_person_name ON public.person USING btree (name);
 
 
+--
+-- Name: idx_person_reports_to; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_person_reports_to ON public.person USING btree (reports_to);
+
+
 --
 -- Name: idx_theme_created_at; Type: INDEX; Schema: public; Owner: -
 --
@@ -443,6 +983,20 @@ CREATE INDEX idx_person_name ON public.person USING btree (name);
 CREATE INDEX idx_theme_created_at ON public.theme USING btree (created_at);
 
 
+--
+-- Name: idx_theme_framework_text; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE UNIQUE INDEX idx_theme_framework_text ON public.theme USING btree (manager_id, lower(text)) WHERE ((person_id IS NULL) AND (deleted_at IS NULL));
+
+
+--
+-- Name: idx_theme_manager_id; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_theme_manager_id ON public.theme USING btree (manager_id);
+
+
 --
 -- Name: idx_theme_person_id; Type: INDEX; Schema: public; Owner: -
 --
@@ -450,6 +1004,27 @@ CREATE INDEX idx_theme_created_at ON public.theme USING btree (created_at);
 CREATE INDEX idx_theme_person_id ON public.theme USING btree (person_id);
 
 
+--
+-- Name: idx_theme_search; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX idx_theme_search ON public.theme USING gin (to_tsvector('english'::regconfig, ((text || ' '::text) || COALESCE(description, ''::text))));
+
+
+--
+-- Name: audit_log audit_log_no_truncate; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER audit_log_no_truncate BEFORE TRUNCATE ON public.audit_log FOR EACH STATEMENT EXECUTE FUNCTION public.audit_log_append_only();
+
+
+--
+-- Name: audit_log audit_log_no_update_or_delete; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER audit_log_no_update_or_delete BEFORE UPDATE OR DELETE ON public.audit_log FOR EACH ROW EXECUTE FUNCTION public.audit_log_append_only();
+
+
 --
 -- Name: action_conversation update_action_conversation_updated_at; Type: TRIGGER; Schema: public; Owner: -
 --
@@ -485,6 +1060,34 @@ CREATE TRIGGER update_conversation_theme_updated_at BEFORE UPDATE ON public.conv
 CREATE TRIGGER update_conversation_updated_at BEFORE UPDATE ON public.conversation FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
 
 
+--
+-- Name: follow_up update_follow_up_updated_at; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER update_follow_up_updated_at BEFORE UPDATE ON public.follow_up FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
+
+
+--
+-- Name: ladder_level update_ladder_level_updated_at; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER update_ladder_level_updated_at BEFORE UPDATE ON public.ladder_level FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
+
+
+--
+-- Name: level_expectation update_level_expectation_updated_at; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER update_level_expectation_updated_at BEFORE UPDATE ON public.level_expectation FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
+
+
+--
+-- Name: manager update_manager_updated_at; Type: TRIGGER; Schema: public; Owner: -
+--
+
+CREATE TRIGGER update_manager_updated_at BEFORE UPDATE ON public.manager FOR EACH ROW EXECUTE FUNCTION public.update_updated_at_column();
+
+
 --
 -- Name: person update_person_updated_at; Type: TRIGGER; Schema: public; Owner: -
 --
@@ -515,6 +1118,14 @@ ALTER TABLE ONLY public.action_conversation
     ADD CONSTRAINT action_conversation_conversation_id_fkey FOREIGN KEY (conversation_id) REFERENCES public.conversation(id) ON DELETE CASCADE;
 
 
+--
+-- Name: action action_manager_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.action
+    ADD CONSTRAINT action_manager_id_fkey FOREIGN KEY (manager_id) REFERENCES public.manager(id) ON DELETE CASCADE;
+
+
 --
 -- Name: action action_person_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -523,6 +1134,30 @@ ALTER TABLE ONLY public.action
     ADD CONSTRAINT action_person_id_fkey FOREIGN KEY (person_id) REFERENCES public.person(id) ON DELETE CASCADE;
 
 
+--
+-- Name: action_reference action_reference_action_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.action_reference
+    ADD CONSTRAINT action_reference_action_id_fkey FOREIGN KEY (action_id) REFERENCES public.action(id) ON DELETE CASCADE;
+
+
+--
+-- Name: action_revision action_revision_action_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.action_revision
+    ADD CONSTRAINT action_revision_action_id_fkey FOREIGN KEY (action_id) REFERENCES public.action(id) ON DELETE CASCADE;
+
+
+--
+-- Name: action_revision action_revision_manager_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.action_revision
+    ADD CONSTRAINT action_revision_manager_id_fkey FOREIGN KEY (manager_id) REFERENCES public.manager(id) ON DELETE CASCADE;
+
+
 --
 -- Name: action_theme action_theme_action_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -539,6 +1174,46 @@ ALTER TABLE ONLY public.action_theme
     ADD CONSTRAINT action_theme_theme_id_fkey FOREIGN KEY (theme_id) REFERENCES public.theme(id) ON DELETE CASCADE;
 
 
+--
+-- Name: api_token api_token_manager_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.api_token
+    ADD CONSTRAINT api_token_manager_id_fkey FOREIGN KEY (manager_id) REFERENCES public.manager(id) ON DELETE CASCADE;
+
+
+--
+-- Name: attachment attachment_action_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.attachment
+    ADD CONSTRAINT attachment_action_id_fkey FOREIGN KEY (action_id) REFERENCES public.action(id) ON DELETE CASCADE;
+
+
+--
+-- Name: attachment attachment_conversation_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.attachment
+    ADD CONSTRAINT attachment_conversation_id_fkey FOREIGN KEY (conversation_id) REFERENCES public.conversation(id) ON DELETE CASCADE;
+
+
+--
+-- Name: attachment attachment_manager_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.attachment
+    ADD CONSTRAINT attachment_manager_id_fkey FOREIGN KEY (manager_id) REFERENCES public.manager(id) ON DELETE CASCADE;
+
+
+--
+-- Name: conversation conversation_manager_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.conversation
+    ADD CONSTRAINT conversation_manager_id_fkey FOREIGN KEY (manager_id) REFERENCES public.manager(id) ON DELETE CASCADE;
+
+
 --
 -- Name: conversation conversation_person_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -547,6 +1222,22 @@ ALTER TABLE ONLY public.conversation
     ADD CONSTRAINT conversation_person_id_fkey FOREIGN KEY (person_id) REFERENCES public.person(id) ON DELETE CASCADE;
 
 
+--
+-- Name: conversation_revision conversation_revision_conversation_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.conversation_revision
+    ADD CONSTRAINT conversation_revision_conversation_id_fkey FOREIGN KEY (conversation_id) REFERENCES public.conversation(id) ON DELETE CASCADE;
+
+
+--
+-- Name: conversation_revision conversation_revision_manager_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.conversation_revision
+    ADD CONSTRAINT conversation_revision_manager_id_fkey FOREIGN KEY (manager_id) REFERENCES public.manager(id) ON DELETE CASCADE;
+
+
 --
 -- Name: conversation_theme conversation_theme_conversation_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -563,6 +1254,94 @@ ALTER TABLE ONLY public.conversation_theme
     ADD CONSTRAINT conversation_theme_theme_id_fkey FOREIGN KEY (theme_id) REFERENCES public.theme(id) ON DELETE CASCADE;
 
 
+--
+-- Name: follow_up follow_up_conversation_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.follow_up
+    ADD CONSTRAINT follow_up_conversation_id_fkey FOREIGN KEY (conversation_id) REFERENCES public.conversation(id) ON DELETE CASCADE;
+
+
+--
+-- Name: follow_up follow_up_manager_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.follow_up
+    ADD CONSTRAINT follow_up_manager_id_fkey FOREIGN KEY (manager_id) REFERENCES public.manager(id) ON DELETE CASCADE;
+
+
+--
+-- Name: ladder_level ladder_level_manager_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.ladder_level
+    ADD CONSTRAINT ladder_level_manager_id_fkey FOREIGN KEY (manager_id) REFERENCES public.manager(id) ON DELETE CASCADE;
+
+
+--
+-- Name: level_expectation level_expectation_level_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.level_expectation
+    ADD CONSTRAINT level_expectation_level_id_fkey FOREIGN KEY (level_id) REFERENCES public.ladder_level(id) ON DELETE CASCADE;
+
+
+--
+-- Name: level_expectation level_expectation_theme_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.level_expectation
+    ADD CONSTRAINT level_expectation_theme_id_fkey FOREIGN KEY (theme_id) REFERENCES public.theme(id) ON DELETE CASCADE;
+
+
+--
+-- Name: manager_session manager_session_manager_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.manager_session
+    ADD CONSTRAINT manager_session_manager_id_fkey FOREIGN KEY (manager_id) REFERENCES public.manager(id) ON DELETE CASCADE;
+
+
+--
+-- Name: person person_current_level_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.person
+    ADD CONSTRAINT person_current_level_id_fkey FOREIGN KEY (current_level_id) REFERENCES public.ladder_level(id) ON DELETE SET NULL;
+
+
+--
+-- Name: person person_manager_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.person
+    ADD CONSTRAINT person_manager_id_fkey FOREIGN KEY (manager_id) REFERENCES public.manager(id) ON DELETE CASCADE;
+
+
+--
+-- Name: person person_reports_to_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.person
+    ADD CONSTRAINT person_reports_to_fkey FOREIGN KEY (reports_to) REFERENCES public.person(id) ON DELETE SET NULL;
+
+
+--
+-- Name: person person_target_level_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.person
+    ADD CONSTRAINT person_target_level_id_fkey FOREIGN KEY (target_level_id) REFERENCES public.ladder_level(id) ON DELETE SET NULL;
+
+
+--
+-- Name: theme theme_manager_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY public.theme
+    ADD CONSTRAINT theme_manager_id_fkey FOREIGN KEY (manager_id) REFERENCES public.manager(id) ON DELETE CASCADE;
+
+
 --
 -- Name: theme theme_person_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
@@ -586,4 +1365,20 @@ INSERT INTO public.schema_migrations (version) VALUES
     ('20250730204830'),
     ('20250730221000'),
     ('20250730230000'),
-    ('20250730230100');
+    ('20250730230100'),
+    ('20250801090000'),
+    ('20250801100000'),
+    ('20250801110000'),
+    ('20250801120000'),
+    ('20250801130000'),
+    ('20250801140000'),
+    ('20250801150000'),
+    ('20250801160000'),
+    ('20250801170000'),
+    ('20250801180000'),
+    ('20250801190000'),
+    ('20250801200000'),
+    ('20250801210000'),
+    ('20250801220000'),
+    ('20250801230000'),
+    ('20250801235900');
@@ -1,6 +1,10 @@
 // Code generated by ogen, DO NOT EDIT.
 package api
 
+type CompleteFollowUpRes interface {
+	completeFollowUpRes()
+}
+
 type CreateActionRes interface {
 	createActionRes()
 }
@@ -9,34 +13,90 @@ type CreateConversationRes interface {
 	createConversationRes()
 }
 
+type CreateFollowUpRes interface {
+	createFollowUpRes()
+}
+
 type CreatePersonRes interface {
 	createPersonRes()
 }
 
+type CreateThemeRes interface {
+	createThemeRes()
+}
+
 type DeleteActionRes interface {
 	deleteActionRes()
 }
 
+type DeleteConversationRes interface {
+	deleteConversationRes()
+}
+
 type DeletePersonRes interface {
 	deletePersonRes()
 }
 
+type DeleteThemeRes interface {
+	deleteThemeRes()
+}
+
 type GetActionByIdRes interface {
 	getActionByIdRes()
 }
 
+type GetActionRevisionsRes interface {
+	getActionRevisionsRes()
+}
+
 type GetActionsRes interface {
 	getActionsRes()
 }
 
+type GetConversationByIdRes interface {
+	getConversationByIdRes()
+}
+
+type GetConversationRevisionsRes interface {
+	getConversationRevisionsRes()
+}
+
+type GetConversationsRes interface {
+	getConversationsRes()
+}
+
+type GetFollowUpsRes interface {
+	getFollowUpsRes()
+}
+
+type GetLevelsRes interface {
+	getLevelsRes()
+}
+
+type GetOrgTreeRes interface {
+	getOrgTreeRes()
+}
+
 type GetPersonActionsRes interface {
 	getPersonActionsRes()
 }
 
+type GetPersonAgendaRes interface {
+	getPersonAgendaRes()
+}
+
 type GetPersonByIdRes interface {
 	getPersonByIdRes()
 }
 
+type GetPersonLadderRes interface {
+	getPersonLadderRes()
+}
+
+type GetPersonThemesRes interface {
+	getPersonThemesRes()
+}
+
 type GetPersonTimelineRes interface {
 	getPersonTimelineRes()
 }
@@ -45,10 +105,66 @@ type GetPersonsRes interface {
 	getPersonsRes()
 }
 
+type GetThemeByIdRes interface {
+	getThemeByIdRes()
+}
+
+type GetThemesRes interface {
+	getThemesRes()
+}
+
+type ListAuditLogRes interface {
+	listAuditLogRes()
+}
+
+type ListTrashRes interface {
+	listTrashRes()
+}
+
+type MergeThemesRes interface {
+	mergeThemesRes()
+}
+
+type PurgeTrashItemRes interface {
+	purgeTrashItemRes()
+}
+
+type RestoreActionRevisionRes interface {
+	restoreActionRevisionRes()
+}
+
+type RestoreConversationRevisionRes interface {
+	restoreConversationRevisionRes()
+}
+
+type RestoreTrashItemRes interface {
+	restoreTrashItemRes()
+}
+
+type SearchRes interface {
+	searchRes()
+}
+
+type SetPersonLevelsRes interface {
+	setPersonLevelsRes()
+}
+
+type SplitThemeRes interface {
+	splitThemeRes()
+}
+
 type UpdateActionRes interface {
 	updateActionRes()
 }
 
+type UpdateConversationRes interface {
+	updateConversationRes()
+}
+
 type UpdatePersonRes interface {
 	updatePersonRes()
 }
+
+type UpdateThemeRes interface {
+	updateThemeRes()
+}
@@ -6,17 +6,46 @@ package api
 type OperationName = string
 
 const (
-	CreateActionOperation       OperationName = "CreateAction"
-	CreateConversationOperation OperationName = "CreateConversation"
-	CreatePersonOperation       OperationName = "CreatePerson"
-	DeleteActionOperation       OperationName = "DeleteAction"
-	DeletePersonOperation       OperationName = "DeletePerson"
-	GetActionByIdOperation      OperationName = "GetActionById"
-	GetActionsOperation         OperationName = "GetActions"
-	GetPersonActionsOperation   OperationName = "GetPersonActions"
-	GetPersonByIdOperation      OperationName = "GetPersonById"
-	GetPersonTimelineOperation  OperationName = "GetPersonTimeline"
-	GetPersonsOperation         OperationName = "GetPersons"
-	UpdateActionOperation       OperationName = "UpdateAction"
-	UpdatePersonOperation       OperationName = "UpdatePerson"
+	CompleteFollowUpOperation            OperationName = "CompleteFollowUp"
+	CreateActionOperation                OperationName = "CreateAction"
+	CreateConversationOperation          OperationName = "CreateConversation"
+	CreateFollowUpOperation              OperationName = "CreateFollowUp"
+	CreatePersonOperation                OperationName = "CreatePerson"
+	CreateThemeOperation                 OperationName = "CreateTheme"
+	DeleteActionOperation                OperationName = "DeleteAction"
+	DeleteConversationOperation          OperationName = "DeleteConversation"
+	DeletePersonOperation                OperationName = "DeletePerson"
+	DeleteThemeOperation                 OperationName = "DeleteTheme"
+	GetActionByIdOperation               OperationName = "GetActionById"
+	GetActionRevisionsOperation          OperationName = "GetActionRevisions"
+	GetActionsOperation                  OperationName = "GetActions"
+	GetConversationByIdOperation         OperationName = "GetConversationById"
+	GetConversationRevisionsOperation    OperationName = "GetConversationRevisions"
+	GetConversationsOperation            OperationName = "GetConversations"
+	GetFollowUpsOperation                OperationName = "GetFollowUps"
+	GetLevelsOperation                   OperationName = "GetLevels"
+	GetOrgTreeOperation                  OperationName = "GetOrgTree"
+	GetPersonActionsOperation            OperationName = "GetPersonActions"
+	GetPersonAgendaOperation             OperationName = "GetPersonAgenda"
+	GetPersonByIdOperation               OperationName = "GetPersonById"
+	GetPersonLadderOperation             OperationName = "GetPersonLadder"
+	GetPersonThemesOperation             OperationName = "GetPersonThemes"
+	GetPersonTimelineOperation           OperationName = "GetPersonTimeline"
+	GetPersonsOperation                  OperationName = "GetPersons"
+	GetThemeByIdOperation                OperationName = "GetThemeById"
+	GetThemesOperation                   OperationName = "GetThemes"
+	ListAuditLogOperation                OperationName = "ListAuditLog"
+	ListTrashOperation                   OperationName = "ListTrash"
+	MergeThemesOperation                 OperationName = "MergeThemes"
+	PurgeTrashItemOperation              OperationName = "PurgeTrashItem"
+	RestoreActionRevisionOperation       OperationName = "RestoreActionRevision"
+	RestoreConversationRevisionOperation OperationName = "RestoreConversationRevision"
+	RestoreTrashItemOperation            OperationName = "RestoreTrashItem"
+	SearchOperation                      OperationName = "Search"
+	SetPersonLevelsOperation             OperationName = "SetPersonLevels"
+	SplitThemeOperation                  OperationName = "SplitTheme"
+	UpdateActionOperation                OperationName = "UpdateAction"
+	UpdateConversationOperation          OperationName = "UpdateConversation"
+	UpdatePersonOperation                OperationName = "UpdatePerson"
+	UpdateThemeOperation                 OperationName = "UpdateTheme"
 )
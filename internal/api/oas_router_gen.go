@@ -40,7 +40,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		s.notFound(w, r)
 		return
 	}
-	args := [1]string{}
+	args := [2]string{}
 
 	// Static code generated router with unwrapped path search.
 	switch {
@@ -61,9 +61,175 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				break
 			}
 			switch elem[0] {
-			case 'a': // Prefix: "actions"
+			case 'a': // Prefix: "a"
 
-				if l := len("actions"); len(elem) >= l && elem[0:l] == "actions" {
+				if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'c': // Prefix: "ctions"
+
+					if l := len("ctions"); len(elem) >= l && elem[0:l] == "ctions" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleGetActionsRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleCreateActionRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "DELETE":
+								s.handleDeleteActionRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleGetActionByIdRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleUpdateActionRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,GET,PUT")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/revisions"
+
+							if l := len("/revisions"); len(elem) >= l && elem[0:l] == "/revisions" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleGetActionRevisionsRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "revision_id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[1] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/restore"
+
+									if l := len("/restore"); len(elem) >= l && elem[0:l] == "/restore" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleRestoreActionRevisionRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'u': // Prefix: "udit"
+
+					if l := len("udit"); len(elem) >= l && elem[0:l] == "udit" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleListAuditLogRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
+				}
+
+			case 'c': // Prefix: "conversations"
+
+				if l := len("conversations"); len(elem) >= l && elem[0:l] == "conversations" {
 					elem = elem[l:]
 				} else {
 					break
@@ -72,9 +238,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				if len(elem) == 0 {
 					switch r.Method {
 					case "GET":
-						s.handleGetActionsRequest([0]string{}, elemIsEscaped, w, r)
+						s.handleGetConversationsRequest([0]string{}, elemIsEscaped, w, r)
 					case "POST":
-						s.handleCreateActionRequest([0]string{}, elemIsEscaped, w, r)
+						s.handleCreateConversationRequest([0]string{}, elemIsEscaped, w, r)
 					default:
 						s.notAllowed(w, r, "GET,POST")
 					}
@@ -91,27 +257,26 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					}
 
 					// Param: "id"
-					// Leaf parameter, slashes are prohibited
+					// Match until "/"
 					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
-						break
+					if idx < 0 {
+						idx = len(elem)
 					}
-					args[0] = elem
-					elem = ""
+					args[0] = elem[:idx]
+					elem = elem[idx:]
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch r.Method {
 						case "DELETE":
-							s.handleDeleteActionRequest([1]string{
+							s.handleDeleteConversationRequest([1]string{
 								args[0],
 							}, elemIsEscaped, w, r)
 						case "GET":
-							s.handleGetActionByIdRequest([1]string{
+							s.handleGetConversationByIdRequest([1]string{
 								args[0],
 							}, elemIsEscaped, w, r)
 						case "PUT":
-							s.handleUpdateActionRequest([1]string{
+							s.handleUpdateConversationRequest([1]string{
 								args[0],
 							}, elemIsEscaped, w, r)
 						default:
@@ -120,24 +285,183 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 						return
 					}
+					switch elem[0] {
+					case '/': // Prefix: "/revisions"
+
+						if l := len("/revisions"); len(elem) >= l && elem[0:l] == "/revisions" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleGetConversationRevisionsRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "revision_id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[1] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/restore"
+
+								if l := len("/restore"); len(elem) >= l && elem[0:l] == "/restore" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleRestoreConversationRevisionRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					}
 
 				}
 
-			case 'c': // Prefix: "conversations"
+			case 'f': // Prefix: "follow-ups"
 
-				if l := len("conversations"); len(elem) >= l && elem[0:l] == "conversations" {
+				if l := len("follow-ups"); len(elem) >= l && elem[0:l] == "follow-ups" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					// Leaf node.
 					switch r.Method {
+					case "GET":
+						s.handleGetFollowUpsRequest([0]string{}, elemIsEscaped, w, r)
 					case "POST":
-						s.handleCreateConversationRequest([0]string{}, elemIsEscaped, w, r)
+						s.handleCreateFollowUpRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET,POST")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/complete"
+
+						if l := len("/complete"); len(elem) >= l && elem[0:l] == "/complete" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleCompleteFollowUpRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
+
+							return
+						}
+
+					}
+
+				}
+
+			case 'l': // Prefix: "levels"
+
+				if l := len("levels"); len(elem) >= l && elem[0:l] == "levels" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "GET":
+						s.handleGetLevelsRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+
+			case 'o': // Prefix: "org"
+
+				if l := len("org"); len(elem) >= l && elem[0:l] == "org" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "GET":
+						s.handleGetOrgTreeRequest([0]string{}, elemIsEscaped, w, r)
 					default:
-						s.notAllowed(w, r, "POST")
+						s.notAllowed(w, r, "GET")
 					}
 
 					return
@@ -214,31 +538,67 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							break
 						}
 						switch elem[0] {
-						case 'a': // Prefix: "actions"
+						case 'a': // Prefix: "a"
 
-							if l := len("actions"); len(elem) >= l && elem[0:l] == "actions" {
+							if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
-								switch r.Method {
-								case "GET":
-									s.handleGetPersonActionsRequest([1]string{
-										args[0],
-									}, elemIsEscaped, w, r)
-								default:
-									s.notAllowed(w, r, "GET")
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "ctions"
+
+								if l := len("ctions"); len(elem) >= l && elem[0:l] == "ctions" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetPersonActionsRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+							case 'g': // Prefix: "genda"
+
+								if l := len("genda"); len(elem) >= l && elem[0:l] == "genda" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetPersonAgendaRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
 								}
 
-								return
 							}
 
-						case 't': // Prefix: "timeline"
+						case 'l': // Prefix: "ladder"
 
-							if l := len("timeline"); len(elem) >= l && elem[0:l] == "timeline" {
+							if l := len("ladder"); len(elem) >= l && elem[0:l] == "ladder" {
 								elem = elem[l:]
 							} else {
 								break
@@ -248,37 +608,354 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								// Leaf node.
 								switch r.Method {
 								case "GET":
-									s.handleGetPersonTimelineRequest([1]string{
+									s.handleGetPersonLadderRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "PUT":
+									s.handleSetPersonLevelsRequest([1]string{
 										args[0],
 									}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "GET")
+									s.notAllowed(w, r, "GET,PUT")
 								}
 
 								return
 							}
 
-						}
+						case 't': // Prefix: "t"
 
-					}
+							if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-				}
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'h': // Prefix: "hemes"
 
-			}
+								if l := len("hemes"); len(elem) >= l && elem[0:l] == "hemes" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-		}
-	}
-	s.notFound(w, r)
-}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetPersonThemesRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
 
-// Route is route object.
-type Route struct {
-	name        string
+									return
+								}
+
+							case 'i': // Prefix: "imeline"
+
+								if l := len("imeline"); len(elem) >= l && elem[0:l] == "imeline" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetPersonTimelineRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					}
+
+				}
+
+			case 's': // Prefix: "search"
+
+				if l := len("search"); len(elem) >= l && elem[0:l] == "search" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "GET":
+						s.handleSearchRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET")
+					}
+
+					return
+				}
+
+			case 't': // Prefix: "t"
+
+				if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'h': // Prefix: "hemes"
+
+					if l := len("hemes"); len(elem) >= l && elem[0:l] == "hemes" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleGetThemesRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleCreateThemeRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET,POST")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "DELETE":
+								s.handleDeleteThemeRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "GET":
+								s.handleGetThemeByIdRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							case "PUT":
+								s.handleUpdateThemeRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE,GET,PUT")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'm': // Prefix: "merge"
+
+								if l := len("merge"); len(elem) >= l && elem[0:l] == "merge" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleMergeThemesRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							case 's': // Prefix: "split"
+
+								if l := len("split"); len(elem) >= l && elem[0:l] == "split" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleSplitThemeRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'r': // Prefix: "rash"
+
+					if l := len("rash"); len(elem) >= l && elem[0:l] == "rash" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleListTrashRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "type"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[1] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "DELETE":
+									s.handlePurgeTrashItemRequest([2]string{
+										args[0],
+										args[1],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/restore"
+
+								if l := len("/restore"); len(elem) >= l && elem[0:l] == "/restore" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleRestoreTrashItemRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+							}
+
+						}
+
+					}
+
+				}
+
+			}
+
+		}
+	}
+	s.notFound(w, r)
+}
+
+// Route is route object.
+type Route struct {
+	name        string
 	summary     string
 	operationID string
 	pathPattern string
 	count       int
-	args        [1]string
+	args        [2]string
 }
 
 // Name returns ogen operation name.
@@ -348,19 +1025,367 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 		switch elem[0] {
 		case '/': // Prefix: "/"
 
-			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-				elem = elem[l:]
-			} else {
-				break
-			}
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+				elem = elem[l:]
+			} else {
+				break
+			}
+
+			if len(elem) == 0 {
+				break
+			}
+			switch elem[0] {
+			case 'a': // Prefix: "a"
+
+				if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'c': // Prefix: "ctions"
+
+					if l := len("ctions"); len(elem) >= l && elem[0:l] == "ctions" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = GetActionsOperation
+							r.summary = "Get all actions"
+							r.operationID = "getActions"
+							r.pathPattern = "/actions"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = CreateActionOperation
+							r.summary = "Create a new action"
+							r.operationID = "createAction"
+							r.pathPattern = "/actions"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch method {
+							case "DELETE":
+								r.name = DeleteActionOperation
+								r.summary = "Delete an action"
+								r.operationID = "deleteAction"
+								r.pathPattern = "/actions/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = GetActionByIdOperation
+								r.summary = "Get an action by ID"
+								r.operationID = "getActionById"
+								r.pathPattern = "/actions/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = UpdateActionOperation
+								r.summary = "Update an action"
+								r.operationID = "updateAction"
+								r.pathPattern = "/actions/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/revisions"
+
+							if l := len("/revisions"); len(elem) >= l && elem[0:l] == "/revisions" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = GetActionRevisionsOperation
+									r.summary = "List an action's revisions"
+									r.operationID = "getActionRevisions"
+									r.pathPattern = "/actions/{id}/revisions"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "revision_id"
+								// Match until "/"
+								idx := strings.IndexByte(elem, '/')
+								if idx < 0 {
+									idx = len(elem)
+								}
+								args[1] = elem[:idx]
+								elem = elem[idx:]
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/restore"
+
+									if l := len("/restore"); len(elem) >= l && elem[0:l] == "/restore" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch method {
+										case "POST":
+											r.name = RestoreActionRevisionOperation
+											r.summary = "Restore an earlier revision of an action"
+											r.operationID = "restoreActionRevision"
+											r.pathPattern = "/actions/{id}/revisions/{revision_id}/restore"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'u': // Prefix: "udit"
+
+					if l := len("udit"); len(elem) >= l && elem[0:l] == "udit" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = ListAuditLogOperation
+							r.summary = "List audit log entries"
+							r.operationID = "listAuditLog"
+							r.pathPattern = "/audit"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
+
+			case 'c': // Prefix: "conversations"
+
+				if l := len("conversations"); len(elem) >= l && elem[0:l] == "conversations" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = GetConversationsOperation
+						r.summary = "Get all conversations"
+						r.operationID = "getConversations"
+						r.pathPattern = "/conversations"
+						r.args = args
+						r.count = 0
+						return r, true
+					case "POST":
+						r.name = CreateConversationOperation
+						r.summary = "Create a new conversation"
+						r.operationID = "createConversation"
+						r.pathPattern = "/conversations"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "id"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						switch method {
+						case "DELETE":
+							r.name = DeleteConversationOperation
+							r.summary = "Delete a conversation"
+							r.operationID = "deleteConversation"
+							r.pathPattern = "/conversations/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "GET":
+							r.name = GetConversationByIdOperation
+							r.summary = "Get a conversation by ID"
+							r.operationID = "getConversationById"
+							r.pathPattern = "/conversations/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "PUT":
+							r.name = UpdateConversationOperation
+							r.summary = "Update a conversation"
+							r.operationID = "updateConversation"
+							r.pathPattern = "/conversations/{id}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/revisions"
+
+						if l := len("/revisions"); len(elem) >= l && elem[0:l] == "/revisions" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = GetConversationRevisionsOperation
+								r.summary = "List a conversation's revisions"
+								r.operationID = "getConversationRevisions"
+								r.pathPattern = "/conversations/{id}/revisions"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "revision_id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[1] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/restore"
+
+								if l := len("/restore"); len(elem) >= l && elem[0:l] == "/restore" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = RestoreConversationRevisionOperation
+										r.summary = "Restore an earlier revision of a conversation"
+										r.operationID = "restoreConversationRevision"
+										r.pathPattern = "/conversations/{id}/revisions/{revision_id}/restore"
+										r.args = args
+										r.count = 2
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
 
-			if len(elem) == 0 {
-				break
-			}
-			switch elem[0] {
-			case 'a': // Prefix: "actions"
+						}
+
+					}
+
+				}
 
-				if l := len("actions"); len(elem) >= l && elem[0:l] == "actions" {
+			case 'f': // Prefix: "follow-ups"
+
+				if l := len("follow-ups"); len(elem) >= l && elem[0:l] == "follow-ups" {
 					elem = elem[l:]
 				} else {
 					break
@@ -369,18 +1394,18 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				if len(elem) == 0 {
 					switch method {
 					case "GET":
-						r.name = GetActionsOperation
-						r.summary = "Get all actions"
-						r.operationID = "getActions"
-						r.pathPattern = "/actions"
+						r.name = GetFollowUpsOperation
+						r.summary = "List follow-up items across all reports"
+						r.operationID = "getFollowUps"
+						r.pathPattern = "/follow-ups"
 						r.args = args
 						r.count = 0
 						return r, true
 					case "POST":
-						r.name = CreateActionOperation
-						r.summary = "Create a new action"
-						r.operationID = "createAction"
-						r.pathPattern = "/actions"
+						r.name = CreateFollowUpOperation
+						r.summary = "Create a follow-up item for a conversation"
+						r.operationID = "createFollowUp"
+						r.pathPattern = "/follow-ups"
 						r.args = args
 						r.count = 0
 						return r, true
@@ -398,51 +1423,49 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					}
 
 					// Param: "id"
-					// Leaf parameter, slashes are prohibited
+					// Match until "/"
 					idx := strings.IndexByte(elem, '/')
-					if idx >= 0 {
-						break
+					if idx < 0 {
+						idx = len(elem)
 					}
-					args[0] = elem
-					elem = ""
+					args[0] = elem[:idx]
+					elem = elem[idx:]
 
 					if len(elem) == 0 {
-						// Leaf node.
-						switch method {
-						case "DELETE":
-							r.name = DeleteActionOperation
-							r.summary = "Delete an action"
-							r.operationID = "deleteAction"
-							r.pathPattern = "/actions/{id}"
-							r.args = args
-							r.count = 1
-							return r, true
-						case "GET":
-							r.name = GetActionByIdOperation
-							r.summary = "Get an action by ID"
-							r.operationID = "getActionById"
-							r.pathPattern = "/actions/{id}"
-							r.args = args
-							r.count = 1
-							return r, true
-						case "PUT":
-							r.name = UpdateActionOperation
-							r.summary = "Update an action"
-							r.operationID = "updateAction"
-							r.pathPattern = "/actions/{id}"
-							r.args = args
-							r.count = 1
-							return r, true
-						default:
-							return
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/complete"
+
+						if l := len("/complete"); len(elem) >= l && elem[0:l] == "/complete" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = CompleteFollowUpOperation
+								r.summary = "Mark a follow-up item as done"
+								r.operationID = "completeFollowUp"
+								r.pathPattern = "/follow-ups/{id}/complete"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
 						}
+
 					}
 
 				}
 
-			case 'c': // Prefix: "conversations"
+			case 'l': // Prefix: "levels"
 
-				if l := len("conversations"); len(elem) >= l && elem[0:l] == "conversations" {
+				if l := len("levels"); len(elem) >= l && elem[0:l] == "levels" {
 					elem = elem[l:]
 				} else {
 					break
@@ -451,11 +1474,35 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				if len(elem) == 0 {
 					// Leaf node.
 					switch method {
-					case "POST":
-						r.name = CreateConversationOperation
-						r.summary = "Create a new conversation"
-						r.operationID = "createConversation"
-						r.pathPattern = "/conversations"
+					case "GET":
+						r.name = GetLevelsOperation
+						r.summary = "List career ladder levels"
+						r.operationID = "getLevels"
+						r.pathPattern = "/levels"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
+			case 'o': // Prefix: "org"
+
+				if l := len("org"); len(elem) >= l && elem[0:l] == "org" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch method {
+					case "GET":
+						r.name = GetOrgTreeOperation
+						r.summary = "Get the reporting hierarchy"
+						r.operationID = "getOrgTree"
+						r.pathPattern = "/org"
 						r.args = args
 						r.count = 0
 						return r, true
@@ -555,9 +1602,71 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 							break
 						}
 						switch elem[0] {
-						case 'a': // Prefix: "actions"
+						case 'a': // Prefix: "a"
+
+							if l := len("a"); len(elem) >= l && elem[0:l] == "a" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "ctions"
+
+								if l := len("ctions"); len(elem) >= l && elem[0:l] == "ctions" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetPersonActionsOperation
+										r.summary = "Get actions for a specific person"
+										r.operationID = "getPersonActions"
+										r.pathPattern = "/people/{id}/actions"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'g': // Prefix: "genda"
+
+								if l := len("genda"); len(elem) >= l && elem[0:l] == "genda" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetPersonAgendaOperation
+										r.summary = "Get a drafted 1:1 agenda for a person"
+										r.operationID = "getPersonAgenda"
+										r.pathPattern = "/people/{id}/agenda"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
 
-							if l := len("actions"); len(elem) >= l && elem[0:l] == "actions" {
+							}
+
+						case 'l': // Prefix: "ladder"
+
+							if l := len("ladder"); len(elem) >= l && elem[0:l] == "ladder" {
 								elem = elem[l:]
 							} else {
 								break
@@ -567,10 +1676,18 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								// Leaf node.
 								switch method {
 								case "GET":
-									r.name = GetPersonActionsOperation
-									r.summary = "Get actions for a specific person"
-									r.operationID = "getPersonActions"
-									r.pathPattern = "/people/{id}/actions"
+									r.name = GetPersonLadderOperation
+									r.summary = "Get a person's career ladder gap view"
+									r.operationID = "getPersonLadder"
+									r.pathPattern = "/people/{id}/ladder"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "PUT":
+									r.name = SetPersonLevelsOperation
+									r.summary = "Set a person's current and target levels"
+									r.operationID = "setPersonLevels"
+									r.pathPattern = "/people/{id}/ladder"
 									r.args = args
 									r.count = 1
 									return r, true
@@ -579,29 +1696,356 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								}
 							}
 
-						case 't': // Prefix: "timeline"
+						case 't': // Prefix: "t"
 
-							if l := len("timeline"); len(elem) >= l && elem[0:l] == "timeline" {
+							if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
 							if len(elem) == 0 {
-								// Leaf node.
+								break
+							}
+							switch elem[0] {
+							case 'h': // Prefix: "hemes"
+
+								if l := len("hemes"); len(elem) >= l && elem[0:l] == "hemes" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetPersonThemesOperation
+										r.summary = "Get themes for a specific person"
+										r.operationID = "getPersonThemes"
+										r.pathPattern = "/people/{id}/themes"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 'i': // Prefix: "imeline"
+
+								if l := len("imeline"); len(elem) >= l && elem[0:l] == "imeline" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "GET":
+										r.name = GetPersonTimelineOperation
+										r.summary = "Get timeline for a specific person"
+										r.operationID = "getPersonTimeline"
+										r.pathPattern = "/people/{id}/timeline"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					}
+
+				}
+
+			case 's': // Prefix: "search"
+
+				if l := len("search"); len(elem) >= l && elem[0:l] == "search" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch method {
+					case "GET":
+						r.name = SearchOperation
+						r.summary = "Search actions, conversations and themes"
+						r.operationID = "search"
+						r.pathPattern = "/search"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
+			case 't': // Prefix: "t"
+
+				if l := len("t"); len(elem) >= l && elem[0:l] == "t" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'h': // Prefix: "hemes"
+
+					if l := len("hemes"); len(elem) >= l && elem[0:l] == "hemes" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = GetThemesOperation
+							r.summary = "List themes across all reports"
+							r.operationID = "getThemes"
+							r.pathPattern = "/themes"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = CreateThemeOperation
+							r.summary = "Create a theme for a person"
+							r.operationID = "createTheme"
+							r.pathPattern = "/themes"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "id"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch method {
+							case "DELETE":
+								r.name = DeleteThemeOperation
+								r.summary = "Delete a theme"
+								r.operationID = "deleteTheme"
+								r.pathPattern = "/themes/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "GET":
+								r.name = GetThemeByIdOperation
+								r.summary = "Get a theme by ID"
+								r.operationID = "getThemeById"
+								r.pathPattern = "/themes/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							case "PUT":
+								r.name = UpdateThemeOperation
+								r.summary = "Rename, archive or unarchive a theme"
+								r.operationID = "updateTheme"
+								r.pathPattern = "/themes/{id}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'm': // Prefix: "merge"
+
+								if l := len("merge"); len(elem) >= l && elem[0:l] == "merge" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = MergeThemesOperation
+										r.summary = "Merge other themes into this one"
+										r.operationID = "mergeThemes"
+										r.pathPattern = "/themes/{id}/merge"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							case 's': // Prefix: "split"
+
+								if l := len("split"); len(elem) >= l && elem[0:l] == "split" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = SplitThemeOperation
+										r.summary = "Split selected actions off into a new theme"
+										r.operationID = "splitTheme"
+										r.pathPattern = "/themes/{id}/split"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
+
+						}
+
+					}
+
+				case 'r': // Prefix: "rash"
+
+					if l := len("rash"); len(elem) >= l && elem[0:l] == "rash" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = ListTrashOperation
+							r.summary = "List deleted items"
+							r.operationID = "listTrash"
+							r.pathPattern = "/trash"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "type"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "id"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[1] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
 								switch method {
-								case "GET":
-									r.name = GetPersonTimelineOperation
-									r.summary = "Get timeline for a specific person"
-									r.operationID = "getPersonTimeline"
-									r.pathPattern = "/people/{id}/timeline"
+								case "DELETE":
+									r.name = PurgeTrashItemOperation
+									r.summary = "Permanently delete an item from the trash"
+									r.operationID = "purgeTrashItem"
+									r.pathPattern = "/trash/{type}/{id}"
 									r.args = args
-									r.count = 1
+									r.count = 2
 									return r, true
 								default:
 									return
 								}
 							}
+							switch elem[0] {
+							case '/': // Prefix: "/restore"
+
+								if l := len("/restore"); len(elem) >= l && elem[0:l] == "/restore" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch method {
+									case "POST":
+										r.name = RestoreTrashItemOperation
+										r.summary = "Restore an item from the trash"
+										r.operationID = "restoreTrashItem"
+										r.pathPattern = "/trash/{type}/{id}/restore"
+										r.args = args
+										r.count = 2
+										return r, true
+									default:
+										return
+									}
+								}
+
+							}
 
 						}
 
@@ -19,16 +19,22 @@ type Action struct {
 	OccurredAt time.Time `json:"occurred_at"`
 	// Description of the action.
 	Description string `json:"description"`
-	// Optional references or links related to the action.
-	References OptNilString `json:"references"`
-	// Whether the action was positive or negative.
+	// Links, ticket keys, pull requests and notes related to the action, in the order entered.
+	References []Reference `json:"references"`
+	// Whether the action was positive, negative or neutral context.
 	Valence ActionValence `json:"valence"`
+	// How much the action mattered, when recorded.
+	Impact OptNilActionImpact `json:"impact"`
 	// When the action was created.
 	CreatedAt time.Time `json:"created_at"`
 	// When the action was last updated.
 	UpdatedAt time.Time `json:"updated_at"`
 	// Name of the person who performed the action.
 	PersonName OptString `json:"person_name"`
+	// Themes associated with the action.
+	Themes []Theme `json:"themes"`
+	// IDs of conversations the action was discussed in.
+	Conversations []string `json:"conversations"`
 }
 
 // GetID returns the value of ID.
@@ -52,7 +58,7 @@ func (s *Action) GetDescription() string {
 }
 
 // GetReferences returns the value of References.
-func (s *Action) GetReferences() OptNilString {
+func (s *Action) GetReferences() []Reference {
 	return s.References
 }
 
@@ -61,6 +67,11 @@ func (s *Action) GetValence() ActionValence {
 	return s.Valence
 }
 
+// GetImpact returns the value of Impact.
+func (s *Action) GetImpact() OptNilActionImpact {
+	return s.Impact
+}
+
 // GetCreatedAt returns the value of CreatedAt.
 func (s *Action) GetCreatedAt() time.Time {
 	return s.CreatedAt
@@ -76,6 +87,16 @@ func (s *Action) GetPersonName() OptString {
 	return s.PersonName
 }
 
+// GetThemes returns the value of Themes.
+func (s *Action) GetThemes() []Theme {
+	return s.Themes
+}
+
+// GetConversations returns the value of Conversations.
+func (s *Action) GetConversations() []string {
+	return s.Conversations
+}
+
 // SetID sets the value of ID.
 func (s *Action) SetID(val string) {
 	s.ID = val
@@ -97,7 +118,7 @@ func (s *Action) SetDescription(val string) {
 }
 
 // SetReferences sets the value of References.
-func (s *Action) SetReferences(val OptNilString) {
+func (s *Action) SetReferences(val []Reference) {
 	s.References = val
 }
 
@@ -106,6 +127,11 @@ func (s *Action) SetValence(val ActionValence) {
 	s.Valence = val
 }
 
+// SetImpact sets the value of Impact.
+func (s *Action) SetImpact(val OptNilActionImpact) {
+	s.Impact = val
+}
+
 // SetCreatedAt sets the value of CreatedAt.
 func (s *Action) SetCreatedAt(val time.Time) {
 	s.CreatedAt = val
@@ -121,32 +147,47 @@ func (s *Action) SetPersonName(val OptString) {
 	s.PersonName = val
 }
 
-func (*Action) createActionRes()  {}
-func (*Action) getActionByIdRes() {}
-func (*Action) updateActionRes()  {}
+// SetThemes sets the value of Themes.
+func (s *Action) SetThemes(val []Theme) {
+	s.Themes = val
+}
+
+// SetConversations sets the value of Conversations.
+func (s *Action) SetConversations(val []string) {
+	s.Conversations = val
+}
 
-// Whether the action was positive or negative.
-type ActionValence string
+func (*Action) createActionRes()          {}
+func (*Action) getActionByIdRes()         {}
+func (*Action) restoreActionRevisionRes() {}
+func (*Action) updateActionRes()          {}
+
+// How much the action mattered, when recorded.
+type ActionImpact string
 
 const (
-	ActionValencePositive ActionValence = "positive"
-	ActionValenceNegative ActionValence = "negative"
+	ActionImpactLow    ActionImpact = "low"
+	ActionImpactMedium ActionImpact = "medium"
+	ActionImpactHigh   ActionImpact = "high"
 )
 
-// AllValues returns all ActionValence values.
-func (ActionValence) AllValues() []ActionValence {
-	return []ActionValence{
-		ActionValencePositive,
-		ActionValenceNegative,
+// AllValues returns all ActionImpact values.
+func (ActionImpact) AllValues() []ActionImpact {
+	return []ActionImpact{
+		ActionImpactLow,
+		ActionImpactMedium,
+		ActionImpactHigh,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s ActionValence) MarshalText() ([]byte, error) {
+func (s ActionImpact) MarshalText() ([]byte, error) {
 	switch s {
-	case ActionValencePositive:
+	case ActionImpactLow:
 		return []byte(s), nil
-	case ActionValenceNegative:
+	case ActionImpactMedium:
+		return []byte(s), nil
+	case ActionImpactHigh:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -154,223 +195,210 @@ func (s ActionValence) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *ActionValence) UnmarshalText(data []byte) error {
-	switch ActionValence(data) {
-	case ActionValencePositive:
-		*s = ActionValencePositive
+func (s *ActionImpact) UnmarshalText(data []byte) error {
+	switch ActionImpact(data) {
+	case ActionImpactLow:
+		*s = ActionImpactLow
 		return nil
-	case ActionValenceNegative:
-		*s = ActionValenceNegative
+	case ActionImpactMedium:
+		*s = ActionImpactMedium
+		return nil
+	case ActionImpactHigh:
+		*s = ActionImpactHigh
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// Ref: #/components/schemas/Conversation
-type Conversation struct {
-	// Unique identifier (xid).
+// Ref: #/components/schemas/ActionRevision
+type ActionRevision struct {
 	ID string `json:"id"`
-	// ID of the person this conversation relates to.
-	PersonID string `json:"person_id"`
-	// When the conversation occurred.
-	OccurredAt time.Time `json:"occurred_at"`
-	// Description of the conversation.
-	Description string `json:"description"`
-	// When the conversation was created.
-	CreatedAt time.Time `json:"created_at"`
-	// When the conversation was last updated.
-	UpdatedAt time.Time `json:"updated_at"`
+	// Position in the action's history, starting at 1.
+	Number      int                        `json:"number"`
+	Description string                     `json:"description"`
+	Valence     ActionRevisionValence      `json:"valence"`
+	Impact      OptNilActionRevisionImpact `json:"impact"`
+	OccurredAt  time.Time                  `json:"occurred_at"`
+	References  []Reference                `json:"references"`
+	Themes      []Theme                    `json:"themes"`
+	Changes     []RevisionChange           `json:"changes"`
+	CreatedAt   time.Time                  `json:"created_at"`
 }
 
 // GetID returns the value of ID.
-func (s *Conversation) GetID() string {
+func (s *ActionRevision) GetID() string {
 	return s.ID
 }
 
-// GetPersonID returns the value of PersonID.
-func (s *Conversation) GetPersonID() string {
-	return s.PersonID
-}
-
-// GetOccurredAt returns the value of OccurredAt.
-func (s *Conversation) GetOccurredAt() time.Time {
-	return s.OccurredAt
+// GetNumber returns the value of Number.
+func (s *ActionRevision) GetNumber() int {
+	return s.Number
 }
 
 // GetDescription returns the value of Description.
-func (s *Conversation) GetDescription() string {
+func (s *ActionRevision) GetDescription() string {
 	return s.Description
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *Conversation) GetCreatedAt() time.Time {
-	return s.CreatedAt
-}
-
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *Conversation) GetUpdatedAt() time.Time {
-	return s.UpdatedAt
+// GetValence returns the value of Valence.
+func (s *ActionRevision) GetValence() ActionRevisionValence {
+	return s.Valence
 }
 
-// SetID sets the value of ID.
-func (s *Conversation) SetID(val string) {
-	s.ID = val
+// GetImpact returns the value of Impact.
+func (s *ActionRevision) GetImpact() OptNilActionRevisionImpact {
+	return s.Impact
 }
 
-// SetPersonID sets the value of PersonID.
-func (s *Conversation) SetPersonID(val string) {
-	s.PersonID = val
+// GetOccurredAt returns the value of OccurredAt.
+func (s *ActionRevision) GetOccurredAt() time.Time {
+	return s.OccurredAt
 }
 
-// SetOccurredAt sets the value of OccurredAt.
-func (s *Conversation) SetOccurredAt(val time.Time) {
-	s.OccurredAt = val
+// GetReferences returns the value of References.
+func (s *ActionRevision) GetReferences() []Reference {
+	return s.References
 }
 
-// SetDescription sets the value of Description.
-func (s *Conversation) SetDescription(val string) {
-	s.Description = val
+// GetThemes returns the value of Themes.
+func (s *ActionRevision) GetThemes() []Theme {
+	return s.Themes
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *Conversation) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+// GetChanges returns the value of Changes.
+func (s *ActionRevision) GetChanges() []RevisionChange {
+	return s.Changes
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *Conversation) SetUpdatedAt(val time.Time) {
-	s.UpdatedAt = val
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ActionRevision) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-func (*Conversation) createConversationRes() {}
-
-type CreateActionBadRequest Error
-
-func (*CreateActionBadRequest) createActionRes() {}
-
-type CreateActionCreatedTextHTML struct {
-	Data io.Reader
+// SetID sets the value of ID.
+func (s *ActionRevision) SetID(val string) {
+	s.ID = val
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s CreateActionCreatedTextHTML) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
+// SetNumber sets the value of Number.
+func (s *ActionRevision) SetNumber(val int) {
+	s.Number = val
 }
 
-func (*CreateActionCreatedTextHTML) createActionRes() {}
-
-type CreateActionInternalServerError Error
-
-func (*CreateActionInternalServerError) createActionRes() {}
-
-// Ref: #/components/schemas/CreateActionRequest
-type CreateActionRequest struct {
-	// ID of the person who performed the action.
-	PersonID string `json:"person_id"`
-	// When the action occurred.
-	OccurredAt time.Time `json:"occurred_at"`
-	// Description of the action.
-	Description string `json:"description"`
-	// Optional references or links related to the action.
-	References OptNilString `json:"references"`
-	// Emotional valence of the action.
-	Valence CreateActionRequestValence `json:"valence"`
-	// IDs of themes associated with the action.
-	Themes []string `json:"themes"`
+// SetDescription sets the value of Description.
+func (s *ActionRevision) SetDescription(val string) {
+	s.Description = val
 }
 
-// GetPersonID returns the value of PersonID.
-func (s *CreateActionRequest) GetPersonID() string {
-	return s.PersonID
+// SetValence sets the value of Valence.
+func (s *ActionRevision) SetValence(val ActionRevisionValence) {
+	s.Valence = val
 }
 
-// GetOccurredAt returns the value of OccurredAt.
-func (s *CreateActionRequest) GetOccurredAt() time.Time {
-	return s.OccurredAt
+// SetImpact sets the value of Impact.
+func (s *ActionRevision) SetImpact(val OptNilActionRevisionImpact) {
+	s.Impact = val
 }
 
-// GetDescription returns the value of Description.
-func (s *CreateActionRequest) GetDescription() string {
-	return s.Description
+// SetOccurredAt sets the value of OccurredAt.
+func (s *ActionRevision) SetOccurredAt(val time.Time) {
+	s.OccurredAt = val
 }
 
-// GetReferences returns the value of References.
-func (s *CreateActionRequest) GetReferences() OptNilString {
-	return s.References
+// SetReferences sets the value of References.
+func (s *ActionRevision) SetReferences(val []Reference) {
+	s.References = val
 }
 
-// GetValence returns the value of Valence.
-func (s *CreateActionRequest) GetValence() CreateActionRequestValence {
-	return s.Valence
+// SetThemes sets the value of Themes.
+func (s *ActionRevision) SetThemes(val []Theme) {
+	s.Themes = val
 }
 
-// GetThemes returns the value of Themes.
-func (s *CreateActionRequest) GetThemes() []string {
-	return s.Themes
+// SetChanges sets the value of Changes.
+func (s *ActionRevision) SetChanges(val []RevisionChange) {
+	s.Changes = val
 }
 
-// SetPersonID sets the value of PersonID.
-func (s *CreateActionRequest) SetPersonID(val string) {
-	s.PersonID = val
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ActionRevision) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
 }
 
-// SetOccurredAt sets the value of OccurredAt.
-func (s *CreateActionRequest) SetOccurredAt(val time.Time) {
-	s.OccurredAt = val
-}
+type ActionRevisionImpact string
 
-// SetDescription sets the value of Description.
-func (s *CreateActionRequest) SetDescription(val string) {
-	s.Description = val
-}
+const (
+	ActionRevisionImpactLow    ActionRevisionImpact = "low"
+	ActionRevisionImpactMedium ActionRevisionImpact = "medium"
+	ActionRevisionImpactHigh   ActionRevisionImpact = "high"
+)
 
-// SetReferences sets the value of References.
-func (s *CreateActionRequest) SetReferences(val OptNilString) {
-	s.References = val
+// AllValues returns all ActionRevisionImpact values.
+func (ActionRevisionImpact) AllValues() []ActionRevisionImpact {
+	return []ActionRevisionImpact{
+		ActionRevisionImpactLow,
+		ActionRevisionImpactMedium,
+		ActionRevisionImpactHigh,
+	}
 }
 
-// SetValence sets the value of Valence.
-func (s *CreateActionRequest) SetValence(val CreateActionRequestValence) {
-	s.Valence = val
+// MarshalText implements encoding.TextMarshaler.
+func (s ActionRevisionImpact) MarshalText() ([]byte, error) {
+	switch s {
+	case ActionRevisionImpactLow:
+		return []byte(s), nil
+	case ActionRevisionImpactMedium:
+		return []byte(s), nil
+	case ActionRevisionImpactHigh:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetThemes sets the value of Themes.
-func (s *CreateActionRequest) SetThemes(val []string) {
-	s.Themes = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ActionRevisionImpact) UnmarshalText(data []byte) error {
+	switch ActionRevisionImpact(data) {
+	case ActionRevisionImpactLow:
+		*s = ActionRevisionImpactLow
+		return nil
+	case ActionRevisionImpactMedium:
+		*s = ActionRevisionImpactMedium
+		return nil
+	case ActionRevisionImpactHigh:
+		*s = ActionRevisionImpactHigh
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// Emotional valence of the action.
-type CreateActionRequestValence string
+type ActionRevisionValence string
 
 const (
-	CreateActionRequestValencePositive CreateActionRequestValence = "positive"
-	CreateActionRequestValenceNegative CreateActionRequestValence = "negative"
-	CreateActionRequestValenceNeutral  CreateActionRequestValence = "neutral"
+	ActionRevisionValencePositive ActionRevisionValence = "positive"
+	ActionRevisionValenceNegative ActionRevisionValence = "negative"
+	ActionRevisionValenceNeutral  ActionRevisionValence = "neutral"
 )
 
-// AllValues returns all CreateActionRequestValence values.
-func (CreateActionRequestValence) AllValues() []CreateActionRequestValence {
-	return []CreateActionRequestValence{
-		CreateActionRequestValencePositive,
-		CreateActionRequestValenceNegative,
-		CreateActionRequestValenceNeutral,
+// AllValues returns all ActionRevisionValence values.
+func (ActionRevisionValence) AllValues() []ActionRevisionValence {
+	return []ActionRevisionValence{
+		ActionRevisionValencePositive,
+		ActionRevisionValenceNegative,
+		ActionRevisionValenceNeutral,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s CreateActionRequestValence) MarshalText() ([]byte, error) {
+func (s ActionRevisionValence) MarshalText() ([]byte, error) {
 	switch s {
-	case CreateActionRequestValencePositive:
+	case ActionRevisionValencePositive:
 		return []byte(s), nil
-	case CreateActionRequestValenceNegative:
+	case ActionRevisionValenceNegative:
 		return []byte(s), nil
-	case CreateActionRequestValenceNeutral:
+	case ActionRevisionValenceNeutral:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -378,296 +406,340 @@ func (s CreateActionRequestValence) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *CreateActionRequestValence) UnmarshalText(data []byte) error {
-	switch CreateActionRequestValence(data) {
-	case CreateActionRequestValencePositive:
-		*s = CreateActionRequestValencePositive
+func (s *ActionRevisionValence) UnmarshalText(data []byte) error {
+	switch ActionRevisionValence(data) {
+	case ActionRevisionValencePositive:
+		*s = ActionRevisionValencePositive
 		return nil
-	case CreateActionRequestValenceNegative:
-		*s = CreateActionRequestValenceNegative
+	case ActionRevisionValenceNegative:
+		*s = ActionRevisionValenceNegative
 		return nil
-	case CreateActionRequestValenceNeutral:
-		*s = CreateActionRequestValenceNeutral
+	case ActionRevisionValenceNeutral:
+		*s = ActionRevisionValenceNeutral
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-type CreateConversationBadRequest Error
+// Whether the action was positive, negative or neutral context.
+type ActionValence string
 
-func (*CreateConversationBadRequest) createConversationRes() {}
+const (
+	ActionValencePositive ActionValence = "positive"
+	ActionValenceNegative ActionValence = "negative"
+	ActionValenceNeutral  ActionValence = "neutral"
+)
 
-type CreateConversationCreatedTextHTML struct {
-	Data io.Reader
+// AllValues returns all ActionValence values.
+func (ActionValence) AllValues() []ActionValence {
+	return []ActionValence{
+		ActionValencePositive,
+		ActionValenceNegative,
+		ActionValenceNeutral,
+	}
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s CreateConversationCreatedTextHTML) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
+// MarshalText implements encoding.TextMarshaler.
+func (s ActionValence) MarshalText() ([]byte, error) {
+	switch s {
+	case ActionValencePositive:
+		return []byte(s), nil
+	case ActionValenceNegative:
+		return []byte(s), nil
+	case ActionValenceNeutral:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return s.Data.Read(p)
 }
 
-func (*CreateConversationCreatedTextHTML) createConversationRes() {}
-
-type CreateConversationInternalServerError Error
-
-func (*CreateConversationInternalServerError) createConversationRes() {}
-
-// Ref: #/components/schemas/CreateConversationRequest
-type CreateConversationRequest struct {
-	// ID of the person this conversation relates to.
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ActionValence) UnmarshalText(data []byte) error {
+	switch ActionValence(data) {
+	case ActionValencePositive:
+		*s = ActionValencePositive
+		return nil
+	case ActionValenceNegative:
+		*s = ActionValenceNegative
+		return nil
+	case ActionValenceNeutral:
+		*s = ActionValenceNeutral
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/Agenda
+type Agenda struct {
 	PersonID string `json:"person_id"`
-	// When the conversation occurred.
-	OccurredAt time.Time `json:"occurred_at"`
-	// Description of the conversation.
-	Description string `json:"description"`
-	// IDs of actions associated with the conversation.
-	Actions []string `json:"actions"`
-	// IDs of themes associated with the conversation.
-	Themes []string `json:"themes"`
+	// When the last conversation occurred; null if there has not been one.
+	Since  OptNilDateTime `json:"since"`
+	Groups []AgendaGroup  `json:"groups"`
 }
 
 // GetPersonID returns the value of PersonID.
-func (s *CreateConversationRequest) GetPersonID() string {
+func (s *Agenda) GetPersonID() string {
 	return s.PersonID
 }
 
-// GetOccurredAt returns the value of OccurredAt.
-func (s *CreateConversationRequest) GetOccurredAt() time.Time {
-	return s.OccurredAt
-}
-
-// GetDescription returns the value of Description.
-func (s *CreateConversationRequest) GetDescription() string {
-	return s.Description
-}
-
-// GetActions returns the value of Actions.
-func (s *CreateConversationRequest) GetActions() []string {
-	return s.Actions
+// GetSince returns the value of Since.
+func (s *Agenda) GetSince() OptNilDateTime {
+	return s.Since
 }
 
-// GetThemes returns the value of Themes.
-func (s *CreateConversationRequest) GetThemes() []string {
-	return s.Themes
+// GetGroups returns the value of Groups.
+func (s *Agenda) GetGroups() []AgendaGroup {
+	return s.Groups
 }
 
 // SetPersonID sets the value of PersonID.
-func (s *CreateConversationRequest) SetPersonID(val string) {
+func (s *Agenda) SetPersonID(val string) {
 	s.PersonID = val
 }
 
-// SetOccurredAt sets the value of OccurredAt.
-func (s *CreateConversationRequest) SetOccurredAt(val time.Time) {
-	s.OccurredAt = val
+// SetSince sets the value of Since.
+func (s *Agenda) SetSince(val OptNilDateTime) {
+	s.Since = val
 }
 
-// SetDescription sets the value of Description.
-func (s *CreateConversationRequest) SetDescription(val string) {
-	s.Description = val
+// SetGroups sets the value of Groups.
+func (s *Agenda) SetGroups(val []AgendaGroup) {
+	s.Groups = val
 }
 
-// SetActions sets the value of Actions.
-func (s *CreateConversationRequest) SetActions(val []string) {
-	s.Actions = val
-}
+func (*Agenda) getPersonAgendaRes() {}
 
-// SetThemes sets the value of Themes.
-func (s *CreateConversationRequest) SetThemes(val []string) {
-	s.Themes = val
+// Ref: #/components/schemas/AgendaGroup
+type AgendaGroup struct {
+	Theme   OptTheme           `json:"theme"`
+	Valence AgendaGroupValence `json:"valence"`
+	Actions []Action           `json:"actions"`
 }
 
-type CreatePersonBadRequest Error
-
-func (*CreatePersonBadRequest) createPersonRes() {}
-
-type CreatePersonCreatedTextHTML struct {
-	Data io.Reader
+// GetTheme returns the value of Theme.
+func (s *AgendaGroup) GetTheme() OptTheme {
+	return s.Theme
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s CreatePersonCreatedTextHTML) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
+// GetValence returns the value of Valence.
+func (s *AgendaGroup) GetValence() AgendaGroupValence {
+	return s.Valence
 }
 
-func (*CreatePersonCreatedTextHTML) createPersonRes() {}
-
-type CreatePersonInternalServerError Error
-
-func (*CreatePersonInternalServerError) createPersonRes() {}
-
-// Ref: #/components/schemas/CreatePersonRequest
-type CreatePersonRequest struct {
-	// Full name of the person.
-	Name string `json:"name"`
+// GetActions returns the value of Actions.
+func (s *AgendaGroup) GetActions() []Action {
+	return s.Actions
 }
 
-// GetName returns the value of Name.
-func (s *CreatePersonRequest) GetName() string {
-	return s.Name
+// SetTheme sets the value of Theme.
+func (s *AgendaGroup) SetTheme(val OptTheme) {
+	s.Theme = val
 }
 
-// SetName sets the value of Name.
-func (s *CreatePersonRequest) SetName(val string) {
-	s.Name = val
+// SetValence sets the value of Valence.
+func (s *AgendaGroup) SetValence(val AgendaGroupValence) {
+	s.Valence = val
 }
 
-type DeleteActionInternalServerError Error
-
-func (*DeleteActionInternalServerError) deleteActionRes() {}
-
-// DeleteActionNoContent is response for DeleteAction operation.
-type DeleteActionNoContent struct{}
-
-func (*DeleteActionNoContent) deleteActionRes() {}
-
-type DeleteActionNotFound Error
-
-func (*DeleteActionNotFound) deleteActionRes() {}
-
-type DeletePersonInternalServerError Error
-
-func (*DeletePersonInternalServerError) deletePersonRes() {}
+// SetActions sets the value of Actions.
+func (s *AgendaGroup) SetActions(val []Action) {
+	s.Actions = val
+}
 
-// DeletePersonNoContent is response for DeletePerson operation.
-type DeletePersonNoContent struct{}
+type AgendaGroupValence string
 
-func (*DeletePersonNoContent) deletePersonRes() {}
+const (
+	AgendaGroupValencePositive AgendaGroupValence = "positive"
+	AgendaGroupValenceNegative AgendaGroupValence = "negative"
+	AgendaGroupValenceNeutral  AgendaGroupValence = "neutral"
+)
 
-type DeletePersonNotFound Error
+// AllValues returns all AgendaGroupValence values.
+func (AgendaGroupValence) AllValues() []AgendaGroupValence {
+	return []AgendaGroupValence{
+		AgendaGroupValencePositive,
+		AgendaGroupValenceNegative,
+		AgendaGroupValenceNeutral,
+	}
+}
 
-func (*DeletePersonNotFound) deletePersonRes() {}
+// MarshalText implements encoding.TextMarshaler.
+func (s AgendaGroupValence) MarshalText() ([]byte, error) {
+	switch s {
+	case AgendaGroupValencePositive:
+		return []byte(s), nil
+	case AgendaGroupValenceNegative:
+		return []byte(s), nil
+	case AgendaGroupValenceNeutral:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
 
-// Ref: #/components/schemas/Error
-type Error struct {
-	// Error message.
-	Message string `json:"message"`
-	// Error code.
-	Code string `json:"code"`
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AgendaGroupValence) UnmarshalText(data []byte) error {
+	switch AgendaGroupValence(data) {
+	case AgendaGroupValencePositive:
+		*s = AgendaGroupValencePositive
+		return nil
+	case AgendaGroupValenceNegative:
+		*s = AgendaGroupValenceNegative
+		return nil
+	case AgendaGroupValenceNeutral:
+		*s = AgendaGroupValenceNeutral
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetMessage returns the value of Message.
-func (s *Error) GetMessage() string {
-	return s.Message
+// Ref: #/components/schemas/AuditEntry
+type AuditEntry struct {
+	ID string `json:"id"`
+	// The API operation name, or the MCP tool name.
+	Operation string `json:"operation"`
+	// Ids of the people, actions, conversations and themes involved.
+	EntityIds []string      `json:"entity_ids"`
+	Via       AuditEntryVia `json:"via"`
+	// HTTP method; empty for MCP tool calls.
+	Method string `json:"method"`
+	// Requested path and query; empty for MCP tool calls.
+	Path       string `json:"path"`
+	RemoteAddr string `json:"remote_addr"`
+	UserAgent  string `json:"user_agent"`
+	// The response the operation produced, e.g. Person or GetPersonByIdNotFound.
+	Outcome   string    `json:"outcome"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
-// GetCode returns the value of Code.
-func (s *Error) GetCode() string {
-	return s.Code
+// GetID returns the value of ID.
+func (s *AuditEntry) GetID() string {
+	return s.ID
 }
 
-// SetMessage sets the value of Message.
-func (s *Error) SetMessage(val string) {
-	s.Message = val
+// GetOperation returns the value of Operation.
+func (s *AuditEntry) GetOperation() string {
+	return s.Operation
 }
 
-// SetCode sets the value of Code.
-func (s *Error) SetCode(val string) {
-	s.Code = val
+// GetEntityIds returns the value of EntityIds.
+func (s *AuditEntry) GetEntityIds() []string {
+	return s.EntityIds
 }
 
-func (*Error) getActionsRes() {}
-func (*Error) getPersonsRes() {}
+// GetVia returns the value of Via.
+func (s *AuditEntry) GetVia() AuditEntryVia {
+	return s.Via
+}
 
-type GetActionByIdInternalServerError Error
+// GetMethod returns the value of Method.
+func (s *AuditEntry) GetMethod() string {
+	return s.Method
+}
 
-func (*GetActionByIdInternalServerError) getActionByIdRes() {}
+// GetPath returns the value of Path.
+func (s *AuditEntry) GetPath() string {
+	return s.Path
+}
 
-type GetActionByIdNotFound Error
+// GetRemoteAddr returns the value of RemoteAddr.
+func (s *AuditEntry) GetRemoteAddr() string {
+	return s.RemoteAddr
+}
 
-func (*GetActionByIdNotFound) getActionByIdRes() {}
+// GetUserAgent returns the value of UserAgent.
+func (s *AuditEntry) GetUserAgent() string {
+	return s.UserAgent
+}
 
-type GetActionByIdOKTextHTML struct {
-	Data io.Reader
+// GetOutcome returns the value of Outcome.
+func (s *AuditEntry) GetOutcome() string {
+	return s.Outcome
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s GetActionByIdOKTextHTML) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
+// GetCreatedAt returns the value of CreatedAt.
+func (s *AuditEntry) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-func (*GetActionByIdOKTextHTML) getActionByIdRes() {}
+// SetID sets the value of ID.
+func (s *AuditEntry) SetID(val string) {
+	s.ID = val
+}
 
-type GetActionsOKApplicationJSON struct {
-	Actions []Action `json:"actions"`
-	// Total number of actions.
-	Total int `json:"total"`
+// SetOperation sets the value of Operation.
+func (s *AuditEntry) SetOperation(val string) {
+	s.Operation = val
 }
 
-// GetActions returns the value of Actions.
-func (s *GetActionsOKApplicationJSON) GetActions() []Action {
-	return s.Actions
+// SetEntityIds sets the value of EntityIds.
+func (s *AuditEntry) SetEntityIds(val []string) {
+	s.EntityIds = val
 }
 
-// GetTotal returns the value of Total.
-func (s *GetActionsOKApplicationJSON) GetTotal() int {
-	return s.Total
+// SetVia sets the value of Via.
+func (s *AuditEntry) SetVia(val AuditEntryVia) {
+	s.Via = val
 }
 
-// SetActions sets the value of Actions.
-func (s *GetActionsOKApplicationJSON) SetActions(val []Action) {
-	s.Actions = val
+// SetMethod sets the value of Method.
+func (s *AuditEntry) SetMethod(val string) {
+	s.Method = val
 }
 
-// SetTotal sets the value of Total.
-func (s *GetActionsOKApplicationJSON) SetTotal(val int) {
-	s.Total = val
+// SetPath sets the value of Path.
+func (s *AuditEntry) SetPath(val string) {
+	s.Path = val
 }
 
-func (*GetActionsOKApplicationJSON) getActionsRes() {}
+// SetRemoteAddr sets the value of RemoteAddr.
+func (s *AuditEntry) SetRemoteAddr(val string) {
+	s.RemoteAddr = val
+}
 
-type GetActionsOKTextHTML struct {
-	Data io.Reader
+// SetUserAgent sets the value of UserAgent.
+func (s *AuditEntry) SetUserAgent(val string) {
+	s.UserAgent = val
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s GetActionsOKTextHTML) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
+// SetOutcome sets the value of Outcome.
+func (s *AuditEntry) SetOutcome(val string) {
+	s.Outcome = val
 }
 
-func (*GetActionsOKTextHTML) getActionsRes() {}
+// SetCreatedAt sets the value of CreatedAt.
+func (s *AuditEntry) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
 
-type GetActionsValence string
+type AuditEntryVia string
 
 const (
-	GetActionsValencePositive GetActionsValence = "positive"
-	GetActionsValenceNegative GetActionsValence = "negative"
+	AuditEntryViaSession AuditEntryVia = "session"
+	AuditEntryViaToken   AuditEntryVia = "token"
+	AuditEntryViaMcp     AuditEntryVia = "mcp"
 )
 
-// AllValues returns all GetActionsValence values.
-func (GetActionsValence) AllValues() []GetActionsValence {
-	return []GetActionsValence{
-		GetActionsValencePositive,
-		GetActionsValenceNegative,
+// AllValues returns all AuditEntryVia values.
+func (AuditEntryVia) AllValues() []AuditEntryVia {
+	return []AuditEntryVia{
+		AuditEntryViaSession,
+		AuditEntryViaToken,
+		AuditEntryViaMcp,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s GetActionsValence) MarshalText() ([]byte, error) {
+func (s AuditEntryVia) MarshalText() ([]byte, error) {
 	switch s {
-	case GetActionsValencePositive:
+	case AuditEntryViaSession:
 		return []byte(s), nil
-	case GetActionsValenceNegative:
+	case AuditEntryViaToken:
+		return []byte(s), nil
+	case AuditEntryViaMcp:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -675,636 +747,5136 @@ func (s GetActionsValence) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *GetActionsValence) UnmarshalText(data []byte) error {
-	switch GetActionsValence(data) {
-	case GetActionsValencePositive:
-		*s = GetActionsValencePositive
+func (s *AuditEntryVia) UnmarshalText(data []byte) error {
+	switch AuditEntryVia(data) {
+	case AuditEntryViaSession:
+		*s = AuditEntryViaSession
 		return nil
-	case GetActionsValenceNegative:
-		*s = GetActionsValenceNegative
+	case AuditEntryViaToken:
+		*s = AuditEntryViaToken
+		return nil
+	case AuditEntryViaMcp:
+		*s = AuditEntryViaMcp
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-type GetPersonActionsInternalServerError Error
-
-func (*GetPersonActionsInternalServerError) getPersonActionsRes() {}
-
-type GetPersonActionsNotFound Error
-
-func (*GetPersonActionsNotFound) getPersonActionsRes() {}
+type BearerAuth struct {
+	Token string
+	Roles []string
+}
 
-type GetPersonActionsOKApplicationJSON struct {
-	Actions []Action `json:"actions"`
-	// Total number of actions.
-	Total int `json:"total"`
+// GetToken returns the value of Token.
+func (s *BearerAuth) GetToken() string {
+	return s.Token
 }
 
-// GetActions returns the value of Actions.
-func (s *GetPersonActionsOKApplicationJSON) GetActions() []Action {
-	return s.Actions
+// GetRoles returns the value of Roles.
+func (s *BearerAuth) GetRoles() []string {
+	return s.Roles
 }
 
-// GetTotal returns the value of Total.
-func (s *GetPersonActionsOKApplicationJSON) GetTotal() int {
-	return s.Total
+// SetToken sets the value of Token.
+func (s *BearerAuth) SetToken(val string) {
+	s.Token = val
 }
 
-// SetActions sets the value of Actions.
-func (s *GetPersonActionsOKApplicationJSON) SetActions(val []Action) {
-	s.Actions = val
+// SetRoles sets the value of Roles.
+func (s *BearerAuth) SetRoles(val []string) {
+	s.Roles = val
 }
 
-// SetTotal sets the value of Total.
-func (s *GetPersonActionsOKApplicationJSON) SetTotal(val int) {
-	s.Total = val
-}
+type CompleteFollowUpInternalServerError Error
 
-func (*GetPersonActionsOKApplicationJSON) getPersonActionsRes() {}
+func (*CompleteFollowUpInternalServerError) completeFollowUpRes() {}
 
-type GetPersonActionsOKTextHTML struct {
+type CompleteFollowUpNotFound Error
+
+func (*CompleteFollowUpNotFound) completeFollowUpRes() {}
+
+type CompleteFollowUpOKTextHTML struct {
 	Data io.Reader
 }
 
 // Read reads data from the Data reader.
 //
 // Kept to satisfy the io.Reader interface.
-func (s GetPersonActionsOKTextHTML) Read(p []byte) (n int, err error) {
+func (s CompleteFollowUpOKTextHTML) Read(p []byte) (n int, err error) {
 	if s.Data == nil {
 		return 0, io.EOF
 	}
 	return s.Data.Read(p)
 }
 
-func (*GetPersonActionsOKTextHTML) getPersonActionsRes() {}
+func (*CompleteFollowUpOKTextHTML) completeFollowUpRes() {}
 
-type GetPersonActionsValence string
+// Ref: #/components/schemas/Conversation
+type Conversation struct {
+	// Unique identifier (xid).
+	ID string `json:"id"`
+	// ID of the person this conversation relates to.
+	PersonID string `json:"person_id"`
+	// When the conversation occurred.
+	OccurredAt time.Time `json:"occurred_at"`
+	// Description of the conversation.
+	Description string `json:"description"`
+	// When the conversation was created.
+	CreatedAt time.Time `json:"created_at"`
+	// When the conversation was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+	// Themes associated with the conversation.
+	Themes []Theme `json:"themes"`
+	// IDs of actions associated with the conversation.
+	Actions []string `json:"actions"`
+}
 
-const (
-	GetPersonActionsValencePositive GetPersonActionsValence = "positive"
-	GetPersonActionsValenceNegative GetPersonActionsValence = "negative"
-)
+// GetID returns the value of ID.
+func (s *Conversation) GetID() string {
+	return s.ID
+}
 
-// AllValues returns all GetPersonActionsValence values.
-func (GetPersonActionsValence) AllValues() []GetPersonActionsValence {
-	return []GetPersonActionsValence{
-		GetPersonActionsValencePositive,
-		GetPersonActionsValenceNegative,
-	}
+// GetPersonID returns the value of PersonID.
+func (s *Conversation) GetPersonID() string {
+	return s.PersonID
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s GetPersonActionsValence) MarshalText() ([]byte, error) {
-	switch s {
-	case GetPersonActionsValencePositive:
-		return []byte(s), nil
-	case GetPersonActionsValenceNegative:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// GetOccurredAt returns the value of OccurredAt.
+func (s *Conversation) GetOccurredAt() time.Time {
+	return s.OccurredAt
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *GetPersonActionsValence) UnmarshalText(data []byte) error {
-	switch GetPersonActionsValence(data) {
-	case GetPersonActionsValencePositive:
-		*s = GetPersonActionsValencePositive
-		return nil
-	case GetPersonActionsValenceNegative:
-		*s = GetPersonActionsValenceNegative
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// GetDescription returns the value of Description.
+func (s *Conversation) GetDescription() string {
+	return s.Description
 }
 
-type GetPersonByIdInternalServerError Error
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Conversation) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
 
-func (*GetPersonByIdInternalServerError) getPersonByIdRes() {}
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Conversation) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
 
-type GetPersonByIdNotFound Error
+// GetThemes returns the value of Themes.
+func (s *Conversation) GetThemes() []Theme {
+	return s.Themes
+}
 
-func (*GetPersonByIdNotFound) getPersonByIdRes() {}
+// GetActions returns the value of Actions.
+func (s *Conversation) GetActions() []string {
+	return s.Actions
+}
 
-type GetPersonByIdOKTextHTML struct {
-	Data io.Reader
+// SetID sets the value of ID.
+func (s *Conversation) SetID(val string) {
+	s.ID = val
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s GetPersonByIdOKTextHTML) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
+// SetPersonID sets the value of PersonID.
+func (s *Conversation) SetPersonID(val string) {
+	s.PersonID = val
 }
 
-func (*GetPersonByIdOKTextHTML) getPersonByIdRes() {}
+// SetOccurredAt sets the value of OccurredAt.
+func (s *Conversation) SetOccurredAt(val time.Time) {
+	s.OccurredAt = val
+}
 
-type GetPersonTimelineInternalServerError Error
+// SetDescription sets the value of Description.
+func (s *Conversation) SetDescription(val string) {
+	s.Description = val
+}
 
-func (*GetPersonTimelineInternalServerError) getPersonTimelineRes() {}
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Conversation) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
 
-type GetPersonTimelineNotFound Error
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Conversation) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
 
-func (*GetPersonTimelineNotFound) getPersonTimelineRes() {}
+// SetThemes sets the value of Themes.
+func (s *Conversation) SetThemes(val []Theme) {
+	s.Themes = val
+}
 
-type GetPersonTimelineOKApplicationJSON struct {
-	Items []TimelineItem `json:"items"`
-	// Total number of timeline items.
-	Total int `json:"total"`
+// SetActions sets the value of Actions.
+func (s *Conversation) SetActions(val []string) {
+	s.Actions = val
 }
 
-// GetItems returns the value of Items.
-func (s *GetPersonTimelineOKApplicationJSON) GetItems() []TimelineItem {
-	return s.Items
+func (*Conversation) createConversationRes()          {}
+func (*Conversation) getConversationByIdRes()         {}
+func (*Conversation) restoreConversationRevisionRes() {}
+func (*Conversation) updateConversationRes()          {}
+
+// Ref: #/components/schemas/ConversationRevision
+type ConversationRevision struct {
+	ID string `json:"id"`
+	// Position in the conversation's history, starting at 1.
+	Number      int              `json:"number"`
+	Description string           `json:"description"`
+	OccurredAt  time.Time        `json:"occurred_at"`
+	Themes      []Theme          `json:"themes"`
+	Changes     []RevisionChange `json:"changes"`
+	CreatedAt   time.Time        `json:"created_at"`
 }
 
-// GetTotal returns the value of Total.
-func (s *GetPersonTimelineOKApplicationJSON) GetTotal() int {
-	return s.Total
+// GetID returns the value of ID.
+func (s *ConversationRevision) GetID() string {
+	return s.ID
 }
 
-// SetItems sets the value of Items.
-func (s *GetPersonTimelineOKApplicationJSON) SetItems(val []TimelineItem) {
-	s.Items = val
+// GetNumber returns the value of Number.
+func (s *ConversationRevision) GetNumber() int {
+	return s.Number
 }
 
-// SetTotal sets the value of Total.
-func (s *GetPersonTimelineOKApplicationJSON) SetTotal(val int) {
-	s.Total = val
+// GetDescription returns the value of Description.
+func (s *ConversationRevision) GetDescription() string {
+	return s.Description
 }
 
-func (*GetPersonTimelineOKApplicationJSON) getPersonTimelineRes() {}
+// GetOccurredAt returns the value of OccurredAt.
+func (s *ConversationRevision) GetOccurredAt() time.Time {
+	return s.OccurredAt
+}
 
-type GetPersonTimelineOKTextHTML struct {
-	Data io.Reader
+// GetThemes returns the value of Themes.
+func (s *ConversationRevision) GetThemes() []Theme {
+	return s.Themes
 }
 
-// Read reads data from the Data reader.
-//
-// Kept to satisfy the io.Reader interface.
-func (s GetPersonTimelineOKTextHTML) Read(p []byte) (n int, err error) {
-	if s.Data == nil {
-		return 0, io.EOF
-	}
-	return s.Data.Read(p)
+// GetChanges returns the value of Changes.
+func (s *ConversationRevision) GetChanges() []RevisionChange {
+	return s.Changes
 }
 
-func (*GetPersonTimelineOKTextHTML) getPersonTimelineRes() {}
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ConversationRevision) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
 
-type GetPersonsOKApplicationJSON struct {
-	Persons []Person `json:"persons"`
-	// Total number of persons.
-	Total int `json:"total"`
+// SetID sets the value of ID.
+func (s *ConversationRevision) SetID(val string) {
+	s.ID = val
 }
 
-// GetPersons returns the value of Persons.
-func (s *GetPersonsOKApplicationJSON) GetPersons() []Person {
-	return s.Persons
+// SetNumber sets the value of Number.
+func (s *ConversationRevision) SetNumber(val int) {
+	s.Number = val
 }
 
-// GetTotal returns the value of Total.
-func (s *GetPersonsOKApplicationJSON) GetTotal() int {
-	return s.Total
+// SetDescription sets the value of Description.
+func (s *ConversationRevision) SetDescription(val string) {
+	s.Description = val
 }
 
-// SetPersons sets the value of Persons.
-func (s *GetPersonsOKApplicationJSON) SetPersons(val []Person) {
-	s.Persons = val
+// SetOccurredAt sets the value of OccurredAt.
+func (s *ConversationRevision) SetOccurredAt(val time.Time) {
+	s.OccurredAt = val
 }
 
-// SetTotal sets the value of Total.
-func (s *GetPersonsOKApplicationJSON) SetTotal(val int) {
-	s.Total = val
+// SetThemes sets the value of Themes.
+func (s *ConversationRevision) SetThemes(val []Theme) {
+	s.Themes = val
 }
 
-func (*GetPersonsOKApplicationJSON) getPersonsRes() {}
+// SetChanges sets the value of Changes.
+func (s *ConversationRevision) SetChanges(val []RevisionChange) {
+	s.Changes = val
+}
 
-type GetPersonsOKTextHTML struct {
+// SetCreatedAt sets the value of CreatedAt.
+func (s *ConversationRevision) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+type CreateActionBadRequest Error
+
+func (*CreateActionBadRequest) createActionRes() {}
+
+type CreateActionCreatedTextHTML struct {
 	Data io.Reader
 }
 
 // Read reads data from the Data reader.
 //
 // Kept to satisfy the io.Reader interface.
-func (s GetPersonsOKTextHTML) Read(p []byte) (n int, err error) {
+func (s CreateActionCreatedTextHTML) Read(p []byte) (n int, err error) {
 	if s.Data == nil {
 		return 0, io.EOF
 	}
 	return s.Data.Read(p)
 }
 
-func (*GetPersonsOKTextHTML) getPersonsRes() {}
-
-// NewOptGetActionsValence returns new OptGetActionsValence with value set to v.
-func NewOptGetActionsValence(v GetActionsValence) OptGetActionsValence {
-	return OptGetActionsValence{
-		Value: v,
-		Set:   true,
-	}
-}
+func (*CreateActionCreatedTextHTML) createActionRes() {}
 
-// OptGetActionsValence is optional GetActionsValence.
-type OptGetActionsValence struct {
-	Value GetActionsValence
-	Set   bool
-}
+type CreateActionInternalServerError Error
 
-// IsSet returns true if OptGetActionsValence was set.
-func (o OptGetActionsValence) IsSet() bool { return o.Set }
+func (*CreateActionInternalServerError) createActionRes() {}
 
-// Reset unsets value.
-func (o *OptGetActionsValence) Reset() {
-	var v GetActionsValence
-	o.Value = v
-	o.Set = false
+// Ref: #/components/schemas/CreateActionRequest
+type CreateActionRequest struct {
+	// ID of the person who performed the action.
+	PersonID string `json:"person_id"`
+	// When the action occurred.
+	OccurredAt time.Time `json:"occurred_at"`
+	// Description of the action.
+	Description string `json:"description"`
+	// References to store with the action; on update these replace the existing ones.
+	References []ReferenceInput `json:"references"`
+	// Emotional valence of the action.
+	Valence CreateActionRequestValence `json:"valence"`
+	// How much the action mattered, when recorded.
+	Impact OptNilCreateActionRequestImpact `json:"impact"`
+	// IDs of themes associated with the action.
+	Themes []string `json:"themes"`
 }
 
-// SetTo sets value to v.
-func (o *OptGetActionsValence) SetTo(v GetActionsValence) {
-	o.Set = true
-	o.Value = v
+// GetPersonID returns the value of PersonID.
+func (s *CreateActionRequest) GetPersonID() string {
+	return s.PersonID
 }
 
-// Get returns value and boolean that denotes whether value was set.
+// GetOccurredAt returns the value of OccurredAt.
+func (s *CreateActionRequest) GetOccurredAt() time.Time {
+	return s.OccurredAt
+}
+
+// GetDescription returns the value of Description.
+func (s *CreateActionRequest) GetDescription() string {
+	return s.Description
+}
+
+// GetReferences returns the value of References.
+func (s *CreateActionRequest) GetReferences() []ReferenceInput {
+	return s.References
+}
+
+// GetValence returns the value of Valence.
+func (s *CreateActionRequest) GetValence() CreateActionRequestValence {
+	return s.Valence
+}
+
+// GetImpact returns the value of Impact.
+func (s *CreateActionRequest) GetImpact() OptNilCreateActionRequestImpact {
+	return s.Impact
+}
+
+// GetThemes returns the value of Themes.
+func (s *CreateActionRequest) GetThemes() []string {
+	return s.Themes
+}
+
+// SetPersonID sets the value of PersonID.
+func (s *CreateActionRequest) SetPersonID(val string) {
+	s.PersonID = val
+}
+
+// SetOccurredAt sets the value of OccurredAt.
+func (s *CreateActionRequest) SetOccurredAt(val time.Time) {
+	s.OccurredAt = val
+}
+
+// SetDescription sets the value of Description.
+func (s *CreateActionRequest) SetDescription(val string) {
+	s.Description = val
+}
+
+// SetReferences sets the value of References.
+func (s *CreateActionRequest) SetReferences(val []ReferenceInput) {
+	s.References = val
+}
+
+// SetValence sets the value of Valence.
+func (s *CreateActionRequest) SetValence(val CreateActionRequestValence) {
+	s.Valence = val
+}
+
+// SetImpact sets the value of Impact.
+func (s *CreateActionRequest) SetImpact(val OptNilCreateActionRequestImpact) {
+	s.Impact = val
+}
+
+// SetThemes sets the value of Themes.
+func (s *CreateActionRequest) SetThemes(val []string) {
+	s.Themes = val
+}
+
+// How much the action mattered, when recorded.
+type CreateActionRequestImpact string
+
+const (
+	CreateActionRequestImpactLow    CreateActionRequestImpact = "low"
+	CreateActionRequestImpactMedium CreateActionRequestImpact = "medium"
+	CreateActionRequestImpactHigh   CreateActionRequestImpact = "high"
+)
+
+// AllValues returns all CreateActionRequestImpact values.
+func (CreateActionRequestImpact) AllValues() []CreateActionRequestImpact {
+	return []CreateActionRequestImpact{
+		CreateActionRequestImpactLow,
+		CreateActionRequestImpactMedium,
+		CreateActionRequestImpactHigh,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CreateActionRequestImpact) MarshalText() ([]byte, error) {
+	switch s {
+	case CreateActionRequestImpactLow:
+		return []byte(s), nil
+	case CreateActionRequestImpactMedium:
+		return []byte(s), nil
+	case CreateActionRequestImpactHigh:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CreateActionRequestImpact) UnmarshalText(data []byte) error {
+	switch CreateActionRequestImpact(data) {
+	case CreateActionRequestImpactLow:
+		*s = CreateActionRequestImpactLow
+		return nil
+	case CreateActionRequestImpactMedium:
+		*s = CreateActionRequestImpactMedium
+		return nil
+	case CreateActionRequestImpactHigh:
+		*s = CreateActionRequestImpactHigh
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Emotional valence of the action.
+type CreateActionRequestValence string
+
+const (
+	CreateActionRequestValencePositive CreateActionRequestValence = "positive"
+	CreateActionRequestValenceNegative CreateActionRequestValence = "negative"
+	CreateActionRequestValenceNeutral  CreateActionRequestValence = "neutral"
+)
+
+// AllValues returns all CreateActionRequestValence values.
+func (CreateActionRequestValence) AllValues() []CreateActionRequestValence {
+	return []CreateActionRequestValence{
+		CreateActionRequestValencePositive,
+		CreateActionRequestValenceNegative,
+		CreateActionRequestValenceNeutral,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CreateActionRequestValence) MarshalText() ([]byte, error) {
+	switch s {
+	case CreateActionRequestValencePositive:
+		return []byte(s), nil
+	case CreateActionRequestValenceNegative:
+		return []byte(s), nil
+	case CreateActionRequestValenceNeutral:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CreateActionRequestValence) UnmarshalText(data []byte) error {
+	switch CreateActionRequestValence(data) {
+	case CreateActionRequestValencePositive:
+		*s = CreateActionRequestValencePositive
+		return nil
+	case CreateActionRequestValenceNegative:
+		*s = CreateActionRequestValenceNegative
+		return nil
+	case CreateActionRequestValenceNeutral:
+		*s = CreateActionRequestValenceNeutral
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type CreateConversationBadRequest Error
+
+func (*CreateConversationBadRequest) createConversationRes() {}
+
+type CreateConversationCreatedTextHTML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s CreateConversationCreatedTextHTML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*CreateConversationCreatedTextHTML) createConversationRes() {}
+
+type CreateConversationInternalServerError Error
+
+func (*CreateConversationInternalServerError) createConversationRes() {}
+
+// Ref: #/components/schemas/CreateConversationRequest
+type CreateConversationRequest struct {
+	// ID of the person this conversation relates to.
+	PersonID string `json:"person_id"`
+	// When the conversation occurred.
+	OccurredAt time.Time `json:"occurred_at"`
+	// Description of the conversation.
+	Description string `json:"description"`
+	// IDs of actions associated with the conversation.
+	Actions []string `json:"actions"`
+	// IDs of themes associated with the conversation.
+	Themes []string `json:"themes"`
+}
+
+// GetPersonID returns the value of PersonID.
+func (s *CreateConversationRequest) GetPersonID() string {
+	return s.PersonID
+}
+
+// GetOccurredAt returns the value of OccurredAt.
+func (s *CreateConversationRequest) GetOccurredAt() time.Time {
+	return s.OccurredAt
+}
+
+// GetDescription returns the value of Description.
+func (s *CreateConversationRequest) GetDescription() string {
+	return s.Description
+}
+
+// GetActions returns the value of Actions.
+func (s *CreateConversationRequest) GetActions() []string {
+	return s.Actions
+}
+
+// GetThemes returns the value of Themes.
+func (s *CreateConversationRequest) GetThemes() []string {
+	return s.Themes
+}
+
+// SetPersonID sets the value of PersonID.
+func (s *CreateConversationRequest) SetPersonID(val string) {
+	s.PersonID = val
+}
+
+// SetOccurredAt sets the value of OccurredAt.
+func (s *CreateConversationRequest) SetOccurredAt(val time.Time) {
+	s.OccurredAt = val
+}
+
+// SetDescription sets the value of Description.
+func (s *CreateConversationRequest) SetDescription(val string) {
+	s.Description = val
+}
+
+// SetActions sets the value of Actions.
+func (s *CreateConversationRequest) SetActions(val []string) {
+	s.Actions = val
+}
+
+// SetThemes sets the value of Themes.
+func (s *CreateConversationRequest) SetThemes(val []string) {
+	s.Themes = val
+}
+
+type CreateFollowUpBadRequest Error
+
+func (*CreateFollowUpBadRequest) createFollowUpRes() {}
+
+type CreateFollowUpCreatedTextHTML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s CreateFollowUpCreatedTextHTML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*CreateFollowUpCreatedTextHTML) createFollowUpRes() {}
+
+type CreateFollowUpInternalServerError Error
+
+func (*CreateFollowUpInternalServerError) createFollowUpRes() {}
+
+// Ref: #/components/schemas/CreateFollowUpRequest
+type CreateFollowUpRequest struct {
+	ConversationID string                     `json:"conversation_id"`
+	Description    string                     `json:"description"`
+	Owner          CreateFollowUpRequestOwner `json:"owner"`
+	DueOn          OptNilDate                 `json:"due_on"`
+}
+
+// GetConversationID returns the value of ConversationID.
+func (s *CreateFollowUpRequest) GetConversationID() string {
+	return s.ConversationID
+}
+
+// GetDescription returns the value of Description.
+func (s *CreateFollowUpRequest) GetDescription() string {
+	return s.Description
+}
+
+// GetOwner returns the value of Owner.
+func (s *CreateFollowUpRequest) GetOwner() CreateFollowUpRequestOwner {
+	return s.Owner
+}
+
+// GetDueOn returns the value of DueOn.
+func (s *CreateFollowUpRequest) GetDueOn() OptNilDate {
+	return s.DueOn
+}
+
+// SetConversationID sets the value of ConversationID.
+func (s *CreateFollowUpRequest) SetConversationID(val string) {
+	s.ConversationID = val
+}
+
+// SetDescription sets the value of Description.
+func (s *CreateFollowUpRequest) SetDescription(val string) {
+	s.Description = val
+}
+
+// SetOwner sets the value of Owner.
+func (s *CreateFollowUpRequest) SetOwner(val CreateFollowUpRequestOwner) {
+	s.Owner = val
+}
+
+// SetDueOn sets the value of DueOn.
+func (s *CreateFollowUpRequest) SetDueOn(val OptNilDate) {
+	s.DueOn = val
+}
+
+type CreateFollowUpRequestOwner string
+
+const (
+	CreateFollowUpRequestOwnerManager CreateFollowUpRequestOwner = "manager"
+	CreateFollowUpRequestOwnerReport  CreateFollowUpRequestOwner = "report"
+)
+
+// AllValues returns all CreateFollowUpRequestOwner values.
+func (CreateFollowUpRequestOwner) AllValues() []CreateFollowUpRequestOwner {
+	return []CreateFollowUpRequestOwner{
+		CreateFollowUpRequestOwnerManager,
+		CreateFollowUpRequestOwnerReport,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CreateFollowUpRequestOwner) MarshalText() ([]byte, error) {
+	switch s {
+	case CreateFollowUpRequestOwnerManager:
+		return []byte(s), nil
+	case CreateFollowUpRequestOwnerReport:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CreateFollowUpRequestOwner) UnmarshalText(data []byte) error {
+	switch CreateFollowUpRequestOwner(data) {
+	case CreateFollowUpRequestOwnerManager:
+		*s = CreateFollowUpRequestOwnerManager
+		return nil
+	case CreateFollowUpRequestOwnerReport:
+		*s = CreateFollowUpRequestOwnerReport
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type CreatePersonBadRequest Error
+
+func (*CreatePersonBadRequest) createPersonRes() {}
+
+type CreatePersonCreatedTextHTML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s CreatePersonCreatedTextHTML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*CreatePersonCreatedTextHTML) createPersonRes() {}
+
+type CreatePersonInternalServerError Error
+
+func (*CreatePersonInternalServerError) createPersonRes() {}
+
+// Ref: #/components/schemas/CreatePersonRequest
+type CreatePersonRequest struct {
+	// Full name of the person.
+	Name string `json:"name"`
+	// ID of the person this person reports to; omit or null for a top-level report.
+	ReportsTo OptNilString `json:"reports_to"`
+	// How often, in days, this person should have a 1:1; omit or null for no cadence.
+	CadenceDays OptNilInt `json:"cadence_days"`
+}
+
+// GetName returns the value of Name.
+func (s *CreatePersonRequest) GetName() string {
+	return s.Name
+}
+
+// GetReportsTo returns the value of ReportsTo.
+func (s *CreatePersonRequest) GetReportsTo() OptNilString {
+	return s.ReportsTo
+}
+
+// GetCadenceDays returns the value of CadenceDays.
+func (s *CreatePersonRequest) GetCadenceDays() OptNilInt {
+	return s.CadenceDays
+}
+
+// SetName sets the value of Name.
+func (s *CreatePersonRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetReportsTo sets the value of ReportsTo.
+func (s *CreatePersonRequest) SetReportsTo(val OptNilString) {
+	s.ReportsTo = val
+}
+
+// SetCadenceDays sets the value of CadenceDays.
+func (s *CreatePersonRequest) SetCadenceDays(val OptNilInt) {
+	s.CadenceDays = val
+}
+
+type CreateThemeBadRequest Error
+
+func (*CreateThemeBadRequest) createThemeRes() {}
+
+type CreateThemeInternalServerError Error
+
+func (*CreateThemeInternalServerError) createThemeRes() {}
+
+// Ref: #/components/schemas/CreateThemeRequest
+type CreateThemeRequest struct {
+	// Omit to create a framework theme that can be used for any of the signed-in manager's reports.
+	PersonID    OptString `json:"person_id"`
+	Text        string    `json:"text"`
+	Description OptString `json:"description"`
+}
+
+// GetPersonID returns the value of PersonID.
+func (s *CreateThemeRequest) GetPersonID() OptString {
+	return s.PersonID
+}
+
+// GetText returns the value of Text.
+func (s *CreateThemeRequest) GetText() string {
+	return s.Text
+}
+
+// GetDescription returns the value of Description.
+func (s *CreateThemeRequest) GetDescription() OptString {
+	return s.Description
+}
+
+// SetPersonID sets the value of PersonID.
+func (s *CreateThemeRequest) SetPersonID(val OptString) {
+	s.PersonID = val
+}
+
+// SetText sets the value of Text.
+func (s *CreateThemeRequest) SetText(val string) {
+	s.Text = val
+}
+
+// SetDescription sets the value of Description.
+func (s *CreateThemeRequest) SetDescription(val OptString) {
+	s.Description = val
+}
+
+type DeleteActionInternalServerError Error
+
+func (*DeleteActionInternalServerError) deleteActionRes() {}
+
+// DeleteActionNoContent is response for DeleteAction operation.
+type DeleteActionNoContent struct{}
+
+func (*DeleteActionNoContent) deleteActionRes() {}
+
+type DeleteActionNotFound Error
+
+func (*DeleteActionNotFound) deleteActionRes() {}
+
+type DeleteConversationInternalServerError Error
+
+func (*DeleteConversationInternalServerError) deleteConversationRes() {}
+
+// DeleteConversationNoContent is response for DeleteConversation operation.
+type DeleteConversationNoContent struct{}
+
+func (*DeleteConversationNoContent) deleteConversationRes() {}
+
+type DeleteConversationNotFound Error
+
+func (*DeleteConversationNotFound) deleteConversationRes() {}
+
+type DeletePersonInternalServerError Error
+
+func (*DeletePersonInternalServerError) deletePersonRes() {}
+
+// DeletePersonNoContent is response for DeletePerson operation.
+type DeletePersonNoContent struct{}
+
+func (*DeletePersonNoContent) deletePersonRes() {}
+
+type DeletePersonNotFound Error
+
+func (*DeletePersonNotFound) deletePersonRes() {}
+
+type DeleteThemeInternalServerError Error
+
+func (*DeleteThemeInternalServerError) deleteThemeRes() {}
+
+// DeleteThemeNoContent is response for DeleteTheme operation.
+type DeleteThemeNoContent struct{}
+
+func (*DeleteThemeNoContent) deleteThemeRes() {}
+
+type DeleteThemeNotFound Error
+
+func (*DeleteThemeNotFound) deleteThemeRes() {}
+
+// Ref: #/components/schemas/Error
+type Error struct {
+	// Error message.
+	Message string `json:"message"`
+	// Error code.
+	Code string `json:"code"`
+}
+
+// GetMessage returns the value of Message.
+func (s *Error) GetMessage() string {
+	return s.Message
+}
+
+// GetCode returns the value of Code.
+func (s *Error) GetCode() string {
+	return s.Code
+}
+
+// SetMessage sets the value of Message.
+func (s *Error) SetMessage(val string) {
+	s.Message = val
+}
+
+// SetCode sets the value of Code.
+func (s *Error) SetCode(val string) {
+	s.Code = val
+}
+
+func (*Error) getConversationsRes() {}
+func (*Error) getFollowUpsRes()     {}
+func (*Error) getLevelsRes()        {}
+func (*Error) getOrgTreeRes()       {}
+func (*Error) getPersonsRes()       {}
+func (*Error) getThemesRes()        {}
+func (*Error) listAuditLogRes()     {}
+func (*Error) listTrashRes()        {}
+func (*Error) searchRes()           {}
+
+// Ref: #/components/schemas/FollowUp
+type FollowUp struct {
+	ID string `json:"id"`
+	// Conversation the follow-up came out of.
+	ConversationID string `json:"conversation_id"`
+	PersonID       string `json:"person_id"`
+	PersonName     string `json:"person_name"`
+	Description    string `json:"description"`
+	// Who committed to the follow-up, the manager or their report.
+	Owner       FollowUpOwner  `json:"owner"`
+	DueOn       OptNilDate     `json:"due_on"`
+	Status      FollowUpStatus `json:"status"`
+	CompletedAt OptNilDateTime `json:"completed_at"`
+	CreatedAt   time.Time      `json:"created_at"`
+	UpdatedAt   time.Time      `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *FollowUp) GetID() string {
+	return s.ID
+}
+
+// GetConversationID returns the value of ConversationID.
+func (s *FollowUp) GetConversationID() string {
+	return s.ConversationID
+}
+
+// GetPersonID returns the value of PersonID.
+func (s *FollowUp) GetPersonID() string {
+	return s.PersonID
+}
+
+// GetPersonName returns the value of PersonName.
+func (s *FollowUp) GetPersonName() string {
+	return s.PersonName
+}
+
+// GetDescription returns the value of Description.
+func (s *FollowUp) GetDescription() string {
+	return s.Description
+}
+
+// GetOwner returns the value of Owner.
+func (s *FollowUp) GetOwner() FollowUpOwner {
+	return s.Owner
+}
+
+// GetDueOn returns the value of DueOn.
+func (s *FollowUp) GetDueOn() OptNilDate {
+	return s.DueOn
+}
+
+// GetStatus returns the value of Status.
+func (s *FollowUp) GetStatus() FollowUpStatus {
+	return s.Status
+}
+
+// GetCompletedAt returns the value of CompletedAt.
+func (s *FollowUp) GetCompletedAt() OptNilDateTime {
+	return s.CompletedAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *FollowUp) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *FollowUp) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *FollowUp) SetID(val string) {
+	s.ID = val
+}
+
+// SetConversationID sets the value of ConversationID.
+func (s *FollowUp) SetConversationID(val string) {
+	s.ConversationID = val
+}
+
+// SetPersonID sets the value of PersonID.
+func (s *FollowUp) SetPersonID(val string) {
+	s.PersonID = val
+}
+
+// SetPersonName sets the value of PersonName.
+func (s *FollowUp) SetPersonName(val string) {
+	s.PersonName = val
+}
+
+// SetDescription sets the value of Description.
+func (s *FollowUp) SetDescription(val string) {
+	s.Description = val
+}
+
+// SetOwner sets the value of Owner.
+func (s *FollowUp) SetOwner(val FollowUpOwner) {
+	s.Owner = val
+}
+
+// SetDueOn sets the value of DueOn.
+func (s *FollowUp) SetDueOn(val OptNilDate) {
+	s.DueOn = val
+}
+
+// SetStatus sets the value of Status.
+func (s *FollowUp) SetStatus(val FollowUpStatus) {
+	s.Status = val
+}
+
+// SetCompletedAt sets the value of CompletedAt.
+func (s *FollowUp) SetCompletedAt(val OptNilDateTime) {
+	s.CompletedAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *FollowUp) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *FollowUp) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+func (*FollowUp) completeFollowUpRes() {}
+func (*FollowUp) createFollowUpRes()   {}
+
+// Who committed to the follow-up, the manager or their report.
+type FollowUpOwner string
+
+const (
+	FollowUpOwnerManager FollowUpOwner = "manager"
+	FollowUpOwnerReport  FollowUpOwner = "report"
+)
+
+// AllValues returns all FollowUpOwner values.
+func (FollowUpOwner) AllValues() []FollowUpOwner {
+	return []FollowUpOwner{
+		FollowUpOwnerManager,
+		FollowUpOwnerReport,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s FollowUpOwner) MarshalText() ([]byte, error) {
+	switch s {
+	case FollowUpOwnerManager:
+		return []byte(s), nil
+	case FollowUpOwnerReport:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *FollowUpOwner) UnmarshalText(data []byte) error {
+	switch FollowUpOwner(data) {
+	case FollowUpOwnerManager:
+		*s = FollowUpOwnerManager
+		return nil
+	case FollowUpOwnerReport:
+		*s = FollowUpOwnerReport
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type FollowUpStatus string
+
+const (
+	FollowUpStatusOpen FollowUpStatus = "open"
+	FollowUpStatusDone FollowUpStatus = "done"
+)
+
+// AllValues returns all FollowUpStatus values.
+func (FollowUpStatus) AllValues() []FollowUpStatus {
+	return []FollowUpStatus{
+		FollowUpStatusOpen,
+		FollowUpStatusDone,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s FollowUpStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case FollowUpStatusOpen:
+		return []byte(s), nil
+	case FollowUpStatusDone:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *FollowUpStatus) UnmarshalText(data []byte) error {
+	switch FollowUpStatus(data) {
+	case FollowUpStatusOpen:
+		*s = FollowUpStatusOpen
+		return nil
+	case FollowUpStatusDone:
+		*s = FollowUpStatusDone
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/GapExpectation
+type GapExpectation struct {
+	Expectation LevelExpectation `json:"expectation"`
+	// Positive actions on the expectation's competency.
+	Positive []Action `json:"positive"`
+	// Negative actions on the expectation's competency.
+	Negative []Action `json:"negative"`
+	// Neutral context on the expectation's competency.
+	Neutral []Action `json:"neutral"`
+	// False when the person has no actions on this competency yet.
+	HasEvidence bool `json:"has_evidence"`
+}
+
+// GetExpectation returns the value of Expectation.
+func (s *GapExpectation) GetExpectation() LevelExpectation {
+	return s.Expectation
+}
+
+// GetPositive returns the value of Positive.
+func (s *GapExpectation) GetPositive() []Action {
+	return s.Positive
+}
+
+// GetNegative returns the value of Negative.
+func (s *GapExpectation) GetNegative() []Action {
+	return s.Negative
+}
+
+// GetNeutral returns the value of Neutral.
+func (s *GapExpectation) GetNeutral() []Action {
+	return s.Neutral
+}
+
+// GetHasEvidence returns the value of HasEvidence.
+func (s *GapExpectation) GetHasEvidence() bool {
+	return s.HasEvidence
+}
+
+// SetExpectation sets the value of Expectation.
+func (s *GapExpectation) SetExpectation(val LevelExpectation) {
+	s.Expectation = val
+}
+
+// SetPositive sets the value of Positive.
+func (s *GapExpectation) SetPositive(val []Action) {
+	s.Positive = val
+}
+
+// SetNegative sets the value of Negative.
+func (s *GapExpectation) SetNegative(val []Action) {
+	s.Negative = val
+}
+
+// SetNeutral sets the value of Neutral.
+func (s *GapExpectation) SetNeutral(val []Action) {
+	s.Neutral = val
+}
+
+// SetHasEvidence sets the value of HasEvidence.
+func (s *GapExpectation) SetHasEvidence(val bool) {
+	s.HasEvidence = val
+}
+
+type GetActionByIdInternalServerError Error
+
+func (*GetActionByIdInternalServerError) getActionByIdRes() {}
+
+type GetActionByIdNotFound Error
+
+func (*GetActionByIdNotFound) getActionByIdRes() {}
+
+type GetActionByIdOKTextHTML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetActionByIdOKTextHTML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetActionByIdOKTextHTML) getActionByIdRes() {}
+
+type GetActionRevisionsInternalServerError Error
+
+func (*GetActionRevisionsInternalServerError) getActionRevisionsRes() {}
+
+type GetActionRevisionsNotFound Error
+
+func (*GetActionRevisionsNotFound) getActionRevisionsRes() {}
+
+type GetActionRevisionsOKApplicationJSON struct {
+	Revisions []ActionRevision `json:"revisions"`
+}
+
+// GetRevisions returns the value of Revisions.
+func (s *GetActionRevisionsOKApplicationJSON) GetRevisions() []ActionRevision {
+	return s.Revisions
+}
+
+// SetRevisions sets the value of Revisions.
+func (s *GetActionRevisionsOKApplicationJSON) SetRevisions(val []ActionRevision) {
+	s.Revisions = val
+}
+
+func (*GetActionRevisionsOKApplicationJSON) getActionRevisionsRes() {}
+
+type GetActionRevisionsOKTextHTML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetActionRevisionsOKTextHTML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetActionRevisionsOKTextHTML) getActionRevisionsRes() {}
+
+type GetActionsBadRequest Error
+
+func (*GetActionsBadRequest) getActionsRes() {}
+
+type GetActionsInternalServerError Error
+
+func (*GetActionsInternalServerError) getActionsRes() {}
+
+type GetActionsOKApplicationJSON struct {
+	Actions []Action `json:"actions"`
+	// Pass as cursor to fetch the next page; absent on the last page.
+	NextCursor OptString `json:"next_cursor"`
+	// Number of actions matching every filter.
+	Total int `json:"total"`
+}
+
+// GetActions returns the value of Actions.
+func (s *GetActionsOKApplicationJSON) GetActions() []Action {
+	return s.Actions
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *GetActionsOKApplicationJSON) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// GetTotal returns the value of Total.
+func (s *GetActionsOKApplicationJSON) GetTotal() int {
+	return s.Total
+}
+
+// SetActions sets the value of Actions.
+func (s *GetActionsOKApplicationJSON) SetActions(val []Action) {
+	s.Actions = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *GetActionsOKApplicationJSON) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+// SetTotal sets the value of Total.
+func (s *GetActionsOKApplicationJSON) SetTotal(val int) {
+	s.Total = val
+}
+
+func (*GetActionsOKApplicationJSON) getActionsRes() {}
+
+type GetActionsOKTextHTML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetActionsOKTextHTML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetActionsOKTextHTML) getActionsRes() {}
+
+type GetActionsSort string
+
+const (
+	GetActionsSortOccurredAtDesc GetActionsSort = "occurred_at_desc"
+	GetActionsSortOccurredAtAsc  GetActionsSort = "occurred_at_asc"
+	GetActionsSortCreatedAtDesc  GetActionsSort = "created_at_desc"
+	GetActionsSortCreatedAtAsc   GetActionsSort = "created_at_asc"
+)
+
+// AllValues returns all GetActionsSort values.
+func (GetActionsSort) AllValues() []GetActionsSort {
+	return []GetActionsSort{
+		GetActionsSortOccurredAtDesc,
+		GetActionsSortOccurredAtAsc,
+		GetActionsSortCreatedAtDesc,
+		GetActionsSortCreatedAtAsc,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GetActionsSort) MarshalText() ([]byte, error) {
+	switch s {
+	case GetActionsSortOccurredAtDesc:
+		return []byte(s), nil
+	case GetActionsSortOccurredAtAsc:
+		return []byte(s), nil
+	case GetActionsSortCreatedAtDesc:
+		return []byte(s), nil
+	case GetActionsSortCreatedAtAsc:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetActionsSort) UnmarshalText(data []byte) error {
+	switch GetActionsSort(data) {
+	case GetActionsSortOccurredAtDesc:
+		*s = GetActionsSortOccurredAtDesc
+		return nil
+	case GetActionsSortOccurredAtAsc:
+		*s = GetActionsSortOccurredAtAsc
+		return nil
+	case GetActionsSortCreatedAtDesc:
+		*s = GetActionsSortCreatedAtDesc
+		return nil
+	case GetActionsSortCreatedAtAsc:
+		*s = GetActionsSortCreatedAtAsc
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type GetActionsValence string
+
+const (
+	GetActionsValencePositive GetActionsValence = "positive"
+	GetActionsValenceNegative GetActionsValence = "negative"
+	GetActionsValenceNeutral  GetActionsValence = "neutral"
+)
+
+// AllValues returns all GetActionsValence values.
+func (GetActionsValence) AllValues() []GetActionsValence {
+	return []GetActionsValence{
+		GetActionsValencePositive,
+		GetActionsValenceNegative,
+		GetActionsValenceNeutral,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GetActionsValence) MarshalText() ([]byte, error) {
+	switch s {
+	case GetActionsValencePositive:
+		return []byte(s), nil
+	case GetActionsValenceNegative:
+		return []byte(s), nil
+	case GetActionsValenceNeutral:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetActionsValence) UnmarshalText(data []byte) error {
+	switch GetActionsValence(data) {
+	case GetActionsValencePositive:
+		*s = GetActionsValencePositive
+		return nil
+	case GetActionsValenceNegative:
+		*s = GetActionsValenceNegative
+		return nil
+	case GetActionsValenceNeutral:
+		*s = GetActionsValenceNeutral
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type GetConversationByIdInternalServerError Error
+
+func (*GetConversationByIdInternalServerError) getConversationByIdRes() {}
+
+type GetConversationByIdNotFound Error
+
+func (*GetConversationByIdNotFound) getConversationByIdRes() {}
+
+type GetConversationByIdOKTextHTML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetConversationByIdOKTextHTML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetConversationByIdOKTextHTML) getConversationByIdRes() {}
+
+type GetConversationRevisionsInternalServerError Error
+
+func (*GetConversationRevisionsInternalServerError) getConversationRevisionsRes() {}
+
+type GetConversationRevisionsNotFound Error
+
+func (*GetConversationRevisionsNotFound) getConversationRevisionsRes() {}
+
+type GetConversationRevisionsOKApplicationJSON struct {
+	Revisions []ConversationRevision `json:"revisions"`
+}
+
+// GetRevisions returns the value of Revisions.
+func (s *GetConversationRevisionsOKApplicationJSON) GetRevisions() []ConversationRevision {
+	return s.Revisions
+}
+
+// SetRevisions sets the value of Revisions.
+func (s *GetConversationRevisionsOKApplicationJSON) SetRevisions(val []ConversationRevision) {
+	s.Revisions = val
+}
+
+func (*GetConversationRevisionsOKApplicationJSON) getConversationRevisionsRes() {}
+
+type GetConversationRevisionsOKTextHTML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetConversationRevisionsOKTextHTML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetConversationRevisionsOKTextHTML) getConversationRevisionsRes() {}
+
+type GetConversationsOKApplicationJSON struct {
+	Conversations []Conversation `json:"conversations"`
+	// Total number of conversations.
+	Total int `json:"total"`
+}
+
+// GetConversations returns the value of Conversations.
+func (s *GetConversationsOKApplicationJSON) GetConversations() []Conversation {
+	return s.Conversations
+}
+
+// GetTotal returns the value of Total.
+func (s *GetConversationsOKApplicationJSON) GetTotal() int {
+	return s.Total
+}
+
+// SetConversations sets the value of Conversations.
+func (s *GetConversationsOKApplicationJSON) SetConversations(val []Conversation) {
+	s.Conversations = val
+}
+
+// SetTotal sets the value of Total.
+func (s *GetConversationsOKApplicationJSON) SetTotal(val int) {
+	s.Total = val
+}
+
+func (*GetConversationsOKApplicationJSON) getConversationsRes() {}
+
+type GetConversationsOKTextHTML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetConversationsOKTextHTML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetConversationsOKTextHTML) getConversationsRes() {}
+
+type GetFollowUpsOKApplicationJSON struct {
+	FollowUps []FollowUp `json:"follow_ups"`
+	// Total number of follow-ups.
+	Total int `json:"total"`
+}
+
+// GetFollowUps returns the value of FollowUps.
+func (s *GetFollowUpsOKApplicationJSON) GetFollowUps() []FollowUp {
+	return s.FollowUps
+}
+
+// GetTotal returns the value of Total.
+func (s *GetFollowUpsOKApplicationJSON) GetTotal() int {
+	return s.Total
+}
+
+// SetFollowUps sets the value of FollowUps.
+func (s *GetFollowUpsOKApplicationJSON) SetFollowUps(val []FollowUp) {
+	s.FollowUps = val
+}
+
+// SetTotal sets the value of Total.
+func (s *GetFollowUpsOKApplicationJSON) SetTotal(val int) {
+	s.Total = val
+}
+
+func (*GetFollowUpsOKApplicationJSON) getFollowUpsRes() {}
+
+type GetFollowUpsOKTextHTML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetFollowUpsOKTextHTML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetFollowUpsOKTextHTML) getFollowUpsRes() {}
+
+type GetFollowUpsStatus string
+
+const (
+	GetFollowUpsStatusOpen GetFollowUpsStatus = "open"
+	GetFollowUpsStatusDone GetFollowUpsStatus = "done"
+)
+
+// AllValues returns all GetFollowUpsStatus values.
+func (GetFollowUpsStatus) AllValues() []GetFollowUpsStatus {
+	return []GetFollowUpsStatus{
+		GetFollowUpsStatusOpen,
+		GetFollowUpsStatusDone,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GetFollowUpsStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case GetFollowUpsStatusOpen:
+		return []byte(s), nil
+	case GetFollowUpsStatusDone:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetFollowUpsStatus) UnmarshalText(data []byte) error {
+	switch GetFollowUpsStatus(data) {
+	case GetFollowUpsStatusOpen:
+		*s = GetFollowUpsStatusOpen
+		return nil
+	case GetFollowUpsStatusDone:
+		*s = GetFollowUpsStatusDone
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type GetLevelsOK struct {
+	Levels []LadderLevel `json:"levels"`
+}
+
+// GetLevels returns the value of Levels.
+func (s *GetLevelsOK) GetLevels() []LadderLevel {
+	return s.Levels
+}
+
+// SetLevels sets the value of Levels.
+func (s *GetLevelsOK) SetLevels(val []LadderLevel) {
+	s.Levels = val
+}
+
+func (*GetLevelsOK) getLevelsRes() {}
+
+type GetOrgTreeOKApplicationJSON struct {
+	Roots []OrgNode `json:"roots"`
+}
+
+// GetRoots returns the value of Roots.
+func (s *GetOrgTreeOKApplicationJSON) GetRoots() []OrgNode {
+	return s.Roots
+}
+
+// SetRoots sets the value of Roots.
+func (s *GetOrgTreeOKApplicationJSON) SetRoots(val []OrgNode) {
+	s.Roots = val
+}
+
+func (*GetOrgTreeOKApplicationJSON) getOrgTreeRes() {}
+
+type GetOrgTreeOKTextHTML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetOrgTreeOKTextHTML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetOrgTreeOKTextHTML) getOrgTreeRes() {}
+
+type GetPersonActionsBadRequest Error
+
+func (*GetPersonActionsBadRequest) getPersonActionsRes() {}
+
+type GetPersonActionsInternalServerError Error
+
+func (*GetPersonActionsInternalServerError) getPersonActionsRes() {}
+
+type GetPersonActionsNotFound Error
+
+func (*GetPersonActionsNotFound) getPersonActionsRes() {}
+
+type GetPersonActionsOKApplicationJSON struct {
+	Actions []Action `json:"actions"`
+	// Pass as cursor to fetch the next page; absent on the last page.
+	NextCursor OptString `json:"next_cursor"`
+	// Total number of actions.
+	Total int `json:"total"`
+}
+
+// GetActions returns the value of Actions.
+func (s *GetPersonActionsOKApplicationJSON) GetActions() []Action {
+	return s.Actions
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *GetPersonActionsOKApplicationJSON) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// GetTotal returns the value of Total.
+func (s *GetPersonActionsOKApplicationJSON) GetTotal() int {
+	return s.Total
+}
+
+// SetActions sets the value of Actions.
+func (s *GetPersonActionsOKApplicationJSON) SetActions(val []Action) {
+	s.Actions = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *GetPersonActionsOKApplicationJSON) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+// SetTotal sets the value of Total.
+func (s *GetPersonActionsOKApplicationJSON) SetTotal(val int) {
+	s.Total = val
+}
+
+func (*GetPersonActionsOKApplicationJSON) getPersonActionsRes() {}
+
+type GetPersonActionsOKTextHTML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetPersonActionsOKTextHTML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetPersonActionsOKTextHTML) getPersonActionsRes() {}
+
+type GetPersonActionsValence string
+
+const (
+	GetPersonActionsValencePositive GetPersonActionsValence = "positive"
+	GetPersonActionsValenceNegative GetPersonActionsValence = "negative"
+	GetPersonActionsValenceNeutral  GetPersonActionsValence = "neutral"
+)
+
+// AllValues returns all GetPersonActionsValence values.
+func (GetPersonActionsValence) AllValues() []GetPersonActionsValence {
+	return []GetPersonActionsValence{
+		GetPersonActionsValencePositive,
+		GetPersonActionsValenceNegative,
+		GetPersonActionsValenceNeutral,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GetPersonActionsValence) MarshalText() ([]byte, error) {
+	switch s {
+	case GetPersonActionsValencePositive:
+		return []byte(s), nil
+	case GetPersonActionsValenceNegative:
+		return []byte(s), nil
+	case GetPersonActionsValenceNeutral:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetPersonActionsValence) UnmarshalText(data []byte) error {
+	switch GetPersonActionsValence(data) {
+	case GetPersonActionsValencePositive:
+		*s = GetPersonActionsValencePositive
+		return nil
+	case GetPersonActionsValenceNegative:
+		*s = GetPersonActionsValenceNegative
+		return nil
+	case GetPersonActionsValenceNeutral:
+		*s = GetPersonActionsValenceNeutral
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type GetPersonAgendaInternalServerError Error
+
+func (*GetPersonAgendaInternalServerError) getPersonAgendaRes() {}
+
+type GetPersonAgendaNotFound Error
+
+func (*GetPersonAgendaNotFound) getPersonAgendaRes() {}
+
+type GetPersonAgendaOKTextHTML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetPersonAgendaOKTextHTML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetPersonAgendaOKTextHTML) getPersonAgendaRes() {}
+
+type GetPersonByIdInternalServerError Error
+
+func (*GetPersonByIdInternalServerError) getPersonByIdRes() {}
+
+type GetPersonByIdNotFound Error
+
+func (*GetPersonByIdNotFound) getPersonByIdRes() {}
+
+type GetPersonByIdOKTextHTML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetPersonByIdOKTextHTML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetPersonByIdOKTextHTML) getPersonByIdRes() {}
+
+type GetPersonLadderInternalServerError Error
+
+func (*GetPersonLadderInternalServerError) getPersonLadderRes() {}
+
+type GetPersonLadderNotFound Error
+
+func (*GetPersonLadderNotFound) getPersonLadderRes() {}
+
+type GetPersonLadderOKTextHTML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetPersonLadderOKTextHTML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetPersonLadderOKTextHTML) getPersonLadderRes() {}
+
+type GetPersonThemesInternalServerError Error
+
+func (*GetPersonThemesInternalServerError) getPersonThemesRes() {}
+
+type GetPersonThemesNotFound Error
+
+func (*GetPersonThemesNotFound) getPersonThemesRes() {}
+
+type GetPersonThemesOKApplicationJSON struct {
+	Themes []ThemeDetail `json:"themes"`
+	// Total number of themes.
+	Total int `json:"total"`
+}
+
+// GetThemes returns the value of Themes.
+func (s *GetPersonThemesOKApplicationJSON) GetThemes() []ThemeDetail {
+	return s.Themes
+}
+
+// GetTotal returns the value of Total.
+func (s *GetPersonThemesOKApplicationJSON) GetTotal() int {
+	return s.Total
+}
+
+// SetThemes sets the value of Themes.
+func (s *GetPersonThemesOKApplicationJSON) SetThemes(val []ThemeDetail) {
+	s.Themes = val
+}
+
+// SetTotal sets the value of Total.
+func (s *GetPersonThemesOKApplicationJSON) SetTotal(val int) {
+	s.Total = val
+}
+
+func (*GetPersonThemesOKApplicationJSON) getPersonThemesRes() {}
+
+type GetPersonThemesOKTextHTML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetPersonThemesOKTextHTML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetPersonThemesOKTextHTML) getPersonThemesRes() {}
+
+type GetPersonTimelineBadRequest Error
+
+func (*GetPersonTimelineBadRequest) getPersonTimelineRes() {}
+
+type GetPersonTimelineGroupBy string
+
+const (
+	GetPersonTimelineGroupByWeek    GetPersonTimelineGroupBy = "week"
+	GetPersonTimelineGroupByMonth   GetPersonTimelineGroupBy = "month"
+	GetPersonTimelineGroupByQuarter GetPersonTimelineGroupBy = "quarter"
+)
+
+// AllValues returns all GetPersonTimelineGroupBy values.
+func (GetPersonTimelineGroupBy) AllValues() []GetPersonTimelineGroupBy {
+	return []GetPersonTimelineGroupBy{
+		GetPersonTimelineGroupByWeek,
+		GetPersonTimelineGroupByMonth,
+		GetPersonTimelineGroupByQuarter,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GetPersonTimelineGroupBy) MarshalText() ([]byte, error) {
+	switch s {
+	case GetPersonTimelineGroupByWeek:
+		return []byte(s), nil
+	case GetPersonTimelineGroupByMonth:
+		return []byte(s), nil
+	case GetPersonTimelineGroupByQuarter:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetPersonTimelineGroupBy) UnmarshalText(data []byte) error {
+	switch GetPersonTimelineGroupBy(data) {
+	case GetPersonTimelineGroupByWeek:
+		*s = GetPersonTimelineGroupByWeek
+		return nil
+	case GetPersonTimelineGroupByMonth:
+		*s = GetPersonTimelineGroupByMonth
+		return nil
+	case GetPersonTimelineGroupByQuarter:
+		*s = GetPersonTimelineGroupByQuarter
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type GetPersonTimelineInternalServerError Error
+
+func (*GetPersonTimelineInternalServerError) getPersonTimelineRes() {}
+
+type GetPersonTimelineNotFound Error
+
+func (*GetPersonTimelineNotFound) getPersonTimelineRes() {}
+
+type GetPersonTimelineOKApplicationJSON struct {
+	Items []TimelineItem `json:"items"`
+	// Pass as cursor to fetch the next page; absent on the last page.
+	NextCursor OptString `json:"next_cursor"`
+	// Number of timeline items matching the filters.
+	Total int `json:"total"`
+	// Present when group_by is set, newest period first.
+	Groups []TimelineGroup `json:"groups"`
+}
+
+// GetItems returns the value of Items.
+func (s *GetPersonTimelineOKApplicationJSON) GetItems() []TimelineItem {
+	return s.Items
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *GetPersonTimelineOKApplicationJSON) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// GetTotal returns the value of Total.
+func (s *GetPersonTimelineOKApplicationJSON) GetTotal() int {
+	return s.Total
+}
+
+// GetGroups returns the value of Groups.
+func (s *GetPersonTimelineOKApplicationJSON) GetGroups() []TimelineGroup {
+	return s.Groups
+}
+
+// SetItems sets the value of Items.
+func (s *GetPersonTimelineOKApplicationJSON) SetItems(val []TimelineItem) {
+	s.Items = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *GetPersonTimelineOKApplicationJSON) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+// SetTotal sets the value of Total.
+func (s *GetPersonTimelineOKApplicationJSON) SetTotal(val int) {
+	s.Total = val
+}
+
+// SetGroups sets the value of Groups.
+func (s *GetPersonTimelineOKApplicationJSON) SetGroups(val []TimelineGroup) {
+	s.Groups = val
+}
+
+func (*GetPersonTimelineOKApplicationJSON) getPersonTimelineRes() {}
+
+type GetPersonTimelineOKTextHTML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetPersonTimelineOKTextHTML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetPersonTimelineOKTextHTML) getPersonTimelineRes() {}
+
+type GetPersonTimelineType string
+
+const (
+	GetPersonTimelineTypeAction       GetPersonTimelineType = "action"
+	GetPersonTimelineTypeConversation GetPersonTimelineType = "conversation"
+)
+
+// AllValues returns all GetPersonTimelineType values.
+func (GetPersonTimelineType) AllValues() []GetPersonTimelineType {
+	return []GetPersonTimelineType{
+		GetPersonTimelineTypeAction,
+		GetPersonTimelineTypeConversation,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GetPersonTimelineType) MarshalText() ([]byte, error) {
+	switch s {
+	case GetPersonTimelineTypeAction:
+		return []byte(s), nil
+	case GetPersonTimelineTypeConversation:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetPersonTimelineType) UnmarshalText(data []byte) error {
+	switch GetPersonTimelineType(data) {
+	case GetPersonTimelineTypeAction:
+		*s = GetPersonTimelineTypeAction
+		return nil
+	case GetPersonTimelineTypeConversation:
+		*s = GetPersonTimelineTypeConversation
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type GetPersonTimelineValence string
+
+const (
+	GetPersonTimelineValencePositive GetPersonTimelineValence = "positive"
+	GetPersonTimelineValenceNegative GetPersonTimelineValence = "negative"
+	GetPersonTimelineValenceNeutral  GetPersonTimelineValence = "neutral"
+)
+
+// AllValues returns all GetPersonTimelineValence values.
+func (GetPersonTimelineValence) AllValues() []GetPersonTimelineValence {
+	return []GetPersonTimelineValence{
+		GetPersonTimelineValencePositive,
+		GetPersonTimelineValenceNegative,
+		GetPersonTimelineValenceNeutral,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GetPersonTimelineValence) MarshalText() ([]byte, error) {
+	switch s {
+	case GetPersonTimelineValencePositive:
+		return []byte(s), nil
+	case GetPersonTimelineValenceNegative:
+		return []byte(s), nil
+	case GetPersonTimelineValenceNeutral:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetPersonTimelineValence) UnmarshalText(data []byte) error {
+	switch GetPersonTimelineValence(data) {
+	case GetPersonTimelineValencePositive:
+		*s = GetPersonTimelineValencePositive
+		return nil
+	case GetPersonTimelineValenceNegative:
+		*s = GetPersonTimelineValenceNegative
+		return nil
+	case GetPersonTimelineValenceNeutral:
+		*s = GetPersonTimelineValenceNeutral
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type GetPersonsOKApplicationJSON struct {
+	Persons []Person `json:"persons"`
+	// Total number of persons.
+	Total int `json:"total"`
+}
+
+// GetPersons returns the value of Persons.
+func (s *GetPersonsOKApplicationJSON) GetPersons() []Person {
+	return s.Persons
+}
+
+// GetTotal returns the value of Total.
+func (s *GetPersonsOKApplicationJSON) GetTotal() int {
+	return s.Total
+}
+
+// SetPersons sets the value of Persons.
+func (s *GetPersonsOKApplicationJSON) SetPersons(val []Person) {
+	s.Persons = val
+}
+
+// SetTotal sets the value of Total.
+func (s *GetPersonsOKApplicationJSON) SetTotal(val int) {
+	s.Total = val
+}
+
+func (*GetPersonsOKApplicationJSON) getPersonsRes() {}
+
+type GetPersonsOKTextHTML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetPersonsOKTextHTML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetPersonsOKTextHTML) getPersonsRes() {}
+
+type GetThemeByIdInternalServerError Error
+
+func (*GetThemeByIdInternalServerError) getThemeByIdRes() {}
+
+type GetThemeByIdNotFound Error
+
+func (*GetThemeByIdNotFound) getThemeByIdRes() {}
+
+type GetThemesOK struct {
+	Themes []ThemeDetail `json:"themes"`
+	// Total number of themes.
+	Total int `json:"total"`
+}
+
+// GetThemes returns the value of Themes.
+func (s *GetThemesOK) GetThemes() []ThemeDetail {
+	return s.Themes
+}
+
+// GetTotal returns the value of Total.
+func (s *GetThemesOK) GetTotal() int {
+	return s.Total
+}
+
+// SetThemes sets the value of Themes.
+func (s *GetThemesOK) SetThemes(val []ThemeDetail) {
+	s.Themes = val
+}
+
+// SetTotal sets the value of Total.
+func (s *GetThemesOK) SetTotal(val int) {
+	s.Total = val
+}
+
+func (*GetThemesOK) getThemesRes() {}
+
+// Ref: #/components/schemas/LadderGap
+type LadderGap struct {
+	PersonID     string           `json:"person_id"`
+	CurrentLevel OptLadderLevel   `json:"current_level"`
+	TargetLevel  OptLadderLevel   `json:"target_level"`
+	Expectations []GapExpectation `json:"expectations"`
+}
+
+// GetPersonID returns the value of PersonID.
+func (s *LadderGap) GetPersonID() string {
+	return s.PersonID
+}
+
+// GetCurrentLevel returns the value of CurrentLevel.
+func (s *LadderGap) GetCurrentLevel() OptLadderLevel {
+	return s.CurrentLevel
+}
+
+// GetTargetLevel returns the value of TargetLevel.
+func (s *LadderGap) GetTargetLevel() OptLadderLevel {
+	return s.TargetLevel
+}
+
+// GetExpectations returns the value of Expectations.
+func (s *LadderGap) GetExpectations() []GapExpectation {
+	return s.Expectations
+}
+
+// SetPersonID sets the value of PersonID.
+func (s *LadderGap) SetPersonID(val string) {
+	s.PersonID = val
+}
+
+// SetCurrentLevel sets the value of CurrentLevel.
+func (s *LadderGap) SetCurrentLevel(val OptLadderLevel) {
+	s.CurrentLevel = val
+}
+
+// SetTargetLevel sets the value of TargetLevel.
+func (s *LadderGap) SetTargetLevel(val OptLadderLevel) {
+	s.TargetLevel = val
+}
+
+// SetExpectations sets the value of Expectations.
+func (s *LadderGap) SetExpectations(val []GapExpectation) {
+	s.Expectations = val
+}
+
+func (*LadderGap) getPersonLadderRes() {}
+func (*LadderGap) setPersonLevelsRes() {}
+
+// Ref: #/components/schemas/LadderLevel
+type LadderLevel struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Position on the ladder; higher is more senior. Set by the level's order in the imported framework
+	// file.
+	Rank         int                `json:"rank"`
+	Description  NilString          `json:"description"`
+	Expectations []LevelExpectation `json:"expectations"`
+}
+
+// GetID returns the value of ID.
+func (s *LadderLevel) GetID() string {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *LadderLevel) GetName() string {
+	return s.Name
+}
+
+// GetRank returns the value of Rank.
+func (s *LadderLevel) GetRank() int {
+	return s.Rank
+}
+
+// GetDescription returns the value of Description.
+func (s *LadderLevel) GetDescription() NilString {
+	return s.Description
+}
+
+// GetExpectations returns the value of Expectations.
+func (s *LadderLevel) GetExpectations() []LevelExpectation {
+	return s.Expectations
+}
+
+// SetID sets the value of ID.
+func (s *LadderLevel) SetID(val string) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *LadderLevel) SetName(val string) {
+	s.Name = val
+}
+
+// SetRank sets the value of Rank.
+func (s *LadderLevel) SetRank(val int) {
+	s.Rank = val
+}
+
+// SetDescription sets the value of Description.
+func (s *LadderLevel) SetDescription(val NilString) {
+	s.Description = val
+}
+
+// SetExpectations sets the value of Expectations.
+func (s *LadderLevel) SetExpectations(val []LevelExpectation) {
+	s.Expectations = val
+}
+
+// Ref: #/components/schemas/LevelExpectation
+type LevelExpectation struct {
+	ID          string `json:"id"`
+	Theme       Theme  `json:"theme"`
+	Description string `json:"description"`
+}
+
+// GetID returns the value of ID.
+func (s *LevelExpectation) GetID() string {
+	return s.ID
+}
+
+// GetTheme returns the value of Theme.
+func (s *LevelExpectation) GetTheme() Theme {
+	return s.Theme
+}
+
+// GetDescription returns the value of Description.
+func (s *LevelExpectation) GetDescription() string {
+	return s.Description
+}
+
+// SetID sets the value of ID.
+func (s *LevelExpectation) SetID(val string) {
+	s.ID = val
+}
+
+// SetTheme sets the value of Theme.
+func (s *LevelExpectation) SetTheme(val Theme) {
+	s.Theme = val
+}
+
+// SetDescription sets the value of Description.
+func (s *LevelExpectation) SetDescription(val string) {
+	s.Description = val
+}
+
+type ListAuditLogFormat string
+
+const (
+	ListAuditLogFormatCsv ListAuditLogFormat = "csv"
+)
+
+// AllValues returns all ListAuditLogFormat values.
+func (ListAuditLogFormat) AllValues() []ListAuditLogFormat {
+	return []ListAuditLogFormat{
+		ListAuditLogFormatCsv,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ListAuditLogFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case ListAuditLogFormatCsv:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ListAuditLogFormat) UnmarshalText(data []byte) error {
+	switch ListAuditLogFormat(data) {
+	case ListAuditLogFormatCsv:
+		*s = ListAuditLogFormatCsv
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type ListAuditLogOKApplicationJSON struct {
+	Entries []AuditEntry `json:"entries"`
+	// Number of entries matching the filters.
+	Total int `json:"total"`
+}
+
+// GetEntries returns the value of Entries.
+func (s *ListAuditLogOKApplicationJSON) GetEntries() []AuditEntry {
+	return s.Entries
+}
+
+// GetTotal returns the value of Total.
+func (s *ListAuditLogOKApplicationJSON) GetTotal() int {
+	return s.Total
+}
+
+// SetEntries sets the value of Entries.
+func (s *ListAuditLogOKApplicationJSON) SetEntries(val []AuditEntry) {
+	s.Entries = val
+}
+
+// SetTotal sets the value of Total.
+func (s *ListAuditLogOKApplicationJSON) SetTotal(val int) {
+	s.Total = val
+}
+
+func (*ListAuditLogOKApplicationJSON) listAuditLogRes() {}
+
+type ListAuditLogOKTextCsv struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s ListAuditLogOKTextCsv) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*ListAuditLogOKTextCsv) listAuditLogRes() {}
+
+type ListAuditLogOKTextHTML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s ListAuditLogOKTextHTML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*ListAuditLogOKTextHTML) listAuditLogRes() {}
+
+type ListAuditLogVia string
+
+const (
+	ListAuditLogViaSession ListAuditLogVia = "session"
+	ListAuditLogViaToken   ListAuditLogVia = "token"
+	ListAuditLogViaMcp     ListAuditLogVia = "mcp"
+)
+
+// AllValues returns all ListAuditLogVia values.
+func (ListAuditLogVia) AllValues() []ListAuditLogVia {
+	return []ListAuditLogVia{
+		ListAuditLogViaSession,
+		ListAuditLogViaToken,
+		ListAuditLogViaMcp,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ListAuditLogVia) MarshalText() ([]byte, error) {
+	switch s {
+	case ListAuditLogViaSession:
+		return []byte(s), nil
+	case ListAuditLogViaToken:
+		return []byte(s), nil
+	case ListAuditLogViaMcp:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ListAuditLogVia) UnmarshalText(data []byte) error {
+	switch ListAuditLogVia(data) {
+	case ListAuditLogViaSession:
+		*s = ListAuditLogViaSession
+		return nil
+	case ListAuditLogViaToken:
+		*s = ListAuditLogViaToken
+		return nil
+	case ListAuditLogViaMcp:
+		*s = ListAuditLogViaMcp
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type ListTrashOKApplicationJSON struct {
+	Items []TrashItem `json:"items"`
+}
+
+// GetItems returns the value of Items.
+func (s *ListTrashOKApplicationJSON) GetItems() []TrashItem {
+	return s.Items
+}
+
+// SetItems sets the value of Items.
+func (s *ListTrashOKApplicationJSON) SetItems(val []TrashItem) {
+	s.Items = val
+}
+
+func (*ListTrashOKApplicationJSON) listTrashRes() {}
+
+type ListTrashOKTextHTML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s ListTrashOKTextHTML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*ListTrashOKTextHTML) listTrashRes() {}
+
+type MergeThemesBadRequest Error
+
+func (*MergeThemesBadRequest) mergeThemesRes() {}
+
+type MergeThemesInternalServerError Error
+
+func (*MergeThemesInternalServerError) mergeThemesRes() {}
+
+type MergeThemesNotFound Error
+
+func (*MergeThemesNotFound) mergeThemesRes() {}
+
+// Ref: #/components/schemas/MergeThemesRequest
+type MergeThemesRequest struct {
+	// Themes to fold into the target; they are deleted afterwards.
+	SourceIds []string `json:"source_ids"`
+}
+
+// GetSourceIds returns the value of SourceIds.
+func (s *MergeThemesRequest) GetSourceIds() []string {
+	return s.SourceIds
+}
+
+// SetSourceIds sets the value of SourceIds.
+func (s *MergeThemesRequest) SetSourceIds(val []string) {
+	s.SourceIds = val
+}
+
+// NewNilDateTime returns new NilDateTime with value set to v.
+func NewNilDateTime(v time.Time) NilDateTime {
+	return NilDateTime{
+		Value: v,
+	}
+}
+
+// NilDateTime is nullable time.Time.
+type NilDateTime struct {
+	Value time.Time
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilDateTime) SetTo(v time.Time) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilDateTime) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilDateTime) SetToNull() {
+	o.Null = true
+	var v time.Time
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilDateTime) Get() (v time.Time, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilString returns new NilString with value set to v.
+func NewNilString(v string) NilString {
+	return NilString{
+		Value: v,
+	}
+}
+
+// NilString is nullable string.
+type NilString struct {
+	Value string
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilString) SetTo(v string) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilString) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilString) SetToNull() {
+	o.Null = true
+	var v string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDate returns new OptDate with value set to v.
+func NewOptDate(v time.Time) OptDate {
+	return OptDate{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDate is optional time.Time.
+type OptDate struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDate was set.
+func (o OptDate) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDate) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDate) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDate) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDate) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetActionsSort returns new OptGetActionsSort with value set to v.
+func NewOptGetActionsSort(v GetActionsSort) OptGetActionsSort {
+	return OptGetActionsSort{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetActionsSort is optional GetActionsSort.
+type OptGetActionsSort struct {
+	Value GetActionsSort
+	Set   bool
+}
+
+// IsSet returns true if OptGetActionsSort was set.
+func (o OptGetActionsSort) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetActionsSort) Reset() {
+	var v GetActionsSort
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetActionsSort) SetTo(v GetActionsSort) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetActionsSort) Get() (v GetActionsSort, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetActionsSort) Or(d GetActionsSort) GetActionsSort {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetActionsValence returns new OptGetActionsValence with value set to v.
+func NewOptGetActionsValence(v GetActionsValence) OptGetActionsValence {
+	return OptGetActionsValence{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetActionsValence is optional GetActionsValence.
+type OptGetActionsValence struct {
+	Value GetActionsValence
+	Set   bool
+}
+
+// IsSet returns true if OptGetActionsValence was set.
+func (o OptGetActionsValence) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetActionsValence) Reset() {
+	var v GetActionsValence
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetActionsValence) SetTo(v GetActionsValence) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
 func (o OptGetActionsValence) Get() (v GetActionsValence, ok bool) {
 	if !o.Set {
 		return v, false
 	}
-	return o.Value, true
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetActionsValence) Or(d GetActionsValence) GetActionsValence {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetFollowUpsStatus returns new OptGetFollowUpsStatus with value set to v.
+func NewOptGetFollowUpsStatus(v GetFollowUpsStatus) OptGetFollowUpsStatus {
+	return OptGetFollowUpsStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetFollowUpsStatus is optional GetFollowUpsStatus.
+type OptGetFollowUpsStatus struct {
+	Value GetFollowUpsStatus
+	Set   bool
+}
+
+// IsSet returns true if OptGetFollowUpsStatus was set.
+func (o OptGetFollowUpsStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetFollowUpsStatus) Reset() {
+	var v GetFollowUpsStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetFollowUpsStatus) SetTo(v GetFollowUpsStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetFollowUpsStatus) Get() (v GetFollowUpsStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetFollowUpsStatus) Or(d GetFollowUpsStatus) GetFollowUpsStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetPersonActionsValence returns new OptGetPersonActionsValence with value set to v.
+func NewOptGetPersonActionsValence(v GetPersonActionsValence) OptGetPersonActionsValence {
+	return OptGetPersonActionsValence{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetPersonActionsValence is optional GetPersonActionsValence.
+type OptGetPersonActionsValence struct {
+	Value GetPersonActionsValence
+	Set   bool
+}
+
+// IsSet returns true if OptGetPersonActionsValence was set.
+func (o OptGetPersonActionsValence) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetPersonActionsValence) Reset() {
+	var v GetPersonActionsValence
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetPersonActionsValence) SetTo(v GetPersonActionsValence) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetPersonActionsValence) Get() (v GetPersonActionsValence, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetPersonActionsValence) Or(d GetPersonActionsValence) GetPersonActionsValence {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetPersonTimelineGroupBy returns new OptGetPersonTimelineGroupBy with value set to v.
+func NewOptGetPersonTimelineGroupBy(v GetPersonTimelineGroupBy) OptGetPersonTimelineGroupBy {
+	return OptGetPersonTimelineGroupBy{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetPersonTimelineGroupBy is optional GetPersonTimelineGroupBy.
+type OptGetPersonTimelineGroupBy struct {
+	Value GetPersonTimelineGroupBy
+	Set   bool
+}
+
+// IsSet returns true if OptGetPersonTimelineGroupBy was set.
+func (o OptGetPersonTimelineGroupBy) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetPersonTimelineGroupBy) Reset() {
+	var v GetPersonTimelineGroupBy
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetPersonTimelineGroupBy) SetTo(v GetPersonTimelineGroupBy) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetPersonTimelineGroupBy) Get() (v GetPersonTimelineGroupBy, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetPersonTimelineGroupBy) Or(d GetPersonTimelineGroupBy) GetPersonTimelineGroupBy {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetPersonTimelineType returns new OptGetPersonTimelineType with value set to v.
+func NewOptGetPersonTimelineType(v GetPersonTimelineType) OptGetPersonTimelineType {
+	return OptGetPersonTimelineType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetPersonTimelineType is optional GetPersonTimelineType.
+type OptGetPersonTimelineType struct {
+	Value GetPersonTimelineType
+	Set   bool
+}
+
+// IsSet returns true if OptGetPersonTimelineType was set.
+func (o OptGetPersonTimelineType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetPersonTimelineType) Reset() {
+	var v GetPersonTimelineType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetPersonTimelineType) SetTo(v GetPersonTimelineType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetPersonTimelineType) Get() (v GetPersonTimelineType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetPersonTimelineType) Or(d GetPersonTimelineType) GetPersonTimelineType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetPersonTimelineValence returns new OptGetPersonTimelineValence with value set to v.
+func NewOptGetPersonTimelineValence(v GetPersonTimelineValence) OptGetPersonTimelineValence {
+	return OptGetPersonTimelineValence{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetPersonTimelineValence is optional GetPersonTimelineValence.
+type OptGetPersonTimelineValence struct {
+	Value GetPersonTimelineValence
+	Set   bool
+}
+
+// IsSet returns true if OptGetPersonTimelineValence was set.
+func (o OptGetPersonTimelineValence) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetPersonTimelineValence) Reset() {
+	var v GetPersonTimelineValence
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetPersonTimelineValence) SetTo(v GetPersonTimelineValence) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetPersonTimelineValence) Get() (v GetPersonTimelineValence, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetPersonTimelineValence) Or(d GetPersonTimelineValence) GetPersonTimelineValence {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptLadderLevel returns new OptLadderLevel with value set to v.
+func NewOptLadderLevel(v LadderLevel) OptLadderLevel {
+	return OptLadderLevel{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptLadderLevel is optional LadderLevel.
+type OptLadderLevel struct {
+	Value LadderLevel
+	Set   bool
+}
+
+// IsSet returns true if OptLadderLevel was set.
+func (o OptLadderLevel) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptLadderLevel) Reset() {
+	var v LadderLevel
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptLadderLevel) SetTo(v LadderLevel) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptLadderLevel) Get() (v LadderLevel, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptLadderLevel) Or(d LadderLevel) LadderLevel {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptListAuditLogFormat returns new OptListAuditLogFormat with value set to v.
+func NewOptListAuditLogFormat(v ListAuditLogFormat) OptListAuditLogFormat {
+	return OptListAuditLogFormat{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptListAuditLogFormat is optional ListAuditLogFormat.
+type OptListAuditLogFormat struct {
+	Value ListAuditLogFormat
+	Set   bool
+}
+
+// IsSet returns true if OptListAuditLogFormat was set.
+func (o OptListAuditLogFormat) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptListAuditLogFormat) Reset() {
+	var v ListAuditLogFormat
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptListAuditLogFormat) SetTo(v ListAuditLogFormat) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptListAuditLogFormat) Get() (v ListAuditLogFormat, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptListAuditLogFormat) Or(d ListAuditLogFormat) ListAuditLogFormat {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptListAuditLogVia returns new OptListAuditLogVia with value set to v.
+func NewOptListAuditLogVia(v ListAuditLogVia) OptListAuditLogVia {
+	return OptListAuditLogVia{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptListAuditLogVia is optional ListAuditLogVia.
+type OptListAuditLogVia struct {
+	Value ListAuditLogVia
+	Set   bool
+}
+
+// IsSet returns true if OptListAuditLogVia was set.
+func (o OptListAuditLogVia) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptListAuditLogVia) Reset() {
+	var v ListAuditLogVia
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptListAuditLogVia) SetTo(v ListAuditLogVia) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptListAuditLogVia) Get() (v ListAuditLogVia, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptListAuditLogVia) Or(d ListAuditLogVia) ListAuditLogVia {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilActionImpact returns new OptNilActionImpact with value set to v.
+func NewOptNilActionImpact(v ActionImpact) OptNilActionImpact {
+	return OptNilActionImpact{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilActionImpact is optional nullable ActionImpact.
+type OptNilActionImpact struct {
+	Value ActionImpact
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilActionImpact was set.
+func (o OptNilActionImpact) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilActionImpact) Reset() {
+	var v ActionImpact
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilActionImpact) SetTo(v ActionImpact) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilActionImpact) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilActionImpact) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v ActionImpact
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilActionImpact) Get() (v ActionImpact, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilActionImpact) Or(d ActionImpact) ActionImpact {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilActionRevisionImpact returns new OptNilActionRevisionImpact with value set to v.
+func NewOptNilActionRevisionImpact(v ActionRevisionImpact) OptNilActionRevisionImpact {
+	return OptNilActionRevisionImpact{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilActionRevisionImpact is optional nullable ActionRevisionImpact.
+type OptNilActionRevisionImpact struct {
+	Value ActionRevisionImpact
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilActionRevisionImpact was set.
+func (o OptNilActionRevisionImpact) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilActionRevisionImpact) Reset() {
+	var v ActionRevisionImpact
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilActionRevisionImpact) SetTo(v ActionRevisionImpact) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilActionRevisionImpact) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilActionRevisionImpact) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v ActionRevisionImpact
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilActionRevisionImpact) Get() (v ActionRevisionImpact, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilActionRevisionImpact) Or(d ActionRevisionImpact) ActionRevisionImpact {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilCreateActionRequestImpact returns new OptNilCreateActionRequestImpact with value set to v.
+func NewOptNilCreateActionRequestImpact(v CreateActionRequestImpact) OptNilCreateActionRequestImpact {
+	return OptNilCreateActionRequestImpact{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilCreateActionRequestImpact is optional nullable CreateActionRequestImpact.
+type OptNilCreateActionRequestImpact struct {
+	Value CreateActionRequestImpact
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilCreateActionRequestImpact was set.
+func (o OptNilCreateActionRequestImpact) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilCreateActionRequestImpact) Reset() {
+	var v CreateActionRequestImpact
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilCreateActionRequestImpact) SetTo(v CreateActionRequestImpact) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilCreateActionRequestImpact) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilCreateActionRequestImpact) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v CreateActionRequestImpact
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilCreateActionRequestImpact) Get() (v CreateActionRequestImpact, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilCreateActionRequestImpact) Or(d CreateActionRequestImpact) CreateActionRequestImpact {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilDate returns new OptNilDate with value set to v.
+func NewOptNilDate(v time.Time) OptNilDate {
+	return OptNilDate{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilDate is optional nullable time.Time.
+type OptNilDate struct {
+	Value time.Time
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilDate was set.
+func (o OptNilDate) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilDate) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilDate) SetTo(v time.Time) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilDate) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilDate) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v time.Time
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilDate) Get() (v time.Time, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilDate) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilDateTime returns new OptNilDateTime with value set to v.
+func NewOptNilDateTime(v time.Time) OptNilDateTime {
+	return OptNilDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilDateTime is optional nullable time.Time.
+type OptNilDateTime struct {
+	Value time.Time
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilDateTime was set.
+func (o OptNilDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilDateTime) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilDateTime) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v time.Time
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilDateTime) Get() (v time.Time, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilInt returns new OptNilInt with value set to v.
+func NewOptNilInt(v int) OptNilInt {
+	return OptNilInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilInt is optional nullable int.
+type OptNilInt struct {
+	Value int
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilInt was set.
+func (o OptNilInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilInt) SetTo(v int) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilInt) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilInt) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v int
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilInt) Get() (v int, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilString returns new OptNilString with value set to v.
+func NewOptNilString(v string) OptNilString {
+	return OptNilString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilString is optional nullable string.
+type OptNilString struct {
+	Value string
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilString was set.
+func (o OptNilString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilString) SetTo(v string) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilString) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilString) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilTimelineItemValence returns new OptNilTimelineItemValence with value set to v.
+func NewOptNilTimelineItemValence(v TimelineItemValence) OptNilTimelineItemValence {
+	return OptNilTimelineItemValence{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilTimelineItemValence is optional nullable TimelineItemValence.
+type OptNilTimelineItemValence struct {
+	Value TimelineItemValence
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilTimelineItemValence was set.
+func (o OptNilTimelineItemValence) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilTimelineItemValence) Reset() {
+	var v TimelineItemValence
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilTimelineItemValence) SetTo(v TimelineItemValence) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilTimelineItemValence) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilTimelineItemValence) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v TimelineItemValence
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilTimelineItemValence) Get() (v TimelineItemValence, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilTimelineItemValence) Or(d TimelineItemValence) TimelineItemValence {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptNilUpdateActionRequestImpact returns new OptNilUpdateActionRequestImpact with value set to v.
+func NewOptNilUpdateActionRequestImpact(v UpdateActionRequestImpact) OptNilUpdateActionRequestImpact {
+	return OptNilUpdateActionRequestImpact{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptNilUpdateActionRequestImpact is optional nullable UpdateActionRequestImpact.
+type OptNilUpdateActionRequestImpact struct {
+	Value UpdateActionRequestImpact
+	Set   bool
+	Null  bool
+}
+
+// IsSet returns true if OptNilUpdateActionRequestImpact was set.
+func (o OptNilUpdateActionRequestImpact) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNilUpdateActionRequestImpact) Reset() {
+	var v UpdateActionRequestImpact
+	o.Value = v
+	o.Set = false
+	o.Null = false
+}
+
+// SetTo sets value to v.
+func (o *OptNilUpdateActionRequestImpact) SetTo(v UpdateActionRequestImpact) {
+	o.Set = true
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o OptNilUpdateActionRequestImpact) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *OptNilUpdateActionRequestImpact) SetToNull() {
+	o.Set = true
+	o.Null = true
+	var v UpdateActionRequestImpact
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNilUpdateActionRequestImpact) Get() (v UpdateActionRequestImpact, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptNilUpdateActionRequestImpact) Or(d UpdateActionRequestImpact) UpdateActionRequestImpact {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptReferenceInputKind returns new OptReferenceInputKind with value set to v.
+func NewOptReferenceInputKind(v ReferenceInputKind) OptReferenceInputKind {
+	return OptReferenceInputKind{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptReferenceInputKind is optional ReferenceInputKind.
+type OptReferenceInputKind struct {
+	Value ReferenceInputKind
+	Set   bool
+}
+
+// IsSet returns true if OptReferenceInputKind was set.
+func (o OptReferenceInputKind) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptReferenceInputKind) Reset() {
+	var v ReferenceInputKind
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptReferenceInputKind) SetTo(v ReferenceInputKind) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptReferenceInputKind) Get() (v ReferenceInputKind, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptReferenceInputKind) Or(d ReferenceInputKind) ReferenceInputKind {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptSearchType returns new OptSearchType with value set to v.
+func NewOptSearchType(v SearchType) OptSearchType {
+	return OptSearchType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptSearchType is optional SearchType.
+type OptSearchType struct {
+	Value SearchType
+	Set   bool
+}
+
+// IsSet returns true if OptSearchType was set.
+func (o OptSearchType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptSearchType) Reset() {
+	var v SearchType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptSearchType) SetTo(v SearchType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptSearchType) Get() (v SearchType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptSearchType) Or(d SearchType) SearchType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptTheme returns new OptTheme with value set to v.
+func NewOptTheme(v Theme) OptTheme {
+	return OptTheme{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptTheme is optional Theme.
+type OptTheme struct {
+	Value Theme
+	Set   bool
+}
+
+// IsSet returns true if OptTheme was set.
+func (o OptTheme) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptTheme) Reset() {
+	var v Theme
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptTheme) SetTo(v Theme) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptTheme) Get() (v Theme, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptTheme) Or(d Theme) Theme {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/OrgNode
+type OrgNode struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Direct reports of this person.
+	Reports []OrgNode `json:"reports"`
+}
+
+// GetID returns the value of ID.
+func (s *OrgNode) GetID() string {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *OrgNode) GetName() string {
+	return s.Name
+}
+
+// GetReports returns the value of Reports.
+func (s *OrgNode) GetReports() []OrgNode {
+	return s.Reports
+}
+
+// SetID sets the value of ID.
+func (s *OrgNode) SetID(val string) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *OrgNode) SetName(val string) {
+	s.Name = val
+}
+
+// SetReports sets the value of Reports.
+func (s *OrgNode) SetReports(val []OrgNode) {
+	s.Reports = val
+}
+
+// Ref: #/components/schemas/Person
+type Person struct {
+	// Unique identifier (xid).
+	ID string `json:"id"`
+	// Full name of the person.
+	Name string `json:"name"`
+	// ID of the person this person reports to.
+	ReportsTo OptNilString `json:"reports_to"`
+	// How often, in days, this person should have a 1:1.
+	CadenceDays OptNilInt `json:"cadence_days"`
+	// When the next 1:1 is due; null when no cadence is set.
+	NextDueAt OptNilDateTime `json:"next_due_at"`
+	// Whether the next 1:1 is past due.
+	Overdue bool `json:"overdue"`
+	// When the person was created.
+	CreatedAt time.Time `json:"created_at"`
+	// When the person was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Person) GetID() string {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *Person) GetName() string {
+	return s.Name
+}
+
+// GetReportsTo returns the value of ReportsTo.
+func (s *Person) GetReportsTo() OptNilString {
+	return s.ReportsTo
+}
+
+// GetCadenceDays returns the value of CadenceDays.
+func (s *Person) GetCadenceDays() OptNilInt {
+	return s.CadenceDays
+}
+
+// GetNextDueAt returns the value of NextDueAt.
+func (s *Person) GetNextDueAt() OptNilDateTime {
+	return s.NextDueAt
+}
+
+// GetOverdue returns the value of Overdue.
+func (s *Person) GetOverdue() bool {
+	return s.Overdue
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Person) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Person) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Person) SetID(val string) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *Person) SetName(val string) {
+	s.Name = val
+}
+
+// SetReportsTo sets the value of ReportsTo.
+func (s *Person) SetReportsTo(val OptNilString) {
+	s.ReportsTo = val
+}
+
+// SetCadenceDays sets the value of CadenceDays.
+func (s *Person) SetCadenceDays(val OptNilInt) {
+	s.CadenceDays = val
+}
+
+// SetNextDueAt sets the value of NextDueAt.
+func (s *Person) SetNextDueAt(val OptNilDateTime) {
+	s.NextDueAt = val
+}
+
+// SetOverdue sets the value of Overdue.
+func (s *Person) SetOverdue(val bool) {
+	s.Overdue = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Person) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Person) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+func (*Person) createPersonRes()  {}
+func (*Person) getPersonByIdRes() {}
+func (*Person) updatePersonRes()  {}
+
+type PurgeTrashItemInternalServerError Error
+
+func (*PurgeTrashItemInternalServerError) purgeTrashItemRes() {}
+
+// PurgeTrashItemNoContent is response for PurgeTrashItem operation.
+type PurgeTrashItemNoContent struct{}
+
+func (*PurgeTrashItemNoContent) purgeTrashItemRes() {}
+
+type PurgeTrashItemNotFound Error
+
+func (*PurgeTrashItemNotFound) purgeTrashItemRes() {}
+
+type PurgeTrashItemType string
+
+const (
+	PurgeTrashItemTypePerson       PurgeTrashItemType = "person"
+	PurgeTrashItemTypeAction       PurgeTrashItemType = "action"
+	PurgeTrashItemTypeConversation PurgeTrashItemType = "conversation"
+	PurgeTrashItemTypeTheme        PurgeTrashItemType = "theme"
+)
+
+// AllValues returns all PurgeTrashItemType values.
+func (PurgeTrashItemType) AllValues() []PurgeTrashItemType {
+	return []PurgeTrashItemType{
+		PurgeTrashItemTypePerson,
+		PurgeTrashItemTypeAction,
+		PurgeTrashItemTypeConversation,
+		PurgeTrashItemTypeTheme,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PurgeTrashItemType) MarshalText() ([]byte, error) {
+	switch s {
+	case PurgeTrashItemTypePerson:
+		return []byte(s), nil
+	case PurgeTrashItemTypeAction:
+		return []byte(s), nil
+	case PurgeTrashItemTypeConversation:
+		return []byte(s), nil
+	case PurgeTrashItemTypeTheme:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PurgeTrashItemType) UnmarshalText(data []byte) error {
+	switch PurgeTrashItemType(data) {
+	case PurgeTrashItemTypePerson:
+		*s = PurgeTrashItemTypePerson
+		return nil
+	case PurgeTrashItemTypeAction:
+		*s = PurgeTrashItemTypeAction
+		return nil
+	case PurgeTrashItemTypeConversation:
+		*s = PurgeTrashItemTypeConversation
+		return nil
+	case PurgeTrashItemTypeTheme:
+		*s = PurgeTrashItemTypeTheme
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/Reference
+type Reference struct {
+	Kind  ReferenceKind `json:"kind"`
+	Value string        `json:"value"`
+	Title OptNilString  `json:"title"`
+	// Where the reference links to, when it can be resolved.
+	URL OptNilString `json:"url"`
+}
+
+// GetKind returns the value of Kind.
+func (s *Reference) GetKind() ReferenceKind {
+	return s.Kind
+}
+
+// GetValue returns the value of Value.
+func (s *Reference) GetValue() string {
+	return s.Value
+}
+
+// GetTitle returns the value of Title.
+func (s *Reference) GetTitle() OptNilString {
+	return s.Title
+}
+
+// GetURL returns the value of URL.
+func (s *Reference) GetURL() OptNilString {
+	return s.URL
+}
+
+// SetKind sets the value of Kind.
+func (s *Reference) SetKind(val ReferenceKind) {
+	s.Kind = val
+}
+
+// SetValue sets the value of Value.
+func (s *Reference) SetValue(val string) {
+	s.Value = val
+}
+
+// SetTitle sets the value of Title.
+func (s *Reference) SetTitle(val OptNilString) {
+	s.Title = val
+}
+
+// SetURL sets the value of URL.
+func (s *Reference) SetURL(val OptNilString) {
+	s.URL = val
+}
+
+// Ref: #/components/schemas/ReferenceInput
+type ReferenceInput struct {
+	// Inferred from the value when omitted.
+	Kind  OptReferenceInputKind `json:"kind"`
+	Value string                `json:"value"`
+	Title OptNilString          `json:"title"`
+}
+
+// GetKind returns the value of Kind.
+func (s *ReferenceInput) GetKind() OptReferenceInputKind {
+	return s.Kind
+}
+
+// GetValue returns the value of Value.
+func (s *ReferenceInput) GetValue() string {
+	return s.Value
+}
+
+// GetTitle returns the value of Title.
+func (s *ReferenceInput) GetTitle() OptNilString {
+	return s.Title
+}
+
+// SetKind sets the value of Kind.
+func (s *ReferenceInput) SetKind(val OptReferenceInputKind) {
+	s.Kind = val
+}
+
+// SetValue sets the value of Value.
+func (s *ReferenceInput) SetValue(val string) {
+	s.Value = val
+}
+
+// SetTitle sets the value of Title.
+func (s *ReferenceInput) SetTitle(val OptNilString) {
+	s.Title = val
+}
+
+// Inferred from the value when omitted.
+type ReferenceInputKind string
+
+const (
+	ReferenceInputKindURL         ReferenceInputKind = "url"
+	ReferenceInputKindTicket      ReferenceInputKind = "ticket"
+	ReferenceInputKindPullRequest ReferenceInputKind = "pull_request"
+	ReferenceInputKindNote        ReferenceInputKind = "note"
+)
+
+// AllValues returns all ReferenceInputKind values.
+func (ReferenceInputKind) AllValues() []ReferenceInputKind {
+	return []ReferenceInputKind{
+		ReferenceInputKindURL,
+		ReferenceInputKindTicket,
+		ReferenceInputKindPullRequest,
+		ReferenceInputKindNote,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ReferenceInputKind) MarshalText() ([]byte, error) {
+	switch s {
+	case ReferenceInputKindURL:
+		return []byte(s), nil
+	case ReferenceInputKindTicket:
+		return []byte(s), nil
+	case ReferenceInputKindPullRequest:
+		return []byte(s), nil
+	case ReferenceInputKindNote:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ReferenceInputKind) UnmarshalText(data []byte) error {
+	switch ReferenceInputKind(data) {
+	case ReferenceInputKindURL:
+		*s = ReferenceInputKindURL
+		return nil
+	case ReferenceInputKindTicket:
+		*s = ReferenceInputKindTicket
+		return nil
+	case ReferenceInputKindPullRequest:
+		*s = ReferenceInputKindPullRequest
+		return nil
+	case ReferenceInputKindNote:
+		*s = ReferenceInputKindNote
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type ReferenceKind string
+
+const (
+	ReferenceKindURL         ReferenceKind = "url"
+	ReferenceKindTicket      ReferenceKind = "ticket"
+	ReferenceKindPullRequest ReferenceKind = "pull_request"
+	ReferenceKindNote        ReferenceKind = "note"
+)
+
+// AllValues returns all ReferenceKind values.
+func (ReferenceKind) AllValues() []ReferenceKind {
+	return []ReferenceKind{
+		ReferenceKindURL,
+		ReferenceKindTicket,
+		ReferenceKindPullRequest,
+		ReferenceKindNote,
+	}
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptGetActionsValence) Or(d GetActionsValence) GetActionsValence {
-	if v, ok := o.Get(); ok {
-		return v
+// MarshalText implements encoding.TextMarshaler.
+func (s ReferenceKind) MarshalText() ([]byte, error) {
+	switch s {
+	case ReferenceKindURL:
+		return []byte(s), nil
+	case ReferenceKindTicket:
+		return []byte(s), nil
+	case ReferenceKindPullRequest:
+		return []byte(s), nil
+	case ReferenceKindNote:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
 	}
-	return d
 }
 
-// NewOptGetPersonActionsValence returns new OptGetPersonActionsValence with value set to v.
-func NewOptGetPersonActionsValence(v GetPersonActionsValence) OptGetPersonActionsValence {
-	return OptGetPersonActionsValence{
-		Value: v,
-		Set:   true,
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ReferenceKind) UnmarshalText(data []byte) error {
+	switch ReferenceKind(data) {
+	case ReferenceKindURL:
+		*s = ReferenceKindURL
+		return nil
+	case ReferenceKindTicket:
+		*s = ReferenceKindTicket
+		return nil
+	case ReferenceKindPullRequest:
+		*s = ReferenceKindPullRequest
+		return nil
+	case ReferenceKindNote:
+		*s = ReferenceKindNote
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// OptGetPersonActionsValence is optional GetPersonActionsValence.
-type OptGetPersonActionsValence struct {
-	Value GetPersonActionsValence
-	Set   bool
+type RestoreActionRevisionInternalServerError Error
+
+func (*RestoreActionRevisionInternalServerError) restoreActionRevisionRes() {}
+
+type RestoreActionRevisionNotFound Error
+
+func (*RestoreActionRevisionNotFound) restoreActionRevisionRes() {}
+
+type RestoreConversationRevisionInternalServerError Error
+
+func (*RestoreConversationRevisionInternalServerError) restoreConversationRevisionRes() {}
+
+type RestoreConversationRevisionNotFound Error
+
+func (*RestoreConversationRevisionNotFound) restoreConversationRevisionRes() {}
+
+type RestoreTrashItemConflict Error
+
+func (*RestoreTrashItemConflict) restoreTrashItemRes() {}
+
+type RestoreTrashItemInternalServerError Error
+
+func (*RestoreTrashItemInternalServerError) restoreTrashItemRes() {}
+
+// RestoreTrashItemNoContent is response for RestoreTrashItem operation.
+type RestoreTrashItemNoContent struct{}
+
+func (*RestoreTrashItemNoContent) restoreTrashItemRes() {}
+
+type RestoreTrashItemNotFound Error
+
+func (*RestoreTrashItemNotFound) restoreTrashItemRes() {}
+
+type RestoreTrashItemType string
+
+const (
+	RestoreTrashItemTypePerson       RestoreTrashItemType = "person"
+	RestoreTrashItemTypeAction       RestoreTrashItemType = "action"
+	RestoreTrashItemTypeConversation RestoreTrashItemType = "conversation"
+	RestoreTrashItemTypeTheme        RestoreTrashItemType = "theme"
+)
+
+// AllValues returns all RestoreTrashItemType values.
+func (RestoreTrashItemType) AllValues() []RestoreTrashItemType {
+	return []RestoreTrashItemType{
+		RestoreTrashItemTypePerson,
+		RestoreTrashItemTypeAction,
+		RestoreTrashItemTypeConversation,
+		RestoreTrashItemTypeTheme,
+	}
 }
 
-// IsSet returns true if OptGetPersonActionsValence was set.
-func (o OptGetPersonActionsValence) IsSet() bool { return o.Set }
+// MarshalText implements encoding.TextMarshaler.
+func (s RestoreTrashItemType) MarshalText() ([]byte, error) {
+	switch s {
+	case RestoreTrashItemTypePerson:
+		return []byte(s), nil
+	case RestoreTrashItemTypeAction:
+		return []byte(s), nil
+	case RestoreTrashItemTypeConversation:
+		return []byte(s), nil
+	case RestoreTrashItemTypeTheme:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
 
-// Reset unsets value.
-func (o *OptGetPersonActionsValence) Reset() {
-	var v GetPersonActionsValence
-	o.Value = v
-	o.Set = false
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RestoreTrashItemType) UnmarshalText(data []byte) error {
+	switch RestoreTrashItemType(data) {
+	case RestoreTrashItemTypePerson:
+		*s = RestoreTrashItemTypePerson
+		return nil
+	case RestoreTrashItemTypeAction:
+		*s = RestoreTrashItemTypeAction
+		return nil
+	case RestoreTrashItemTypeConversation:
+		*s = RestoreTrashItemTypeConversation
+		return nil
+	case RestoreTrashItemTypeTheme:
+		*s = RestoreTrashItemTypeTheme
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// One field that differs from the previous revision. Single-valued fields give the old and new value;
+//
+//	references and themes list what was added and removed.
+//
+// Ref: #/components/schemas/RevisionChange
+type RevisionChange struct {
+	Field   RevisionChangeField `json:"field"`
+	From    OptNilString        `json:"from"`
+	To      OptNilString        `json:"to"`
+	Added   []string            `json:"added"`
+	Removed []string            `json:"removed"`
+}
+
+// GetField returns the value of Field.
+func (s *RevisionChange) GetField() RevisionChangeField {
+	return s.Field
+}
+
+// GetFrom returns the value of From.
+func (s *RevisionChange) GetFrom() OptNilString {
+	return s.From
+}
+
+// GetTo returns the value of To.
+func (s *RevisionChange) GetTo() OptNilString {
+	return s.To
+}
+
+// GetAdded returns the value of Added.
+func (s *RevisionChange) GetAdded() []string {
+	return s.Added
+}
+
+// GetRemoved returns the value of Removed.
+func (s *RevisionChange) GetRemoved() []string {
+	return s.Removed
+}
+
+// SetField sets the value of Field.
+func (s *RevisionChange) SetField(val RevisionChangeField) {
+	s.Field = val
+}
+
+// SetFrom sets the value of From.
+func (s *RevisionChange) SetFrom(val OptNilString) {
+	s.From = val
+}
+
+// SetTo sets the value of To.
+func (s *RevisionChange) SetTo(val OptNilString) {
+	s.To = val
+}
+
+// SetAdded sets the value of Added.
+func (s *RevisionChange) SetAdded(val []string) {
+	s.Added = val
+}
+
+// SetRemoved sets the value of Removed.
+func (s *RevisionChange) SetRemoved(val []string) {
+	s.Removed = val
+}
+
+type RevisionChangeField string
+
+const (
+	RevisionChangeFieldDescription RevisionChangeField = "description"
+	RevisionChangeFieldValence     RevisionChangeField = "valence"
+	RevisionChangeFieldImpact      RevisionChangeField = "impact"
+	RevisionChangeFieldOccurredAt  RevisionChangeField = "occurred_at"
+	RevisionChangeFieldReferences  RevisionChangeField = "references"
+	RevisionChangeFieldThemes      RevisionChangeField = "themes"
+)
+
+// AllValues returns all RevisionChangeField values.
+func (RevisionChangeField) AllValues() []RevisionChangeField {
+	return []RevisionChangeField{
+		RevisionChangeFieldDescription,
+		RevisionChangeFieldValence,
+		RevisionChangeFieldImpact,
+		RevisionChangeFieldOccurredAt,
+		RevisionChangeFieldReferences,
+		RevisionChangeFieldThemes,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RevisionChangeField) MarshalText() ([]byte, error) {
+	switch s {
+	case RevisionChangeFieldDescription:
+		return []byte(s), nil
+	case RevisionChangeFieldValence:
+		return []byte(s), nil
+	case RevisionChangeFieldImpact:
+		return []byte(s), nil
+	case RevisionChangeFieldOccurredAt:
+		return []byte(s), nil
+	case RevisionChangeFieldReferences:
+		return []byte(s), nil
+	case RevisionChangeFieldThemes:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RevisionChangeField) UnmarshalText(data []byte) error {
+	switch RevisionChangeField(data) {
+	case RevisionChangeFieldDescription:
+		*s = RevisionChangeFieldDescription
+		return nil
+	case RevisionChangeFieldValence:
+		*s = RevisionChangeFieldValence
+		return nil
+	case RevisionChangeFieldImpact:
+		*s = RevisionChangeFieldImpact
+		return nil
+	case RevisionChangeFieldOccurredAt:
+		*s = RevisionChangeFieldOccurredAt
+		return nil
+	case RevisionChangeFieldReferences:
+		*s = RevisionChangeFieldReferences
+		return nil
+	case RevisionChangeFieldThemes:
+		*s = RevisionChangeFieldThemes
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type SearchOKApplicationJSON struct {
+	Query   string         `json:"query"`
+	Results []SearchResult `json:"results"`
+}
+
+// GetQuery returns the value of Query.
+func (s *SearchOKApplicationJSON) GetQuery() string {
+	return s.Query
+}
+
+// GetResults returns the value of Results.
+func (s *SearchOKApplicationJSON) GetResults() []SearchResult {
+	return s.Results
+}
+
+// SetQuery sets the value of Query.
+func (s *SearchOKApplicationJSON) SetQuery(val string) {
+	s.Query = val
+}
+
+// SetResults sets the value of Results.
+func (s *SearchOKApplicationJSON) SetResults(val []SearchResult) {
+	s.Results = val
+}
+
+func (*SearchOKApplicationJSON) searchRes() {}
+
+type SearchOKTextHTML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s SearchOKTextHTML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*SearchOKTextHTML) searchRes() {}
+
+// Ref: #/components/schemas/SearchResult
+type SearchResult struct {
+	Type SearchResultType `json:"type"`
+	ID   string           `json:"id"`
+	// Empty for framework themes.
+	PersonID   string `json:"person_id"`
+	PersonName string `json:"person_name"`
+	// When the action or conversation happened, or when the theme was created.
+	OccurredAt time.Time `json:"occurred_at"`
+	Rank       float32   `json:"rank"`
+	// HTML-escaped excerpt with matching terms wrapped in <mark> tags.
+	Snippet string `json:"snippet"`
+}
+
+// GetType returns the value of Type.
+func (s *SearchResult) GetType() SearchResultType {
+	return s.Type
+}
+
+// GetID returns the value of ID.
+func (s *SearchResult) GetID() string {
+	return s.ID
+}
+
+// GetPersonID returns the value of PersonID.
+func (s *SearchResult) GetPersonID() string {
+	return s.PersonID
+}
+
+// GetPersonName returns the value of PersonName.
+func (s *SearchResult) GetPersonName() string {
+	return s.PersonName
+}
+
+// GetOccurredAt returns the value of OccurredAt.
+func (s *SearchResult) GetOccurredAt() time.Time {
+	return s.OccurredAt
+}
+
+// GetRank returns the value of Rank.
+func (s *SearchResult) GetRank() float32 {
+	return s.Rank
+}
+
+// GetSnippet returns the value of Snippet.
+func (s *SearchResult) GetSnippet() string {
+	return s.Snippet
+}
+
+// SetType sets the value of Type.
+func (s *SearchResult) SetType(val SearchResultType) {
+	s.Type = val
+}
+
+// SetID sets the value of ID.
+func (s *SearchResult) SetID(val string) {
+	s.ID = val
+}
+
+// SetPersonID sets the value of PersonID.
+func (s *SearchResult) SetPersonID(val string) {
+	s.PersonID = val
+}
+
+// SetPersonName sets the value of PersonName.
+func (s *SearchResult) SetPersonName(val string) {
+	s.PersonName = val
+}
+
+// SetOccurredAt sets the value of OccurredAt.
+func (s *SearchResult) SetOccurredAt(val time.Time) {
+	s.OccurredAt = val
+}
+
+// SetRank sets the value of Rank.
+func (s *SearchResult) SetRank(val float32) {
+	s.Rank = val
+}
+
+// SetSnippet sets the value of Snippet.
+func (s *SearchResult) SetSnippet(val string) {
+	s.Snippet = val
+}
+
+type SearchResultType string
+
+const (
+	SearchResultTypeAction       SearchResultType = "action"
+	SearchResultTypeConversation SearchResultType = "conversation"
+	SearchResultTypeTheme        SearchResultType = "theme"
+)
+
+// AllValues returns all SearchResultType values.
+func (SearchResultType) AllValues() []SearchResultType {
+	return []SearchResultType{
+		SearchResultTypeAction,
+		SearchResultTypeConversation,
+		SearchResultTypeTheme,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SearchResultType) MarshalText() ([]byte, error) {
+	switch s {
+	case SearchResultTypeAction:
+		return []byte(s), nil
+	case SearchResultTypeConversation:
+		return []byte(s), nil
+	case SearchResultTypeTheme:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SearchResultType) UnmarshalText(data []byte) error {
+	switch SearchResultType(data) {
+	case SearchResultTypeAction:
+		*s = SearchResultTypeAction
+		return nil
+	case SearchResultTypeConversation:
+		*s = SearchResultTypeConversation
+		return nil
+	case SearchResultTypeTheme:
+		*s = SearchResultTypeTheme
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type SearchType string
+
+const (
+	SearchTypeAction       SearchType = "action"
+	SearchTypeConversation SearchType = "conversation"
+	SearchTypeTheme        SearchType = "theme"
+)
+
+// AllValues returns all SearchType values.
+func (SearchType) AllValues() []SearchType {
+	return []SearchType{
+		SearchTypeAction,
+		SearchTypeConversation,
+		SearchTypeTheme,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s SearchType) MarshalText() ([]byte, error) {
+	switch s {
+	case SearchTypeAction:
+		return []byte(s), nil
+	case SearchTypeConversation:
+		return []byte(s), nil
+	case SearchTypeTheme:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *SearchType) UnmarshalText(data []byte) error {
+	switch SearchType(data) {
+	case SearchTypeAction:
+		*s = SearchTypeAction
+		return nil
+	case SearchTypeConversation:
+		*s = SearchTypeConversation
+		return nil
+	case SearchTypeTheme:
+		*s = SearchTypeTheme
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// SetTo sets value to v.
-func (o *OptGetPersonActionsValence) SetTo(v GetPersonActionsValence) {
-	o.Set = true
-	o.Value = v
+type SessionCookie struct {
+	APIKey string
+	Roles  []string
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptGetPersonActionsValence) Get() (v GetPersonActionsValence, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetAPIKey returns the value of APIKey.
+func (s *SessionCookie) GetAPIKey() string {
+	return s.APIKey
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptGetPersonActionsValence) Or(d GetPersonActionsValence) GetPersonActionsValence {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetRoles returns the value of Roles.
+func (s *SessionCookie) GetRoles() []string {
+	return s.Roles
 }
 
-// NewOptInt returns new OptInt with value set to v.
-func NewOptInt(v int) OptInt {
-	return OptInt{
-		Value: v,
-		Set:   true,
-	}
+// SetAPIKey sets the value of APIKey.
+func (s *SessionCookie) SetAPIKey(val string) {
+	s.APIKey = val
 }
 
-// OptInt is optional int.
-type OptInt struct {
-	Value int
-	Set   bool
+// SetRoles sets the value of Roles.
+func (s *SessionCookie) SetRoles(val []string) {
+	s.Roles = val
 }
 
-// IsSet returns true if OptInt was set.
-func (o OptInt) IsSet() bool { return o.Set }
+type SetPersonLevelsBadRequest Error
 
-// Reset unsets value.
-func (o *OptInt) Reset() {
-	var v int
-	o.Value = v
-	o.Set = false
+func (*SetPersonLevelsBadRequest) setPersonLevelsRes() {}
+
+type SetPersonLevelsInternalServerError Error
+
+func (*SetPersonLevelsInternalServerError) setPersonLevelsRes() {}
+
+type SetPersonLevelsNotFound Error
+
+func (*SetPersonLevelsNotFound) setPersonLevelsRes() {}
+
+// Ref: #/components/schemas/SetPersonLevelsRequest
+type SetPersonLevelsRequest struct {
+	CurrentLevelID OptNilString `json:"current_level_id"`
+	TargetLevelID  OptNilString `json:"target_level_id"`
 }
 
-// SetTo sets value to v.
-func (o *OptInt) SetTo(v int) {
-	o.Set = true
-	o.Value = v
+// GetCurrentLevelID returns the value of CurrentLevelID.
+func (s *SetPersonLevelsRequest) GetCurrentLevelID() OptNilString {
+	return s.CurrentLevelID
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptInt) Get() (v int, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetTargetLevelID returns the value of TargetLevelID.
+func (s *SetPersonLevelsRequest) GetTargetLevelID() OptNilString {
+	return s.TargetLevelID
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptInt) Or(d int) int {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetCurrentLevelID sets the value of CurrentLevelID.
+func (s *SetPersonLevelsRequest) SetCurrentLevelID(val OptNilString) {
+	s.CurrentLevelID = val
 }
 
-// NewOptNilString returns new OptNilString with value set to v.
-func NewOptNilString(v string) OptNilString {
-	return OptNilString{
-		Value: v,
-		Set:   true,
-	}
+// SetTargetLevelID sets the value of TargetLevelID.
+func (s *SetPersonLevelsRequest) SetTargetLevelID(val OptNilString) {
+	s.TargetLevelID = val
 }
 
-// OptNilString is optional nullable string.
-type OptNilString struct {
-	Value string
-	Set   bool
-	Null  bool
+type SplitThemeBadRequest Error
+
+func (*SplitThemeBadRequest) splitThemeRes() {}
+
+type SplitThemeInternalServerError Error
+
+func (*SplitThemeInternalServerError) splitThemeRes() {}
+
+type SplitThemeNotFound Error
+
+func (*SplitThemeNotFound) splitThemeRes() {}
+
+// Ref: #/components/schemas/SplitThemeRequest
+type SplitThemeRequest struct {
+	// Text of the new theme.
+	Text string `json:"text"`
+	// Actions to move from the theme being split onto the new theme.
+	ActionIds []string `json:"action_ids"`
 }
 
-// IsSet returns true if OptNilString was set.
-func (o OptNilString) IsSet() bool { return o.Set }
+// GetText returns the value of Text.
+func (s *SplitThemeRequest) GetText() string {
+	return s.Text
+}
 
-// Reset unsets value.
-func (o *OptNilString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// GetActionIds returns the value of ActionIds.
+func (s *SplitThemeRequest) GetActionIds() []string {
+	return s.ActionIds
 }
 
-// SetTo sets value to v.
-func (o *OptNilString) SetTo(v string) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// SetText sets the value of Text.
+func (s *SplitThemeRequest) SetText(val string) {
+	s.Text = val
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilString) IsNull() bool { return o.Null }
+// SetActionIds sets the value of ActionIds.
+func (s *SplitThemeRequest) SetActionIds(val []string) {
+	s.ActionIds = val
+}
 
-// SetToNull sets value to null.
-func (o *OptNilString) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v string
-	o.Value = v
+// Ref: #/components/schemas/Theme
+type Theme struct {
+	ID   string `json:"id"`
+	Text string `json:"text"`
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilString) Get() (v string, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetID returns the value of ID.
+func (s *Theme) GetID() string {
+	return s.ID
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetText returns the value of Text.
+func (s *Theme) GetText() string {
+	return s.Text
 }
 
-// NewOptNilTimelineItemValence returns new OptNilTimelineItemValence with value set to v.
-func NewOptNilTimelineItemValence(v TimelineItemValence) OptNilTimelineItemValence {
-	return OptNilTimelineItemValence{
-		Value: v,
-		Set:   true,
-	}
+// SetID sets the value of ID.
+func (s *Theme) SetID(val string) {
+	s.ID = val
 }
 
-// OptNilTimelineItemValence is optional nullable TimelineItemValence.
-type OptNilTimelineItemValence struct {
-	Value TimelineItemValence
-	Set   bool
-	Null  bool
+// SetText sets the value of Text.
+func (s *Theme) SetText(val string) {
+	s.Text = val
 }
 
-// IsSet returns true if OptNilTimelineItemValence was set.
-func (o OptNilTimelineItemValence) IsSet() bool { return o.Set }
+// Ref: #/components/schemas/ThemeDetail
+type ThemeDetail struct {
+	ID string `json:"id"`
+	// Null for framework themes, which are shared across every report of the manager who owns them. Each
+	// manager has their own framework; there is no organization-wide one.
+	PersonID NilString `json:"person_id"`
+	// Whether the theme belongs to the manager's competency framework rather than one person.
+	Framework   bool        `json:"framework"`
+	Text        string      `json:"text"`
+	Description NilString   `json:"description"`
+	Archived    bool        `json:"archived"`
+	ArchivedAt  NilDateTime `json:"archived_at"`
+	// Number of actions tagged with this theme.
+	ActionCount int `json:"action_count"`
+	// Number of conversations tagged with this theme.
+	ConversationCount int       `json:"conversation_count"`
+	CreatedAt         time.Time `json:"created_at"`
+	UpdatedAt         time.Time `json:"updated_at"`
+}
 
-// Reset unsets value.
-func (o *OptNilTimelineItemValence) Reset() {
-	var v TimelineItemValence
-	o.Value = v
-	o.Set = false
-	o.Null = false
+// GetID returns the value of ID.
+func (s *ThemeDetail) GetID() string {
+	return s.ID
 }
 
-// SetTo sets value to v.
-func (o *OptNilTimelineItemValence) SetTo(v TimelineItemValence) {
-	o.Set = true
-	o.Null = false
-	o.Value = v
+// GetPersonID returns the value of PersonID.
+func (s *ThemeDetail) GetPersonID() NilString {
+	return s.PersonID
 }
 
-// IsNull returns true if value is Null.
-func (o OptNilTimelineItemValence) IsNull() bool { return o.Null }
+// GetFramework returns the value of Framework.
+func (s *ThemeDetail) GetFramework() bool {
+	return s.Framework
+}
 
-// SetToNull sets value to null.
-func (o *OptNilTimelineItemValence) SetToNull() {
-	o.Set = true
-	o.Null = true
-	var v TimelineItemValence
-	o.Value = v
+// GetText returns the value of Text.
+func (s *ThemeDetail) GetText() string {
+	return s.Text
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptNilTimelineItemValence) Get() (v TimelineItemValence, ok bool) {
-	if o.Null {
-		return v, false
-	}
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetDescription returns the value of Description.
+func (s *ThemeDetail) GetDescription() NilString {
+	return s.Description
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptNilTimelineItemValence) Or(d TimelineItemValence) TimelineItemValence {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetArchived returns the value of Archived.
+func (s *ThemeDetail) GetArchived() bool {
+	return s.Archived
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
-		Value: v,
-		Set:   true,
-	}
+// GetArchivedAt returns the value of ArchivedAt.
+func (s *ThemeDetail) GetArchivedAt() NilDateTime {
+	return s.ArchivedAt
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
-	Set   bool
+// GetActionCount returns the value of ActionCount.
+func (s *ThemeDetail) GetActionCount() int {
+	return s.ActionCount
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// GetConversationCount returns the value of ConversationCount.
+func (s *ThemeDetail) GetConversationCount() int {
+	return s.ConversationCount
+}
 
-// Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
+// GetCreatedAt returns the value of CreatedAt.
+func (s *ThemeDetail) GetCreatedAt() time.Time {
+	return s.CreatedAt
 }
 
-// SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
-	o.Set = true
-	o.Value = v
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *ThemeDetail) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetID sets the value of ID.
+func (s *ThemeDetail) SetID(val string) {
+	s.ID = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetPersonID sets the value of PersonID.
+func (s *ThemeDetail) SetPersonID(val NilString) {
+	s.PersonID = val
 }
 
-// Ref: #/components/schemas/Person
-type Person struct {
-	// Unique identifier (xid).
-	ID string `json:"id"`
-	// Full name of the person.
-	Name string `json:"name"`
-	// When the person was created.
-	CreatedAt time.Time `json:"created_at"`
-	// When the person was last updated.
-	UpdatedAt time.Time `json:"updated_at"`
+// SetFramework sets the value of Framework.
+func (s *ThemeDetail) SetFramework(val bool) {
+	s.Framework = val
 }
 
-// GetID returns the value of ID.
-func (s *Person) GetID() string {
-	return s.ID
+// SetText sets the value of Text.
+func (s *ThemeDetail) SetText(val string) {
+	s.Text = val
 }
 
-// GetName returns the value of Name.
-func (s *Person) GetName() string {
-	return s.Name
+// SetDescription sets the value of Description.
+func (s *ThemeDetail) SetDescription(val NilString) {
+	s.Description = val
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *Person) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// SetArchived sets the value of Archived.
+func (s *ThemeDetail) SetArchived(val bool) {
+	s.Archived = val
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *Person) GetUpdatedAt() time.Time {
-	return s.UpdatedAt
+// SetArchivedAt sets the value of ArchivedAt.
+func (s *ThemeDetail) SetArchivedAt(val NilDateTime) {
+	s.ArchivedAt = val
 }
 
-// SetID sets the value of ID.
-func (s *Person) SetID(val string) {
-	s.ID = val
+// SetActionCount sets the value of ActionCount.
+func (s *ThemeDetail) SetActionCount(val int) {
+	s.ActionCount = val
 }
 
-// SetName sets the value of Name.
-func (s *Person) SetName(val string) {
-	s.Name = val
+// SetConversationCount sets the value of ConversationCount.
+func (s *ThemeDetail) SetConversationCount(val int) {
+	s.ConversationCount = val
 }
 
 // SetCreatedAt sets the value of CreatedAt.
-func (s *Person) SetCreatedAt(val time.Time) {
+func (s *ThemeDetail) SetCreatedAt(val time.Time) {
 	s.CreatedAt = val
 }
 
 // SetUpdatedAt sets the value of UpdatedAt.
-func (s *Person) SetUpdatedAt(val time.Time) {
+func (s *ThemeDetail) SetUpdatedAt(val time.Time) {
 	s.UpdatedAt = val
 }
 
-func (*Person) createPersonRes()  {}
-func (*Person) getPersonByIdRes() {}
-func (*Person) updatePersonRes()  {}
+func (*ThemeDetail) createThemeRes()  {}
+func (*ThemeDetail) getThemeByIdRes() {}
+func (*ThemeDetail) mergeThemesRes()  {}
+func (*ThemeDetail) splitThemeRes()   {}
+func (*ThemeDetail) updateThemeRes()  {}
 
-// Ref: #/components/schemas/Theme
-type Theme struct {
-	ID   string `json:"id"`
-	Text string `json:"text"`
+// Ref: #/components/schemas/TimelineGroup
+type TimelineGroup struct {
+	// First day of the period.
+	PeriodStart time.Time `json:"period_start"`
+	Label       string    `json:"label"`
+	Total       int       `json:"total"`
+	// Positive actions in the period.
+	Positive int `json:"positive"`
+	// Negative actions in the period.
+	Negative int `json:"negative"`
 }
 
-// GetID returns the value of ID.
-func (s *Theme) GetID() string {
-	return s.ID
+// GetPeriodStart returns the value of PeriodStart.
+func (s *TimelineGroup) GetPeriodStart() time.Time {
+	return s.PeriodStart
 }
 
-// GetText returns the value of Text.
-func (s *Theme) GetText() string {
-	return s.Text
+// GetLabel returns the value of Label.
+func (s *TimelineGroup) GetLabel() string {
+	return s.Label
 }
 
-// SetID sets the value of ID.
-func (s *Theme) SetID(val string) {
-	s.ID = val
+// GetTotal returns the value of Total.
+func (s *TimelineGroup) GetTotal() int {
+	return s.Total
 }
 
-// SetText sets the value of Text.
-func (s *Theme) SetText(val string) {
-	s.Text = val
+// GetPositive returns the value of Positive.
+func (s *TimelineGroup) GetPositive() int {
+	return s.Positive
+}
+
+// GetNegative returns the value of Negative.
+func (s *TimelineGroup) GetNegative() int {
+	return s.Negative
+}
+
+// SetPeriodStart sets the value of PeriodStart.
+func (s *TimelineGroup) SetPeriodStart(val time.Time) {
+	s.PeriodStart = val
+}
+
+// SetLabel sets the value of Label.
+func (s *TimelineGroup) SetLabel(val string) {
+	s.Label = val
+}
+
+// SetTotal sets the value of Total.
+func (s *TimelineGroup) SetTotal(val int) {
+	s.Total = val
+}
+
+// SetPositive sets the value of Positive.
+func (s *TimelineGroup) SetPositive(val int) {
+	s.Positive = val
+}
+
+// SetNegative sets the value of Negative.
+func (s *TimelineGroup) SetNegative(val int) {
+	s.Negative = val
 }
 
 // Ref: #/components/schemas/TimelineItem
 type TimelineItem struct {
-	Type        TimelineItemType          `json:"type"`
-	ID          string                    `json:"id"`
-	PersonID    string                    `json:"person_id"`
-	OccurredAt  time.Time                 `json:"occurred_at"`
-	Description string                    `json:"description"`
-	Themes      []Theme                   `json:"themes"`
-	References  OptNilString              `json:"references"`
-	Valence     OptNilTimelineItemValence `json:"valence"`
-	CreatedAt   time.Time                 `json:"created_at"`
-	UpdatedAt   time.Time                 `json:"updated_at"`
+	Type        TimelineItemType `json:"type"`
+	ID          string           `json:"id"`
+	PersonID    string           `json:"person_id"`
+	OccurredAt  time.Time        `json:"occurred_at"`
+	Description string           `json:"description"`
+	Themes      []Theme          `json:"themes"`
+	// Actions linked to a conversation item.
+	Actions    []Action                  `json:"actions"`
+	References []Reference               `json:"references"`
+	Valence    OptNilTimelineItemValence `json:"valence"`
+	CreatedAt  time.Time                 `json:"created_at"`
+	UpdatedAt  time.Time                 `json:"updated_at"`
 }
 
 // GetType returns the value of Type.
@@ -1337,8 +5909,13 @@ func (s *TimelineItem) GetThemes() []Theme {
 	return s.Themes
 }
 
+// GetActions returns the value of Actions.
+func (s *TimelineItem) GetActions() []Action {
+	return s.Actions
+}
+
 // GetReferences returns the value of References.
-func (s *TimelineItem) GetReferences() OptNilString {
+func (s *TimelineItem) GetReferences() []Reference {
 	return s.References
 }
 
@@ -1387,8 +5964,13 @@ func (s *TimelineItem) SetThemes(val []Theme) {
 	s.Themes = val
 }
 
+// SetActions sets the value of Actions.
+func (s *TimelineItem) SetActions(val []Action) {
+	s.Actions = val
+}
+
 // SetReferences sets the value of References.
-func (s *TimelineItem) SetReferences(val OptNilString) {
+func (s *TimelineItem) SetReferences(val []Reference) {
 	s.References = val
 }
 
@@ -1453,6 +6035,7 @@ type TimelineItemValence string
 const (
 	TimelineItemValencePositive TimelineItemValence = "positive"
 	TimelineItemValenceNegative TimelineItemValence = "negative"
+	TimelineItemValenceNeutral  TimelineItemValence = "neutral"
 )
 
 // AllValues returns all TimelineItemValence values.
@@ -1460,6 +6043,7 @@ func (TimelineItemValence) AllValues() []TimelineItemValence {
 	return []TimelineItemValence{
 		TimelineItemValencePositive,
 		TimelineItemValenceNegative,
+		TimelineItemValenceNeutral,
 	}
 }
 
@@ -1470,6 +6054,8 @@ func (s TimelineItemValence) MarshalText() ([]byte, error) {
 		return []byte(s), nil
 	case TimelineItemValenceNegative:
 		return []byte(s), nil
+	case TimelineItemValenceNeutral:
+		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
 	}
@@ -1484,6 +6070,125 @@ func (s *TimelineItemValence) UnmarshalText(data []byte) error {
 	case TimelineItemValenceNegative:
 		*s = TimelineItemValenceNegative
 		return nil
+	case TimelineItemValenceNeutral:
+		*s = TimelineItemValenceNeutral
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/TrashItem
+type TrashItem struct {
+	Type TrashItemType `json:"type"`
+	ID   string        `json:"id"`
+	// The person's name, the description, or the theme text.
+	Label string `json:"label"`
+	// Who the item was about; empty for people and framework themes.
+	PersonName string    `json:"person_name"`
+	DeletedAt  time.Time `json:"deleted_at"`
+}
+
+// GetType returns the value of Type.
+func (s *TrashItem) GetType() TrashItemType {
+	return s.Type
+}
+
+// GetID returns the value of ID.
+func (s *TrashItem) GetID() string {
+	return s.ID
+}
+
+// GetLabel returns the value of Label.
+func (s *TrashItem) GetLabel() string {
+	return s.Label
+}
+
+// GetPersonName returns the value of PersonName.
+func (s *TrashItem) GetPersonName() string {
+	return s.PersonName
+}
+
+// GetDeletedAt returns the value of DeletedAt.
+func (s *TrashItem) GetDeletedAt() time.Time {
+	return s.DeletedAt
+}
+
+// SetType sets the value of Type.
+func (s *TrashItem) SetType(val TrashItemType) {
+	s.Type = val
+}
+
+// SetID sets the value of ID.
+func (s *TrashItem) SetID(val string) {
+	s.ID = val
+}
+
+// SetLabel sets the value of Label.
+func (s *TrashItem) SetLabel(val string) {
+	s.Label = val
+}
+
+// SetPersonName sets the value of PersonName.
+func (s *TrashItem) SetPersonName(val string) {
+	s.PersonName = val
+}
+
+// SetDeletedAt sets the value of DeletedAt.
+func (s *TrashItem) SetDeletedAt(val time.Time) {
+	s.DeletedAt = val
+}
+
+type TrashItemType string
+
+const (
+	TrashItemTypePerson       TrashItemType = "person"
+	TrashItemTypeAction       TrashItemType = "action"
+	TrashItemTypeConversation TrashItemType = "conversation"
+	TrashItemTypeTheme        TrashItemType = "theme"
+)
+
+// AllValues returns all TrashItemType values.
+func (TrashItemType) AllValues() []TrashItemType {
+	return []TrashItemType{
+		TrashItemTypePerson,
+		TrashItemTypeAction,
+		TrashItemTypeConversation,
+		TrashItemTypeTheme,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TrashItemType) MarshalText() ([]byte, error) {
+	switch s {
+	case TrashItemTypePerson:
+		return []byte(s), nil
+	case TrashItemTypeAction:
+		return []byte(s), nil
+	case TrashItemTypeConversation:
+		return []byte(s), nil
+	case TrashItemTypeTheme:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TrashItemType) UnmarshalText(data []byte) error {
+	switch TrashItemType(data) {
+	case TrashItemTypePerson:
+		*s = TrashItemTypePerson
+		return nil
+	case TrashItemTypeAction:
+		*s = TrashItemTypeAction
+		return nil
+	case TrashItemTypeConversation:
+		*s = TrashItemTypeConversation
+		return nil
+	case TrashItemTypeTheme:
+		*s = TrashItemTypeTheme
+		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
@@ -1525,12 +6230,16 @@ type UpdateActionRequest struct {
 	OccurredAt time.Time `json:"occurred_at"`
 	// Description of the action.
 	Description string `json:"description"`
-	// Optional references or links related to the action.
-	References OptNilString `json:"references"`
-	// Whether the action was positive or negative.
+	// References to store with the action; on update these replace the existing ones.
+	References []ReferenceInput `json:"references"`
+	// Whether the action was positive, negative or neutral context.
 	Valence UpdateActionRequestValence `json:"valence"`
-	// IDs of themes associated with the action.
+	// How much the action mattered, when recorded.
+	Impact OptNilUpdateActionRequestImpact `json:"impact"`
+	// IDs of every theme on the action; themes left out are removed.
 	Themes []string `json:"themes"`
+	// IDs of every conversation the action was discussed in; conversations left out are unlinked.
+	Conversations []string `json:"conversations"`
 }
 
 // GetPersonID returns the value of PersonID.
@@ -1549,7 +6258,7 @@ func (s *UpdateActionRequest) GetDescription() string {
 }
 
 // GetReferences returns the value of References.
-func (s *UpdateActionRequest) GetReferences() OptNilString {
+func (s *UpdateActionRequest) GetReferences() []ReferenceInput {
 	return s.References
 }
 
@@ -1558,11 +6267,21 @@ func (s *UpdateActionRequest) GetValence() UpdateActionRequestValence {
 	return s.Valence
 }
 
+// GetImpact returns the value of Impact.
+func (s *UpdateActionRequest) GetImpact() OptNilUpdateActionRequestImpact {
+	return s.Impact
+}
+
 // GetThemes returns the value of Themes.
 func (s *UpdateActionRequest) GetThemes() []string {
 	return s.Themes
 }
 
+// GetConversations returns the value of Conversations.
+func (s *UpdateActionRequest) GetConversations() []string {
+	return s.Conversations
+}
+
 // SetPersonID sets the value of PersonID.
 func (s *UpdateActionRequest) SetPersonID(val string) {
 	s.PersonID = val
@@ -1579,7 +6298,7 @@ func (s *UpdateActionRequest) SetDescription(val string) {
 }
 
 // SetReferences sets the value of References.
-func (s *UpdateActionRequest) SetReferences(val OptNilString) {
+func (s *UpdateActionRequest) SetReferences(val []ReferenceInput) {
 	s.References = val
 }
 
@@ -1588,17 +6307,77 @@ func (s *UpdateActionRequest) SetValence(val UpdateActionRequestValence) {
 	s.Valence = val
 }
 
+// SetImpact sets the value of Impact.
+func (s *UpdateActionRequest) SetImpact(val OptNilUpdateActionRequestImpact) {
+	s.Impact = val
+}
+
 // SetThemes sets the value of Themes.
 func (s *UpdateActionRequest) SetThemes(val []string) {
 	s.Themes = val
 }
 
-// Whether the action was positive or negative.
+// SetConversations sets the value of Conversations.
+func (s *UpdateActionRequest) SetConversations(val []string) {
+	s.Conversations = val
+}
+
+// How much the action mattered, when recorded.
+type UpdateActionRequestImpact string
+
+const (
+	UpdateActionRequestImpactLow    UpdateActionRequestImpact = "low"
+	UpdateActionRequestImpactMedium UpdateActionRequestImpact = "medium"
+	UpdateActionRequestImpactHigh   UpdateActionRequestImpact = "high"
+)
+
+// AllValues returns all UpdateActionRequestImpact values.
+func (UpdateActionRequestImpact) AllValues() []UpdateActionRequestImpact {
+	return []UpdateActionRequestImpact{
+		UpdateActionRequestImpactLow,
+		UpdateActionRequestImpactMedium,
+		UpdateActionRequestImpactHigh,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s UpdateActionRequestImpact) MarshalText() ([]byte, error) {
+	switch s {
+	case UpdateActionRequestImpactLow:
+		return []byte(s), nil
+	case UpdateActionRequestImpactMedium:
+		return []byte(s), nil
+	case UpdateActionRequestImpactHigh:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *UpdateActionRequestImpact) UnmarshalText(data []byte) error {
+	switch UpdateActionRequestImpact(data) {
+	case UpdateActionRequestImpactLow:
+		*s = UpdateActionRequestImpactLow
+		return nil
+	case UpdateActionRequestImpactMedium:
+		*s = UpdateActionRequestImpactMedium
+		return nil
+	case UpdateActionRequestImpactHigh:
+		*s = UpdateActionRequestImpactHigh
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Whether the action was positive, negative or neutral context.
 type UpdateActionRequestValence string
 
 const (
 	UpdateActionRequestValencePositive UpdateActionRequestValence = "positive"
 	UpdateActionRequestValenceNegative UpdateActionRequestValence = "negative"
+	UpdateActionRequestValenceNeutral  UpdateActionRequestValence = "neutral"
 )
 
 // AllValues returns all UpdateActionRequestValence values.
@@ -1606,6 +6385,7 @@ func (UpdateActionRequestValence) AllValues() []UpdateActionRequestValence {
 	return []UpdateActionRequestValence{
 		UpdateActionRequestValencePositive,
 		UpdateActionRequestValenceNegative,
+		UpdateActionRequestValenceNeutral,
 	}
 }
 
@@ -1616,6 +6396,8 @@ func (s UpdateActionRequestValence) MarshalText() ([]byte, error) {
 		return []byte(s), nil
 	case UpdateActionRequestValenceNegative:
 		return []byte(s), nil
+	case UpdateActionRequestValenceNeutral:
+		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
 	}
@@ -1630,11 +6412,106 @@ func (s *UpdateActionRequestValence) UnmarshalText(data []byte) error {
 	case UpdateActionRequestValenceNegative:
 		*s = UpdateActionRequestValenceNegative
 		return nil
+	case UpdateActionRequestValenceNeutral:
+		*s = UpdateActionRequestValenceNeutral
+		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
+type UpdateConversationBadRequest Error
+
+func (*UpdateConversationBadRequest) updateConversationRes() {}
+
+type UpdateConversationInternalServerError Error
+
+func (*UpdateConversationInternalServerError) updateConversationRes() {}
+
+type UpdateConversationNotFound Error
+
+func (*UpdateConversationNotFound) updateConversationRes() {}
+
+type UpdateConversationOKTextHTML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s UpdateConversationOKTextHTML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*UpdateConversationOKTextHTML) updateConversationRes() {}
+
+// Ref: #/components/schemas/UpdateConversationRequest
+type UpdateConversationRequest struct {
+	// ID of the person this conversation relates to.
+	PersonID string `json:"person_id"`
+	// When the conversation occurred.
+	OccurredAt time.Time `json:"occurred_at"`
+	// Description of the conversation.
+	Description string `json:"description"`
+	// IDs of actions associated with the conversation; replaces the existing set.
+	Actions []string `json:"actions"`
+	// IDs of themes associated with the conversation; replaces the existing set.
+	Themes []string `json:"themes"`
+}
+
+// GetPersonID returns the value of PersonID.
+func (s *UpdateConversationRequest) GetPersonID() string {
+	return s.PersonID
+}
+
+// GetOccurredAt returns the value of OccurredAt.
+func (s *UpdateConversationRequest) GetOccurredAt() time.Time {
+	return s.OccurredAt
+}
+
+// GetDescription returns the value of Description.
+func (s *UpdateConversationRequest) GetDescription() string {
+	return s.Description
+}
+
+// GetActions returns the value of Actions.
+func (s *UpdateConversationRequest) GetActions() []string {
+	return s.Actions
+}
+
+// GetThemes returns the value of Themes.
+func (s *UpdateConversationRequest) GetThemes() []string {
+	return s.Themes
+}
+
+// SetPersonID sets the value of PersonID.
+func (s *UpdateConversationRequest) SetPersonID(val string) {
+	s.PersonID = val
+}
+
+// SetOccurredAt sets the value of OccurredAt.
+func (s *UpdateConversationRequest) SetOccurredAt(val time.Time) {
+	s.OccurredAt = val
+}
+
+// SetDescription sets the value of Description.
+func (s *UpdateConversationRequest) SetDescription(val string) {
+	s.Description = val
+}
+
+// SetActions sets the value of Actions.
+func (s *UpdateConversationRequest) SetActions(val []string) {
+	s.Actions = val
+}
+
+// SetThemes sets the value of Themes.
+func (s *UpdateConversationRequest) SetThemes(val []string) {
+	s.Themes = val
+}
+
 type UpdatePersonBadRequest Error
 
 func (*UpdatePersonBadRequest) updatePersonRes() {}
@@ -1667,6 +6544,10 @@ func (*UpdatePersonOKTextHTML) updatePersonRes() {}
 type UpdatePersonRequest struct {
 	// Full name of the person.
 	Name string `json:"name"`
+	// ID of the person this person reports to; omit or null for a top-level report.
+	ReportsTo OptNilString `json:"reports_to"`
+	// How often, in days, this person should have a 1:1; omit or null for no cadence.
+	CadenceDays OptNilInt `json:"cadence_days"`
 }
 
 // GetName returns the value of Name.
@@ -1674,7 +6555,66 @@ func (s *UpdatePersonRequest) GetName() string {
 	return s.Name
 }
 
+// GetReportsTo returns the value of ReportsTo.
+func (s *UpdatePersonRequest) GetReportsTo() OptNilString {
+	return s.ReportsTo
+}
+
+// GetCadenceDays returns the value of CadenceDays.
+func (s *UpdatePersonRequest) GetCadenceDays() OptNilInt {
+	return s.CadenceDays
+}
+
 // SetName sets the value of Name.
 func (s *UpdatePersonRequest) SetName(val string) {
 	s.Name = val
 }
+
+// SetReportsTo sets the value of ReportsTo.
+func (s *UpdatePersonRequest) SetReportsTo(val OptNilString) {
+	s.ReportsTo = val
+}
+
+// SetCadenceDays sets the value of CadenceDays.
+func (s *UpdatePersonRequest) SetCadenceDays(val OptNilInt) {
+	s.CadenceDays = val
+}
+
+type UpdateThemeBadRequest Error
+
+func (*UpdateThemeBadRequest) updateThemeRes() {}
+
+type UpdateThemeInternalServerError Error
+
+func (*UpdateThemeInternalServerError) updateThemeRes() {}
+
+type UpdateThemeNotFound Error
+
+func (*UpdateThemeNotFound) updateThemeRes() {}
+
+// Ref: #/components/schemas/UpdateThemeRequest
+type UpdateThemeRequest struct {
+	Text string `json:"text"`
+	// Archived themes are hidden from pickers but stay on existing actions and conversations.
+	Archived bool `json:"archived"`
+}
+
+// GetText returns the value of Text.
+func (s *UpdateThemeRequest) GetText() string {
+	return s.Text
+}
+
+// GetArchived returns the value of Archived.
+func (s *UpdateThemeRequest) GetArchived() bool {
+	return s.Archived
+}
+
+// SetText sets the value of Text.
+func (s *UpdateThemeRequest) SetText(val string) {
+	s.Text = val
+}
+
+// SetArchived sets the value of Archived.
+func (s *UpdateThemeRequest) SetArchived(val bool) {
+	s.Archived = val
+}
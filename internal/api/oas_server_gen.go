@@ -8,6 +8,12 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// CompleteFollowUp implements completeFollowUp operation.
+	//
+	// Mark a follow-up item as done.
+	//
+	// POST /follow-ups/{id}/complete
+	CompleteFollowUp(ctx context.Context, params CompleteFollowUpParams) (CompleteFollowUpRes, error)
 	// CreateAction implements createAction operation.
 	//
 	// Create a new action.
@@ -20,51 +26,145 @@ type Handler interface {
 	//
 	// POST /conversations
 	CreateConversation(ctx context.Context, req *CreateConversationRequest) (CreateConversationRes, error)
+	// CreateFollowUp implements createFollowUp operation.
+	//
+	// Create a follow-up item for a conversation.
+	//
+	// POST /follow-ups
+	CreateFollowUp(ctx context.Context, req *CreateFollowUpRequest) (CreateFollowUpRes, error)
 	// CreatePerson implements createPerson operation.
 	//
 	// Create a new person.
 	//
 	// POST /people
 	CreatePerson(ctx context.Context, req *CreatePersonRequest) (CreatePersonRes, error)
+	// CreateTheme implements createTheme operation.
+	//
+	// Create a theme for a person.
+	//
+	// POST /themes
+	CreateTheme(ctx context.Context, req *CreateThemeRequest) (CreateThemeRes, error)
 	// DeleteAction implements deleteAction operation.
 	//
-	// Delete an action.
+	// Moves the action to the trash.
 	//
 	// DELETE /actions/{id}
 	DeleteAction(ctx context.Context, params DeleteActionParams) (DeleteActionRes, error)
+	// DeleteConversation implements deleteConversation operation.
+	//
+	// Moves the conversation to the trash.
+	//
+	// DELETE /conversations/{id}
+	DeleteConversation(ctx context.Context, params DeleteConversationParams) (DeleteConversationRes, error)
 	// DeletePerson implements deletePerson operation.
 	//
-	// Delete a person.
+	// Moves the person to the trash together with their actions, conversations and themes.
 	//
 	// DELETE /people/{id}
 	DeletePerson(ctx context.Context, params DeletePersonParams) (DeletePersonRes, error)
+	// DeleteTheme implements deleteTheme operation.
+	//
+	// Moves the theme to the trash. It stops showing on the actions and conversations that used it until
+	// it is restored.
+	//
+	// DELETE /themes/{id}
+	DeleteTheme(ctx context.Context, params DeleteThemeParams) (DeleteThemeRes, error)
 	// GetActionById implements getActionById operation.
 	//
 	// Get an action by ID.
 	//
 	// GET /actions/{id}
 	GetActionById(ctx context.Context, params GetActionByIdParams) (GetActionByIdRes, error)
+	// GetActionRevisions implements getActionRevisions operation.
+	//
+	// Every saved version of the action, newest first. Each revision lists the fields that changed from
+	// the one before it; the first has no changes.
+	//
+	// GET /actions/{id}/revisions
+	GetActionRevisions(ctx context.Context, params GetActionRevisionsParams) (GetActionRevisionsRes, error)
 	// GetActions implements getActions operation.
 	//
-	// Get all actions.
+	// Filters combine; the total counts every action matching all of them.
 	//
 	// GET /actions
 	GetActions(ctx context.Context, params GetActionsParams) (GetActionsRes, error)
+	// GetConversationById implements getConversationById operation.
+	//
+	// Get a conversation by ID.
+	//
+	// GET /conversations/{id}
+	GetConversationById(ctx context.Context, params GetConversationByIdParams) (GetConversationByIdRes, error)
+	// GetConversationRevisions implements getConversationRevisions operation.
+	//
+	// Every saved version of the conversation, newest first. Each revision lists the fields that changed
+	// from the one before it; the first has no changes.
+	//
+	// GET /conversations/{id}/revisions
+	GetConversationRevisions(ctx context.Context, params GetConversationRevisionsParams) (GetConversationRevisionsRes, error)
+	// GetConversations implements getConversations operation.
+	//
+	// Get all conversations.
+	//
+	// GET /conversations
+	GetConversations(ctx context.Context, params GetConversationsParams) (GetConversationsRes, error)
+	// GetFollowUps implements getFollowUps operation.
+	//
+	// List follow-up items across all reports.
+	//
+	// GET /follow-ups
+	GetFollowUps(ctx context.Context, params GetFollowUpsParams) (GetFollowUpsRes, error)
+	// GetLevels implements getLevels operation.
+	//
+	// Levels from most junior to most senior, each with what it expects per framework competency. Levels
+	// and expectations are read-only here; the only way to define or change them is to import a
+	// framework file, at /framework or with cmd/importframework.
+	//
+	// GET /levels
+	GetLevels(ctx context.Context) (GetLevelsRes, error)
+	// GetOrgTree implements getOrgTree operation.
+	//
+	// Every person arranged under the person they report to. People who report to nobody are roots.
+	//
+	// GET /org
+	GetOrgTree(ctx context.Context) (GetOrgTreeRes, error)
 	// GetPersonActions implements getPersonActions operation.
 	//
 	// Get actions for a specific person.
 	//
 	// GET /people/{id}/actions
 	GetPersonActions(ctx context.Context, params GetPersonActionsParams) (GetPersonActionsRes, error)
+	// GetPersonAgenda implements getPersonAgenda operation.
+	//
+	// Lists every action recorded for the person since their last conversation that is not yet linked to
+	// a conversation, grouped by theme and valence.
+	//
+	// GET /people/{id}/agenda
+	GetPersonAgenda(ctx context.Context, params GetPersonAgendaParams) (GetPersonAgendaRes, error)
 	// GetPersonById implements getPersonById operation.
 	//
 	// Get a person by ID.
 	//
 	// GET /people/{id}
 	GetPersonById(ctx context.Context, params GetPersonByIdParams) (GetPersonByIdRes, error)
+	// GetPersonLadder implements getPersonLadder operation.
+	//
+	// Lists what the person's target level expects (or their current level when no target is set), with
+	// the person's actions on each competency as evidence.
+	//
+	// GET /people/{id}/ladder
+	GetPersonLadder(ctx context.Context, params GetPersonLadderParams) (GetPersonLadderRes, error)
+	// GetPersonThemes implements getPersonThemes operation.
+	//
+	// Each theme carries how many actions and conversations it is linked to.
+	//
+	// GET /people/{id}/themes
+	GetPersonThemes(ctx context.Context, params GetPersonThemesParams) (GetPersonThemesRes, error)
 	// GetPersonTimeline implements getPersonTimeline operation.
 	//
-	// Get timeline for a specific person.
+	// Actions and conversations, newest first, paged by cursor or offset. Filters
+	// combine; a valence filter leaves out conversations. With group_by the
+	// response also summarises every matching item by period, not just the
+	// current page.
 	//
 	// GET /people/{id}/timeline
 	GetPersonTimeline(ctx context.Context, params GetPersonTimelineParams) (GetPersonTimelineRes, error)
@@ -74,35 +174,131 @@ type Handler interface {
 	//
 	// GET /people
 	GetPersons(ctx context.Context, params GetPersonsParams) (GetPersonsRes, error)
+	// GetThemeById implements getThemeById operation.
+	//
+	// Get a theme by ID.
+	//
+	// GET /themes/{id}
+	GetThemeById(ctx context.Context, params GetThemeByIdParams) (GetThemeByIdRes, error)
+	// GetThemes implements getThemes operation.
+	//
+	// List themes across all reports.
+	//
+	// GET /themes
+	GetThemes(ctx context.Context, params GetThemesParams) (GetThemesRes, error)
+	// ListAuditLog implements listAuditLog operation.
+	//
+	// Every API operation and MCP tool call, newest first, with who made it, the ids it touched and
+	// where it came from. Entries cannot be changed or removed. Ask for text/csv, or pass format=csv, to
+	// download every matching entry rather than one page.
+	//
+	// GET /audit
+	ListAuditLog(ctx context.Context, params ListAuditLogParams) (ListAuditLogRes, error)
+	// ListTrash implements listTrash operation.
+	//
+	// People, actions, conversations and themes that have been deleted, most recent first. Anything
+	// deleted along with a person is listed under the person rather than separately.
+	//
+	// GET /trash
+	ListTrash(ctx context.Context, params ListTrashParams) (ListTrashRes, error)
+	// MergeThemes implements mergeThemes operation.
+	//
+	// Moves every action and conversation link from the source themes onto this theme, then moves the
+	// sources to the trash. Runs in one transaction. Sources must belong to the same person as this
+	// theme, unless this is a framework theme.
+	//
+	// POST /themes/{id}/merge
+	MergeThemes(ctx context.Context, req *MergeThemesRequest, params MergeThemesParams) (MergeThemesRes, error)
+	// PurgeTrashItem implements purgeTrashItem operation.
+	//
+	// Purging a person also removes everything that was deleted with them.
+	//
+	// DELETE /trash/{type}/{id}
+	PurgeTrashItem(ctx context.Context, params PurgeTrashItemParams) (PurgeTrashItemRes, error)
+	// RestoreActionRevision implements restoreActionRevision operation.
+	//
+	// Puts the revision's content back on the action. Themes that have since been deleted are left off.
+	// The restore is itself saved as a new revision.
+	//
+	// POST /actions/{id}/revisions/{revision_id}/restore
+	RestoreActionRevision(ctx context.Context, params RestoreActionRevisionParams) (RestoreActionRevisionRes, error)
+	// RestoreConversationRevision implements restoreConversationRevision operation.
+	//
+	// Puts the revision's content back on the conversation. Themes that have since been deleted are left
+	// off. The restore is itself saved as a new revision.
+	//
+	// POST /conversations/{id}/revisions/{revision_id}/restore
+	RestoreConversationRevision(ctx context.Context, params RestoreConversationRevisionParams) (RestoreConversationRevisionRes, error)
+	// RestoreTrashItem implements restoreTrashItem operation.
+	//
+	// Restoring a person also restores what was deleted with them. An item cannot be restored while the
+	// person it belongs to is in the trash.
+	//
+	// POST /trash/{type}/{id}/restore
+	RestoreTrashItem(ctx context.Context, params RestoreTrashItemParams) (RestoreTrashItemRes, error)
+	// Search implements search operation.
+	//
+	// Full-text search over action descriptions and references, conversation descriptions and theme text.
+	//  Results are ordered by relevance; an empty query returns no results.
+	//
+	// GET /search
+	Search(ctx context.Context, params SearchParams) (SearchRes, error)
+	// SetPersonLevels implements setPersonLevels operation.
+	//
+	// Set a person's current and target levels.
+	//
+	// PUT /people/{id}/ladder
+	SetPersonLevels(ctx context.Context, req *SetPersonLevelsRequest, params SetPersonLevelsParams) (SetPersonLevelsRes, error)
+	// SplitTheme implements splitTheme operation.
+	//
+	// Creates a new theme for the same person and moves the selected actions from this theme onto it.
+	// Runs in one transaction.
+	//
+	// POST /themes/{id}/split
+	SplitTheme(ctx context.Context, req *SplitThemeRequest, params SplitThemeParams) (SplitThemeRes, error)
 	// UpdateAction implements updateAction operation.
 	//
-	// Update an action.
+	// Each save that changes the content is kept as a revision.
 	//
 	// PUT /actions/{id}
 	UpdateAction(ctx context.Context, req *UpdateActionRequest, params UpdateActionParams) (UpdateActionRes, error)
+	// UpdateConversation implements updateConversation operation.
+	//
+	// Each save that changes the content is kept as a revision.
+	//
+	// PUT /conversations/{id}
+	UpdateConversation(ctx context.Context, req *UpdateConversationRequest, params UpdateConversationParams) (UpdateConversationRes, error)
 	// UpdatePerson implements updatePerson operation.
 	//
 	// Update a person.
 	//
 	// PUT /people/{id}
 	UpdatePerson(ctx context.Context, req *UpdatePersonRequest, params UpdatePersonParams) (UpdatePersonRes, error)
+	// UpdateTheme implements updateTheme operation.
+	//
+	// Rename, archive or unarchive a theme.
+	//
+	// PUT /themes/{id}
+	UpdateTheme(ctx context.Context, req *UpdateThemeRequest, params UpdateThemeParams) (UpdateThemeRes, error)
 }
 
 // Server implements http server based on OpenAPI v3 specification and
 // calls Handler to handle requests.
 type Server struct {
-	h Handler
+	h   Handler
+	sec SecurityHandler
 	baseServer
 }
 
 // NewServer creates new Server.
-func NewServer(h Handler, opts ...ServerOption) (*Server, error) {
+func NewServer(h Handler, sec SecurityHandler, opts ...ServerOption) (*Server, error) {
 	s, err := newServerConfig(opts...).baseServer()
 	if err != nil {
 		return nil, err
 	}
 	return &Server{
 		h:          h,
+		sec:        sec,
 		baseServer: s,
 	}, nil
 }
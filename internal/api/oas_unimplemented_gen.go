@@ -13,6 +13,15 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// CompleteFollowUp implements completeFollowUp operation.
+//
+// Mark a follow-up item as done.
+//
+// POST /follow-ups/{id}/complete
+func (UnimplementedHandler) CompleteFollowUp(ctx context.Context, params CompleteFollowUpParams) (r CompleteFollowUpRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CreateAction implements createAction operation.
 //
 // Create a new action.
@@ -31,6 +40,15 @@ func (UnimplementedHandler) CreateConversation(ctx context.Context, req *CreateC
 	return r, ht.ErrNotImplemented
 }
 
+// CreateFollowUp implements createFollowUp operation.
+//
+// Create a follow-up item for a conversation.
+//
+// POST /follow-ups
+func (UnimplementedHandler) CreateFollowUp(ctx context.Context, req *CreateFollowUpRequest) (r CreateFollowUpRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CreatePerson implements createPerson operation.
 //
 // Create a new person.
@@ -40,24 +58,52 @@ func (UnimplementedHandler) CreatePerson(ctx context.Context, req *CreatePersonR
 	return r, ht.ErrNotImplemented
 }
 
+// CreateTheme implements createTheme operation.
+//
+// Create a theme for a person.
+//
+// POST /themes
+func (UnimplementedHandler) CreateTheme(ctx context.Context, req *CreateThemeRequest) (r CreateThemeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DeleteAction implements deleteAction operation.
 //
-// Delete an action.
+// Moves the action to the trash.
 //
 // DELETE /actions/{id}
 func (UnimplementedHandler) DeleteAction(ctx context.Context, params DeleteActionParams) (r DeleteActionRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// DeleteConversation implements deleteConversation operation.
+//
+// Moves the conversation to the trash.
+//
+// DELETE /conversations/{id}
+func (UnimplementedHandler) DeleteConversation(ctx context.Context, params DeleteConversationParams) (r DeleteConversationRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // DeletePerson implements deletePerson operation.
 //
-// Delete a person.
+// Moves the person to the trash together with their actions, conversations and themes.
 //
 // DELETE /people/{id}
 func (UnimplementedHandler) DeletePerson(ctx context.Context, params DeletePersonParams) (r DeletePersonRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// DeleteTheme implements deleteTheme operation.
+//
+// Moves the theme to the trash. It stops showing on the actions and conversations that used it until
+// it is restored.
+//
+// DELETE /themes/{id}
+func (UnimplementedHandler) DeleteTheme(ctx context.Context, params DeleteThemeParams) (r DeleteThemeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetActionById implements getActionById operation.
 //
 // Get an action by ID.
@@ -67,15 +113,82 @@ func (UnimplementedHandler) GetActionById(ctx context.Context, params GetActionB
 	return r, ht.ErrNotImplemented
 }
 
+// GetActionRevisions implements getActionRevisions operation.
+//
+// Every saved version of the action, newest first. Each revision lists the fields that changed from
+// the one before it; the first has no changes.
+//
+// GET /actions/{id}/revisions
+func (UnimplementedHandler) GetActionRevisions(ctx context.Context, params GetActionRevisionsParams) (r GetActionRevisionsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetActions implements getActions operation.
 //
-// Get all actions.
+// Filters combine; the total counts every action matching all of them.
 //
 // GET /actions
 func (UnimplementedHandler) GetActions(ctx context.Context, params GetActionsParams) (r GetActionsRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// GetConversationById implements getConversationById operation.
+//
+// Get a conversation by ID.
+//
+// GET /conversations/{id}
+func (UnimplementedHandler) GetConversationById(ctx context.Context, params GetConversationByIdParams) (r GetConversationByIdRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetConversationRevisions implements getConversationRevisions operation.
+//
+// Every saved version of the conversation, newest first. Each revision lists the fields that changed
+// from the one before it; the first has no changes.
+//
+// GET /conversations/{id}/revisions
+func (UnimplementedHandler) GetConversationRevisions(ctx context.Context, params GetConversationRevisionsParams) (r GetConversationRevisionsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetConversations implements getConversations operation.
+//
+// Get all conversations.
+//
+// GET /conversations
+func (UnimplementedHandler) GetConversations(ctx context.Context, params GetConversationsParams) (r GetConversationsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetFollowUps implements getFollowUps operation.
+//
+// List follow-up items across all reports.
+//
+// GET /follow-ups
+func (UnimplementedHandler) GetFollowUps(ctx context.Context, params GetFollowUpsParams) (r GetFollowUpsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetLevels implements getLevels operation.
+//
+// Levels from most junior to most senior, each with what it expects per framework competency. Levels
+// and expectations are read-only here; the only way to define or change them is to import a
+// framework file, at /framework or with cmd/importframework.
+//
+// GET /levels
+func (UnimplementedHandler) GetLevels(ctx context.Context) (r GetLevelsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetOrgTree implements getOrgTree operation.
+//
+// Every person arranged under the person they report to. People who report to nobody are roots.
+//
+// GET /org
+func (UnimplementedHandler) GetOrgTree(ctx context.Context) (r GetOrgTreeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetPersonActions implements getPersonActions operation.
 //
 // Get actions for a specific person.
@@ -85,6 +198,16 @@ func (UnimplementedHandler) GetPersonActions(ctx context.Context, params GetPers
 	return r, ht.ErrNotImplemented
 }
 
+// GetPersonAgenda implements getPersonAgenda operation.
+//
+// Lists every action recorded for the person since their last conversation that is not yet linked to
+// a conversation, grouped by theme and valence.
+//
+// GET /people/{id}/agenda
+func (UnimplementedHandler) GetPersonAgenda(ctx context.Context, params GetPersonAgendaParams) (r GetPersonAgendaRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetPersonById implements getPersonById operation.
 //
 // Get a person by ID.
@@ -94,9 +217,31 @@ func (UnimplementedHandler) GetPersonById(ctx context.Context, params GetPersonB
 	return r, ht.ErrNotImplemented
 }
 
+// GetPersonLadder implements getPersonLadder operation.
+//
+// Lists what the person's target level expects (or their current level when no target is set), with
+// the person's actions on each competency as evidence.
+//
+// GET /people/{id}/ladder
+func (UnimplementedHandler) GetPersonLadder(ctx context.Context, params GetPersonLadderParams) (r GetPersonLadderRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetPersonThemes implements getPersonThemes operation.
+//
+// Each theme carries how many actions and conversations it is linked to.
+//
+// GET /people/{id}/themes
+func (UnimplementedHandler) GetPersonThemes(ctx context.Context, params GetPersonThemesParams) (r GetPersonThemesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetPersonTimeline implements getPersonTimeline operation.
 //
-// Get timeline for a specific person.
+// Actions and conversations, newest first, paged by cursor or offset. Filters
+// combine; a valence filter leaves out conversations. With group_by the
+// response also summarises every matching item by period, not just the
+// current page.
 //
 // GET /people/{id}/timeline
 func (UnimplementedHandler) GetPersonTimeline(ctx context.Context, params GetPersonTimelineParams) (r GetPersonTimelineRes, _ error) {
@@ -112,15 +257,143 @@ func (UnimplementedHandler) GetPersons(ctx context.Context, params GetPersonsPar
 	return r, ht.ErrNotImplemented
 }
 
+// GetThemeById implements getThemeById operation.
+//
+// Get a theme by ID.
+//
+// GET /themes/{id}
+func (UnimplementedHandler) GetThemeById(ctx context.Context, params GetThemeByIdParams) (r GetThemeByIdRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetThemes implements getThemes operation.
+//
+// List themes across all reports.
+//
+// GET /themes
+func (UnimplementedHandler) GetThemes(ctx context.Context, params GetThemesParams) (r GetThemesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListAuditLog implements listAuditLog operation.
+//
+// Every API operation and MCP tool call, newest first, with who made it, the ids it touched and
+// where it came from. Entries cannot be changed or removed. Ask for text/csv, or pass format=csv, to
+// download every matching entry rather than one page.
+//
+// GET /audit
+func (UnimplementedHandler) ListAuditLog(ctx context.Context, params ListAuditLogParams) (r ListAuditLogRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListTrash implements listTrash operation.
+//
+// People, actions, conversations and themes that have been deleted, most recent first. Anything
+// deleted along with a person is listed under the person rather than separately.
+//
+// GET /trash
+func (UnimplementedHandler) ListTrash(ctx context.Context, params ListTrashParams) (r ListTrashRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// MergeThemes implements mergeThemes operation.
+//
+// Moves every action and conversation link from the source themes onto this theme, then moves the
+// sources to the trash. Runs in one transaction. Sources must belong to the same person as this
+// theme, unless this is a framework theme.
+//
+// POST /themes/{id}/merge
+func (UnimplementedHandler) MergeThemes(ctx context.Context, req *MergeThemesRequest, params MergeThemesParams) (r MergeThemesRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// PurgeTrashItem implements purgeTrashItem operation.
+//
+// Purging a person also removes everything that was deleted with them.
+//
+// DELETE /trash/{type}/{id}
+func (UnimplementedHandler) PurgeTrashItem(ctx context.Context, params PurgeTrashItemParams) (r PurgeTrashItemRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RestoreActionRevision implements restoreActionRevision operation.
+//
+// Puts the revision's content back on the action. Themes that have since been deleted are left off.
+// The restore is itself saved as a new revision.
+//
+// POST /actions/{id}/revisions/{revision_id}/restore
+func (UnimplementedHandler) RestoreActionRevision(ctx context.Context, params RestoreActionRevisionParams) (r RestoreActionRevisionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RestoreConversationRevision implements restoreConversationRevision operation.
+//
+// Puts the revision's content back on the conversation. Themes that have since been deleted are left
+// off. The restore is itself saved as a new revision.
+//
+// POST /conversations/{id}/revisions/{revision_id}/restore
+func (UnimplementedHandler) RestoreConversationRevision(ctx context.Context, params RestoreConversationRevisionParams) (r RestoreConversationRevisionRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RestoreTrashItem implements restoreTrashItem operation.
+//
+// Restoring a person also restores what was deleted with them. An item cannot be restored while the
+// person it belongs to is in the trash.
+//
+// POST /trash/{type}/{id}/restore
+func (UnimplementedHandler) RestoreTrashItem(ctx context.Context, params RestoreTrashItemParams) (r RestoreTrashItemRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// Search implements search operation.
+//
+// Full-text search over action descriptions and references, conversation descriptions and theme text.
+//
+//	Results are ordered by relevance; an empty query returns no results.
+//
+// GET /search
+func (UnimplementedHandler) Search(ctx context.Context, params SearchParams) (r SearchRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SetPersonLevels implements setPersonLevels operation.
+//
+// Set a person's current and target levels.
+//
+// PUT /people/{id}/ladder
+func (UnimplementedHandler) SetPersonLevels(ctx context.Context, req *SetPersonLevelsRequest, params SetPersonLevelsParams) (r SetPersonLevelsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// SplitTheme implements splitTheme operation.
+//
+// Creates a new theme for the same person and moves the selected actions from this theme onto it.
+// Runs in one transaction.
+//
+// POST /themes/{id}/split
+func (UnimplementedHandler) SplitTheme(ctx context.Context, req *SplitThemeRequest, params SplitThemeParams) (r SplitThemeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdateAction implements updateAction operation.
 //
-// Update an action.
+// Each save that changes the content is kept as a revision.
 //
 // PUT /actions/{id}
 func (UnimplementedHandler) UpdateAction(ctx context.Context, req *UpdateActionRequest, params UpdateActionParams) (r UpdateActionRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
 
+// UpdateConversation implements updateConversation operation.
+//
+// Each save that changes the content is kept as a revision.
+//
+// PUT /conversations/{id}
+func (UnimplementedHandler) UpdateConversation(ctx context.Context, req *UpdateConversationRequest, params UpdateConversationParams) (r UpdateConversationRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // UpdatePerson implements updatePerson operation.
 //
 // Update a person.
@@ -129,3 +402,12 @@ func (UnimplementedHandler) UpdateAction(ctx context.Context, req *UpdateActionR
 func (UnimplementedHandler) UpdatePerson(ctx context.Context, req *UpdatePersonRequest, params UpdatePersonParams) (r UpdatePersonRes, _ error) {
 	return r, ht.ErrNotImplemented
 }
+
+// UpdateTheme implements updateTheme operation.
+//
+// Rename, archive or unarchive a theme.
+//
+// PUT /themes/{id}
+func (UnimplementedHandler) UpdateTheme(ctx context.Context, req *UpdateThemeRequest, params UpdateThemeParams) (r UpdateThemeRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
@@ -0,0 +1,241 @@
+// Package audit keeps the append-only record of who read or changed what
+package audit
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/ogen-go/ogen/middleware"
+	"github.com/rs/xid"
+	"go.uber.org/zap"
+
+	"pepo/internal/api"
+	"pepo/internal/auth"
+	"pepo/internal/db"
+)
+
+// How the actor behind an entry signed in
+const (
+	ViaSession = "session"
+	ViaToken   = "token"
+	ViaMCP     = "mcp"
+)
+
+// Entry is one audited operation
+type Entry struct {
+	ManagerID  string
+	Operation  string
+	EntityIDs  []string
+	Via        string
+	Method     string
+	Path       string
+	RemoteAddr string
+	UserAgent  string
+	Outcome    string
+}
+
+// Log appends entries to the audit_log table
+type Log struct {
+	queries *db.Queries
+}
+
+func NewLog(queries *db.Queries) *Log {
+	return &Log{queries: queries}
+}
+
+// Record appends an entry. The operation has already run by the time it is
+// recorded, so a failed write is logged rather than handed back to the caller,
+// and a client hanging up does not cancel it.
+func (l *Log) Record(ctx context.Context, e Entry) {
+	if e.EntityIDs == nil {
+		e.EntityIDs = []string{}
+	}
+	err := l.queries.RecordAudit(context.WithoutCancel(ctx), db.RecordAuditParams{
+		ID:         xid.New().String(),
+		ManagerID:  e.ManagerID,
+		Operation:  e.Operation,
+		EntityIds:  e.EntityIDs,
+		AuthMethod: e.Via,
+		Method:     e.Method,
+		Path:       e.Path,
+		RemoteAddr: e.RemoteAddr,
+		UserAgent:  e.UserAgent,
+		Outcome:    e.Outcome,
+	})
+	if err != nil {
+		zap.L().Error("error recording audit entry", zap.String("operation", e.Operation), zap.Error(err))
+	}
+}
+
+// FromRequest starts an entry for a request made by the signed-in manager
+func FromRequest(r *http.Request, operation string) Entry {
+	via := ViaSession
+	if _, ok := auth.TokenScopeFromContext(r.Context()); ok {
+		via = ViaToken
+	}
+	path := r.RequestURI
+	if path == "" {
+		path = r.URL.RequestURI()
+	}
+	return Entry{
+		ManagerID:  auth.ManagerID(r.Context()),
+		Operation:  operation,
+		Via:        via,
+		Method:     r.Method,
+		Path:       path,
+		RemoteAddr: r.RemoteAddr,
+		UserAgent:  r.UserAgent(),
+	}
+}
+
+// Middleware records every API operation after it has been handled, under
+// ogen's operation name, with the ids in its parameters, body and response
+func (l *Log) Middleware() api.Middleware {
+	return func(req middleware.Request, next middleware.Next) (middleware.Response, error) {
+		resp, err := next(req)
+
+		params := make(map[string]any, len(req.Params))
+		for key, value := range req.Params {
+			params[key.Name] = value
+		}
+		entry := FromRequest(req.Raw, req.OperationName)
+		entry.EntityIDs = EntityIDs(params, req.Body, resp.Type)
+		entry.Outcome = Outcome(resp.Type, err)
+		l.Record(req.Context, entry)
+
+		return resp, err
+	}
+}
+
+// RecordCall records a handler that a page called directly rather than through
+// the API router, under the handler's operation name
+func (l *Log) RecordCall(r *http.Request, operation string, params map[string]any, res any, err error) {
+	entry := FromRequest(r, operation)
+	entry.EntityIDs = EntityIDs(params, res)
+	entry.Outcome = Outcome(res, err)
+	l.Record(r.Context(), entry)
+}
+
+// Handler records requests to a route served outside the API. Ids come from the
+// path and from id form values, and the outcome is the response status.
+func (l *Log) Handler(operation string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		sw := &statusWriter{ResponseWriter: w, status: http.StatusOK}
+		next(sw, r)
+
+		// Handlers that parsed a form leave body values in r.Form as well
+		values := r.Form
+		if values == nil {
+			values = r.URL.Query()
+		}
+		params := map[string]any{}
+		for name, v := range values {
+			params[name] = v
+		}
+		var ids idSet
+		for _, segment := range strings.Split(r.URL.Path, "/") {
+			ids.add(segment)
+		}
+		ids.add(EntityIDs(params)...)
+
+		entry := FromRequest(r, operation)
+		entry.EntityIDs = ids.list
+		entry.Outcome = http.StatusText(sw.status)
+		l.Record(r.Context(), entry)
+	}
+}
+
+// statusWriter remembers the status code a handler answered with
+type statusWriter struct {
+	http.ResponseWriter
+	status int
+}
+
+func (w *statusWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+// idGetters are the generated accessors that name the entity a request or
+// response is about, or the person it belongs to
+var idGetters = []string{"GetID", "GetPersonID", "GetActionID", "GetConversationID"}
+
+// EntityIDs collects the xids an operation touched: parameters named "id" or
+// ending in "_id", then whatever idGetters return on each of values. Anything
+// that is not a valid xid is skipped, and each id appears once.
+func EntityIDs(params map[string]any, values ...any) []string {
+	names := make([]string, 0, len(params))
+	for name := range params {
+		if name == "id" || strings.HasSuffix(name, "_id") {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	var ids idSet
+	for _, name := range names {
+		ids.addValue(params[name])
+	}
+	for _, v := range values {
+		rv := reflect.ValueOf(v)
+		if !rv.IsValid() || (rv.Kind() == reflect.Pointer && rv.IsNil()) {
+			continue
+		}
+		for _, getter := range idGetters {
+			m := rv.MethodByName(getter)
+			if m.IsValid() && m.Type().NumIn() == 0 && m.Type().NumOut() == 1 {
+				ids.addValue(m.Call(nil)[0].Interface())
+			}
+		}
+	}
+	return ids.list
+}
+
+// Outcome names the response an operation produced, e.g. "Person" or
+// "GetPersonByIdNotFound"
+func Outcome(res any, err error) string {
+	if err != nil {
+		return "error"
+	}
+	if res == nil {
+		return ""
+	}
+	return strings.TrimPrefix(fmt.Sprintf("%T", res), "*api.")
+}
+
+type idSet struct {
+	list []string
+	seen map[string]bool
+}
+
+func (s *idSet) add(ids ...string) {
+	for _, id := range ids {
+		if _, err := xid.FromString(id); err != nil || s.seen[id] {
+			continue
+		}
+		if s.seen == nil {
+			s.seen = map[string]bool{}
+		}
+		s.seen[id] = true
+		s.list = append(s.list, id)
+	}
+}
+
+// addValue accepts the shapes an id arrives in: a plain string, a list of them,
+// or an optional value such as api.OptString
+func (s *idSet) addValue(v any) {
+	switch v := v.(type) {
+	case string:
+		s.add(v)
+	case []string:
+		s.add(v...)
+	case interface{ Get() (string, bool) }:
+		if id, ok := v.Get(); ok {
+			s.add(id)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package audit
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"pepo/internal/api"
+)
+
+func TestEntityIDs(t *testing.T) {
+	person := "d2a9ltbbvl8ceblq6ev0"
+	action := "d2a9m1bbvl8ceblq6f0g"
+	theme := "d2a9m9bbvl8ceblq6fag"
+
+	params := map[string]any{
+		"id":        action,
+		"person_id": api.NewOptString(person),
+		"theme_id":  api.OptString{},
+		"type":      "action",
+		"limit":     api.NewOptInt(10),
+	}
+	body := &api.CreateActionRequest{PersonID: person, Themes: []string{theme}}
+	result := &api.Action{ID: action, PersonID: person}
+
+	got := EntityIDs(params, body, result)
+	want := []string{action, person}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("EntityIDs() = %v, want %v", got, want)
+	}
+
+	// Arguments decoded from JSON, as MCP tool calls arrive
+	got = EntityIDs(map[string]any{"person_id": person, "ids": []string{theme}, "action_id": "not-an-xid"})
+	if !reflect.DeepEqual(got, []string{person}) {
+		t.Errorf("EntityIDs(arguments) = %v, want [%s]", got, person)
+	}
+
+	var missing *api.Action
+	if got := EntityIDs(nil, missing, nil); len(got) != 0 {
+		t.Errorf("EntityIDs(nil values) = %v, want none", got)
+	}
+}
+
+func TestOutcome(t *testing.T) {
+	tests := []struct {
+		res  any
+		err  error
+		want string
+	}{
+		{&api.Person{}, nil, "Person"},
+		{&api.GetPersonByIdNotFound{}, nil, "GetPersonByIdNotFound"},
+		{nil, errors.New("boom"), "error"},
+		{nil, nil, ""},
+	}
+	for _, tt := range tests {
+		if got := Outcome(tt.res, tt.err); got != tt.want {
+			t.Errorf("Outcome(%T, %v) = %q, want %q", tt.res, tt.err, got, tt.want)
+		}
+	}
+}
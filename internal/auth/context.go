@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/rs/xid"
+
+	"pepo/internal/db"
+)
+
+// Manager is the authenticated account attached to a request
+type Manager struct {
+	ID    string
+	Email string
+	Name  string
+}
+
+type contextKey string
+
+const (
+	managerKey    contextKey = "manager"
+	tokenScopeKey contextKey = "token_scope"
+)
+
+// WithManager returns a copy of ctx carrying the authenticated manager
+func WithManager(ctx context.Context, manager Manager) context.Context {
+	return context.WithValue(ctx, managerKey, manager)
+}
+
+// ManagerFromContext returns the authenticated manager, if any
+func ManagerFromContext(ctx context.Context) (Manager, bool) {
+	manager, ok := ctx.Value(managerKey).(Manager)
+	return manager, ok
+}
+
+// ManagerID returns the authenticated manager's id. Without a manager it returns
+// the nil xid, which owns no rows, so scoped queries come back empty.
+func ManagerID(ctx context.Context) string {
+	if manager, ok := ManagerFromContext(ctx); ok {
+		return manager.ID
+	}
+	return xid.NilID().String()
+}
+
+// WithTokenScope records that the request authenticated with an API token of the given scope
+func WithTokenScope(ctx context.Context, scope db.ApiTokenScope) context.Context {
+	return context.WithValue(ctx, tokenScopeKey, scope)
+}
+
+// TokenScopeFromContext returns the API token scope, if the request used a token
+func TokenScopeFromContext(ctx context.Context) (db.ApiTokenScope, bool) {
+	scope, ok := ctx.Value(tokenScopeKey).(db.ApiTokenScope)
+	return scope, ok
+}
@@ -0,0 +1,97 @@
+package auth
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"pepo/internal/db"
+)
+
+// publicPaths can be reached without signing in. Entries ending in "/" match as prefixes.
+var publicPaths = []string{
+	"/login",
+	"/health",
+	"/api/v1/health",
+	"/static/",
+}
+
+// Require demands an authenticated manager for every non-public path, either from an
+// "Authorization: Bearer" API token or from the session cookie. The manager is added to
+// the request context for downstream handlers.
+func Require(sessions *Sessions, tokens *Tokens) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if isPublicPath(r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			// A bearer token is explicit, so a bad one is rejected rather than
+			// falling back to whatever cookie came along with it.
+			if _, ok := bearerToken(r); ok {
+				manager, scope, ok := tokens.Authenticate(r)
+				if !ok {
+					writeError(w, http.StatusUnauthorized, "Invalid or expired API token", "UNAUTHORIZED")
+					return
+				}
+				if scope != db.ApiTokenScopeWrite && !isReadOnlyMethod(r.Method) {
+					writeError(w, http.StatusForbidden, "API token is read-only", "FORBIDDEN")
+					return
+				}
+				ctx := WithTokenScope(WithManager(r.Context(), manager), scope)
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+
+			manager, ok := sessions.Authenticate(r)
+			if !ok {
+				rejectUnauthenticated(w, r)
+				return
+			}
+
+			next.ServeHTTP(w, r.WithContext(WithManager(r.Context(), manager)))
+		})
+	}
+}
+
+// rejectUnauthenticated answers in the form the client expects: HTMX gets a client-side
+// redirect, browsers navigating to a page get sent to the login form and API clients get JSON.
+func rejectUnauthenticated(w http.ResponseWriter, r *http.Request) {
+	loginURL := "/login?next=" + url.QueryEscape(r.URL.RequestURI())
+
+	if r.Header.Get("HX-Request") == "true" {
+		w.Header().Set("HX-Redirect", loginURL)
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if r.Method == http.MethodGet && strings.Contains(r.Header.Get("Accept"), "text/html") {
+		http.Redirect(w, r, loginURL, http.StatusSeeOther)
+		return
+	}
+
+	writeError(w, http.StatusUnauthorized, "Authentication required", "UNAUTHORIZED")
+}
+
+// writeError writes an error body shaped like the API's Error schema
+func writeError(w http.ResponseWriter, status int, message, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write([]byte(`{"message":"` + message + `","code":"` + code + `"}`))
+}
+
+// isPublicPath reports whether path is reachable without a session
+func isPublicPath(path string) bool {
+	for _, public := range publicPaths {
+		if path == public || (strings.HasSuffix(public, "/") && strings.HasPrefix(path, public)) {
+			return true
+		}
+	}
+	return false
+}
+
+// isReadOnlyMethod reports whether a request with this method cannot change data
+func isReadOnlyMethod(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead || method == http.MethodOptions
+}
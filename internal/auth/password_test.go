@@ -0,0 +1,67 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHashPassword(t *testing.T) {
+	t.Run("verifies the original password", func(t *testing.T) {
+		hash, err := HashPassword("correct horse battery staple")
+		if err != nil {
+			t.Fatalf("HashPassword returned error: %v", err)
+		}
+
+		if !strings.HasPrefix(hash, "pbkdf2-sha256$") {
+			t.Errorf("Expected pbkdf2-sha256 prefix, got %s", hash)
+		}
+
+		ok, err := VerifyPassword("correct horse battery staple", hash)
+		if err != nil {
+			t.Fatalf("VerifyPassword returned error: %v", err)
+		}
+		if !ok {
+			t.Error("Expected password to verify")
+		}
+	})
+
+	t.Run("rejects a different password", func(t *testing.T) {
+		hash, err := HashPassword("secret")
+		if err != nil {
+			t.Fatalf("HashPassword returned error: %v", err)
+		}
+
+		ok, err := VerifyPassword("Secret", hash)
+		if err != nil {
+			t.Fatalf("VerifyPassword returned error: %v", err)
+		}
+		if ok {
+			t.Error("Expected different password to be rejected")
+		}
+	})
+
+	t.Run("salts each hash", func(t *testing.T) {
+		first, _ := HashPassword("secret")
+		second, _ := HashPassword("secret")
+		if first == second {
+			t.Error("Expected hashes of the same password to differ")
+		}
+	})
+}
+
+func TestVerifyPasswordInvalidHash(t *testing.T) {
+	tests := []string{
+		"",
+		"plaintext",
+		"bcrypt$10$abc$def",
+		"pbkdf2-sha256$notanumber$c2FsdA$a2V5",
+		"pbkdf2-sha256$1000$!!!$a2V5",
+		"pbkdf2-sha256$1000$c2FsdA$",
+	}
+
+	for _, encoded := range tests {
+		if _, err := VerifyPassword("secret", encoded); err != ErrInvalidHash {
+			t.Errorf("VerifyPassword(%q) error = %v, want ErrInvalidHash", encoded, err)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"pepo/internal/api"
+)
+
+// APISecurity satisfies the security requirements declared in the OpenAPI spec.
+// Credentials are checked by Require before a request reaches the API server,
+// so this only confirms that a manager was attached to the context.
+type APISecurity struct{}
+
+var errNoManager = errors.New("no authenticated manager")
+
+func (APISecurity) HandleBearerAuth(ctx context.Context, _ api.OperationName, _ api.BearerAuth) (context.Context, error) {
+	if _, ok := ManagerFromContext(ctx); !ok {
+		return ctx, errNoManager
+	}
+	return ctx, nil
+}
+
+func (APISecurity) HandleSessionCookie(ctx context.Context, _ api.OperationName, _ api.SessionCookie) (context.Context, error) {
+	if _, ok := ManagerFromContext(ctx); !ok {
+		return ctx, errNoManager
+	}
+	return ctx, nil
+}
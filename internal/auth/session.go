@@ -0,0 +1,128 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.uber.org/zap"
+
+	"pepo/internal/db"
+)
+
+// SessionCookieName is the cookie that carries the session token
+const SessionCookieName = "pepo_session"
+
+// Sessions issues and validates cookie-based manager sessions
+type Sessions struct {
+	queries *db.Queries
+	ttl     time.Duration
+	secure  bool
+}
+
+// NewSessions creates a session store. Secure marks cookies as HTTPS-only.
+func NewSessions(queries *db.Queries, ttl time.Duration, secure bool) *Sessions {
+	return &Sessions{
+		queries: queries,
+		ttl:     ttl,
+		secure:  secure,
+	}
+}
+
+// Start creates a new session for the manager and sets the session cookie
+func (s *Sessions) Start(ctx context.Context, w http.ResponseWriter, managerID string) error {
+	// Opportunistically clear out stale sessions so the table doesn't grow unbounded
+	if err := s.queries.DeleteExpiredSessions(ctx); err != nil {
+		zap.L().Warn("failed to delete expired sessions", zap.Error(err))
+	}
+
+	token, err := newSecret()
+	if err != nil {
+		return fmt.Errorf("failed to generate session token: %w", err)
+	}
+	expiresAt := time.Now().Add(s.ttl)
+
+	err = s.queries.CreateSession(ctx, db.CreateSessionParams{
+		TokenHash: hashToken(token),
+		ManagerID: managerID,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create session: %w", err)
+	}
+
+	http.SetCookie(w, &http.Cookie{
+		Name:     SessionCookieName,
+		Value:    token,
+		Path:     "/",
+		Expires:  expiresAt,
+		HttpOnly: true,
+		Secure:   s.secure,
+		SameSite: http.SameSiteLaxMode,
+	})
+	return nil
+}
+
+// End deletes the request's session, if any, and clears the cookie
+func (s *Sessions) End(w http.ResponseWriter, r *http.Request) error {
+	http.SetCookie(w, &http.Cookie{
+		Name:     SessionCookieName,
+		Value:    "",
+		Path:     "/",
+		MaxAge:   -1,
+		HttpOnly: true,
+		Secure:   s.secure,
+		SameSite: http.SameSiteLaxMode,
+	})
+
+	cookie, err := r.Cookie(SessionCookieName)
+	if err != nil || cookie.Value == "" {
+		return nil
+	}
+	if err := s.queries.DeleteSession(r.Context(), hashToken(cookie.Value)); err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+	return nil
+}
+
+// Authenticate resolves the manager for the request's session cookie
+func (s *Sessions) Authenticate(r *http.Request) (Manager, bool) {
+	cookie, err := r.Cookie(SessionCookieName)
+	if err != nil || cookie.Value == "" {
+		return Manager{}, false
+	}
+
+	row, err := s.queries.GetManagerBySessionToken(r.Context(), hashToken(cookie.Value))
+	if err != nil {
+		if err != sql.ErrNoRows {
+			zap.L().Error("error looking up session", zap.Error(err))
+		}
+		return Manager{}, false
+	}
+
+	return Manager{
+		ID:    row.Manager.ID.String(),
+		Email: row.Manager.Email,
+		Name:  row.Manager.Name,
+	}, true
+}
+
+// newSecret returns 32 random bytes encoded for use in a cookie or header
+func newSecret() (string, error) {
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
+
+// hashToken returns the value stored in the token_hash columns for a session or API token
+func hashToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
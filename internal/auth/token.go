@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/rs/xid"
+	"go.uber.org/zap"
+
+	"pepo/internal/db"
+)
+
+// TokenPrefix marks API tokens so they are easy to recognise in config files and secret scanners
+const TokenPrefix = "pepo_"
+
+// Tokens issues and validates personal API tokens
+type Tokens struct {
+	queries *db.Queries
+}
+
+// NewTokens creates an API token store
+func NewTokens(queries *db.Queries) *Tokens {
+	return &Tokens{queries: queries}
+}
+
+// Issue creates a token for the manager and returns its secret. Only the hash is
+// stored, so the secret cannot be shown again.
+func (t *Tokens) Issue(ctx context.Context, managerID, name string, scope db.ApiTokenScope, expiresAt sql.NullTime) (string, error) {
+	secret, err := newSecret()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate API token: %w", err)
+	}
+	token := TokenPrefix + secret
+
+	_, err = t.queries.CreateAPIToken(ctx, db.CreateAPITokenParams{
+		ID:        xid.New().String(),
+		ManagerID: managerID,
+		Name:      name,
+		TokenHash: hashToken(token),
+		Scope:     scope,
+		ExpiresAt: expiresAt,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create API token: %w", err)
+	}
+	return token, nil
+}
+
+// Lookup resolves the manager and scope for a token secret. Revoked and expired
+// tokens are not found.
+func (t *Tokens) Lookup(ctx context.Context, token string) (Manager, db.ApiTokenScope, bool) {
+	if !strings.HasPrefix(token, TokenPrefix) {
+		return Manager{}, "", false
+	}
+
+	row, err := t.queries.GetManagerByAPIToken(ctx, hashToken(token))
+	if err != nil {
+		if err != sql.ErrNoRows {
+			zap.L().Error("error looking up API token", zap.Error(err))
+		}
+		return Manager{}, "", false
+	}
+
+	if err := t.queries.TouchAPIToken(ctx, row.ApiToken.ID.String()); err != nil {
+		zap.L().Warn("failed to record API token use", zap.Error(err))
+	}
+
+	return Manager{
+		ID:    row.Manager.ID.String(),
+		Email: row.Manager.Email,
+		Name:  row.Manager.Name,
+	}, row.ApiToken.Scope, true
+}
+
+// Authenticate resolves the manager for the request's bearer token
+func (t *Tokens) Authenticate(r *http.Request) (Manager, db.ApiTokenScope, bool) {
+	token, ok := bearerToken(r)
+	if !ok {
+		return Manager{}, "", false
+	}
+	return t.Lookup(r.Context(), token)
+}
+
+// bearerToken extracts the credentials from an "Authorization: Bearer" header
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return "", false
+	}
+	token = strings.TrimSpace(token)
+	return token, token != ""
+}
@@ -0,0 +1,39 @@
+package auth
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBearerToken(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   string
+		wantOK bool
+	}{
+		{name: "bearer token", header: "Bearer pepo_abc", want: "pepo_abc", wantOK: true},
+		{name: "scheme is case-insensitive", header: "bearer pepo_abc", want: "pepo_abc", wantOK: true},
+		{name: "surrounding whitespace", header: "Bearer   pepo_abc ", want: "pepo_abc", wantOK: true},
+		{name: "missing header", header: "", wantOK: false},
+		{name: "basic auth", header: "Basic dXNlcjpwYXNz", wantOK: false},
+		{name: "empty token", header: "Bearer ", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/api/v1/people", nil)
+			if tt.header != "" {
+				r.Header.Set("Authorization", tt.header)
+			}
+
+			got, ok := bearerToken(r)
+			if ok != tt.wantOK {
+				t.Fatalf("Expected ok=%v, got %v", tt.wantOK, ok)
+			}
+			if got != tt.want {
+				t.Errorf("Expected token %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestLocal(t *testing.T) {
+	ctx := context.Background()
+	store, err := NewLocal(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocal returned error: %v", err)
+	}
+
+	t.Run("round trips a blob", func(t *testing.T) {
+		if err := store.Put(ctx, "abc", strings.NewReader("hello")); err != nil {
+			t.Fatalf("Put returned error: %v", err)
+		}
+		r, err := store.Open(ctx, "abc")
+		if err != nil {
+			t.Fatalf("Open returned error: %v", err)
+		}
+		defer r.Close()
+		data, _ := io.ReadAll(r)
+		if string(data) != "hello" {
+			t.Errorf("Expected %q, got %q", "hello", data)
+		}
+	})
+
+	t.Run("deletes a blob", func(t *testing.T) {
+		if err := store.Put(ctx, "gone", strings.NewReader("x")); err != nil {
+			t.Fatalf("Put returned error: %v", err)
+		}
+		if err := store.Delete(ctx, "gone"); err != nil {
+			t.Fatalf("Delete returned error: %v", err)
+		}
+		if _, err := store.Open(ctx, "gone"); !errors.Is(err, ErrNotFound) {
+			t.Errorf("Expected ErrNotFound, got %v", err)
+		}
+		if err := store.Delete(ctx, "gone"); err != nil {
+			t.Errorf("Deleting a missing blob returned error: %v", err)
+		}
+	})
+
+	t.Run("rejects keys that escape the directory", func(t *testing.T) {
+		for _, key := range []string{"", "..", "../etc", "a/b"} {
+			if err := store.Put(ctx, key, strings.NewReader("x")); err == nil {
+				t.Errorf("Expected error for key %q", key)
+			}
+		}
+	})
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"os"
 	"testing"
+	"time"
 )
 
 func TestLoad(t *testing.T) {
@@ -148,6 +149,38 @@ func TestGetEnv(t *testing.T) {
 	})
 }
 
+func TestGetDurationEnv(t *testing.T) {
+	original := os.Getenv("TEST_DURATION")
+	defer func() {
+		if original != "" {
+			os.Setenv("TEST_DURATION", original)
+		} else {
+			os.Unsetenv("TEST_DURATION")
+		}
+	}()
+
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Duration
+	}{
+		{"parses a valid duration", "12h", 12 * time.Hour},
+		{"falls back when unset", "", time.Hour},
+		{"falls back when invalid", "forever", time.Hour},
+		{"falls back when not positive", "-5m", time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			os.Setenv("TEST_DURATION", tt.value)
+			result := getDurationEnv("TEST_DURATION", time.Hour)
+			if result != tt.expected {
+				t.Errorf("getDurationEnv() = %v, expected %v for value %q", result, tt.expected, tt.value)
+			}
+		})
+	}
+}
+
 func TestConfigStructure(t *testing.T) {
 	cfg := &Config{
 		Port:        "8080",
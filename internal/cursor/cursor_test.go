@@ -0,0 +1,35 @@
+package cursor
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRoundTrip(t *testing.T) {
+	at := time.Date(2025, 3, 4, 5, 6, 7, 123456000, time.FixedZone("x", 3600))
+	c := Encode(at, "9m4e2mr0ui3e8a215n4g")
+
+	gotAt, gotID, err := Decode(c)
+	if err != nil {
+		t.Fatalf("Decode returned error: %v", err)
+	}
+	if !gotAt.Equal(at) || gotID != "9m4e2mr0ui3e8a215n4g" {
+		t.Errorf("Expected %v/%s, got %v/%s", at, "9m4e2mr0ui3e8a215n4g", gotAt, gotID)
+	}
+}
+
+func TestDecodeRejectsGarbage(t *testing.T) {
+	for _, c := range []string{
+		"",
+		"!!!",
+		Encode(time.Time{}, "")[:4],
+		"bm90LWEtY3Vyc29y",
+		Encode(time.Now(), "not-an-xid"),
+		Encode(time.Now(), "9m4e2mr0ui3e8a215n4"),
+	} {
+		if _, _, err := Decode(c); !errors.Is(err, ErrInvalid) {
+			t.Errorf("Decode(%q) = %v, want ErrInvalid", c, err)
+		}
+	}
+}
@@ -7,19 +7,218 @@ package db
 
 import (
 	"context"
+
+	"github.com/lib/pq"
 )
 
 const addActionToConversation = `-- name: AddActionToConversation :exec
 INSERT INTO action_conversation (action_id, conversation_id)
-VALUES (x2b($1), x2b($2))
+SELECT a.id, c.id
+FROM action a
+JOIN conversation c ON c.manager_id = a.manager_id
+WHERE a.id = x2b($1)
+  AND c.id = x2b($2)
+  AND a.manager_id = x2b($3)
+  AND a.deleted_at IS NULL AND c.deleted_at IS NULL
 `
 
 type AddActionToConversationParams struct {
 	ActionID       string `db:"action_id" json:"action_id"`
 	ConversationID string `db:"conversation_id" json:"conversation_id"`
+	ManagerID      string `db:"manager_id" json:"manager_id"`
 }
 
 func (q *Queries) AddActionToConversation(ctx context.Context, arg AddActionToConversationParams) error {
-	_, err := q.db.ExecContext(ctx, addActionToConversation, arg.ActionID, arg.ConversationID)
+	_, err := q.db.ExecContext(ctx, addActionToConversation, arg.ActionID, arg.ConversationID, arg.ManagerID)
+	return err
+}
+
+const listActionsByConversationID = `-- name: ListActionsByConversationID :many
+SELECT action.id, action.person_id, action.occurred_at, action.description, action.valence, action.created_at, action.updated_at, action.manager_id, action.impact, action.deleted_at
+FROM action_conversation ac
+JOIN action ON ac.action_id = action.id
+WHERE ac.conversation_id = x2b($1) AND action.manager_id = x2b($2) AND action.deleted_at IS NULL
+ORDER BY action.occurred_at DESC
+LIMIT $4 OFFSET $3
+`
+
+type ListActionsByConversationIDParams struct {
+	ConversationID string `db:"conversation_id" json:"conversation_id"`
+	ManagerID      string `db:"manager_id" json:"manager_id"`
+	Offset         int32  `db:"offset" json:"offset"`
+	Limit          int32  `db:"limit" json:"limit"`
+}
+
+type ListActionsByConversationIDRow struct {
+	Action Action `db:"action" json:"action"`
+}
+
+func (q *Queries) ListActionsByConversationID(ctx context.Context, arg ListActionsByConversationIDParams) ([]ListActionsByConversationIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, listActionsByConversationID,
+		arg.ConversationID,
+		arg.ManagerID,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListActionsByConversationIDRow{}
+	for rows.Next() {
+		var i ListActionsByConversationIDRow
+		if err := rows.Scan(
+			&i.Action.ID,
+			&i.Action.PersonID,
+			&i.Action.OccurredAt,
+			&i.Action.Description,
+			&i.Action.Valence,
+			&i.Action.CreatedAt,
+			&i.Action.UpdatedAt,
+			&i.Action.ManagerID,
+			&i.Action.Impact,
+			&i.Action.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listActionsByConversationIDs = `-- name: ListActionsByConversationIDs :many
+SELECT b2x(ac.conversation_id) AS conversation_id, action.id, action.person_id, action.occurred_at, action.description, action.valence, action.created_at, action.updated_at, action.manager_id, action.impact, action.deleted_at
+FROM action_conversation ac
+JOIN action ON ac.action_id = action.id
+WHERE ac.conversation_id IN (SELECT x2b(id) FROM unnest($1::text[]) AS id)
+  AND action.manager_id = x2b($2) AND action.deleted_at IS NULL
+ORDER BY ac.conversation_id, action.occurred_at DESC
+`
+
+type ListActionsByConversationIDsParams struct {
+	ConversationIds []string `db:"conversation_ids" json:"conversation_ids"`
+	ManagerID       string   `db:"manager_id" json:"manager_id"`
+}
+
+type ListActionsByConversationIDsRow struct {
+	ConversationID string `db:"conversation_id" json:"conversation_id"`
+	Action         Action `db:"action" json:"action"`
+}
+
+func (q *Queries) ListActionsByConversationIDs(ctx context.Context, arg ListActionsByConversationIDsParams) ([]ListActionsByConversationIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listActionsByConversationIDs, pq.Array(arg.ConversationIds), arg.ManagerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListActionsByConversationIDsRow{}
+	for rows.Next() {
+		var i ListActionsByConversationIDsRow
+		if err := rows.Scan(
+			&i.ConversationID,
+			&i.Action.ID,
+			&i.Action.PersonID,
+			&i.Action.OccurredAt,
+			&i.Action.Description,
+			&i.Action.Valence,
+			&i.Action.CreatedAt,
+			&i.Action.UpdatedAt,
+			&i.Action.ManagerID,
+			&i.Action.Impact,
+			&i.Action.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listConversationsByActionID = `-- name: ListConversationsByActionID :many
+SELECT conversation.id, conversation.description, conversation.occurred_at, conversation.created_at, conversation.updated_at, conversation.person_id, conversation.manager_id, conversation.deleted_at
+FROM action_conversation ac
+JOIN conversation ON ac.conversation_id = conversation.id
+WHERE ac.action_id = x2b($1) AND conversation.manager_id = x2b($2) AND conversation.deleted_at IS NULL
+ORDER BY conversation.occurred_at DESC
+LIMIT $4 OFFSET $3
+`
+
+type ListConversationsByActionIDParams struct {
+	ActionID  string `db:"action_id" json:"action_id"`
+	ManagerID string `db:"manager_id" json:"manager_id"`
+	Offset    int32  `db:"offset" json:"offset"`
+	Limit     int32  `db:"limit" json:"limit"`
+}
+
+type ListConversationsByActionIDRow struct {
+	Conversation Conversation `db:"conversation" json:"conversation"`
+}
+
+func (q *Queries) ListConversationsByActionID(ctx context.Context, arg ListConversationsByActionIDParams) ([]ListConversationsByActionIDRow, error) {
+	rows, err := q.db.QueryContext(ctx, listConversationsByActionID,
+		arg.ActionID,
+		arg.ManagerID,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListConversationsByActionIDRow{}
+	for rows.Next() {
+		var i ListConversationsByActionIDRow
+		if err := rows.Scan(
+			&i.Conversation.ID,
+			&i.Conversation.Description,
+			&i.Conversation.OccurredAt,
+			&i.Conversation.CreatedAt,
+			&i.Conversation.UpdatedAt,
+			&i.Conversation.PersonID,
+			&i.Conversation.ManagerID,
+			&i.Conversation.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const removeActionFromConversation = `-- name: RemoveActionFromConversation :exec
+DELETE FROM action_conversation ac
+USING conversation c
+WHERE c.id = ac.conversation_id
+  AND ac.conversation_id = x2b($1)
+  AND ac.action_id = x2b($2)
+  AND c.manager_id = x2b($3)
+`
+
+type RemoveActionFromConversationParams struct {
+	ConversationID string `db:"conversation_id" json:"conversation_id"`
+	ActionID       string `db:"action_id" json:"action_id"`
+	ManagerID      string `db:"manager_id" json:"manager_id"`
+}
+
+func (q *Queries) RemoveActionFromConversation(ctx context.Context, arg RemoveActionFromConversationParams) error {
+	_, err := q.db.ExecContext(ctx, removeActionFromConversation, arg.ConversationID, arg.ActionID, arg.ManagerID)
 	return err
 }
@@ -7,36 +7,47 @@ package db
 
 import (
 	"context"
+	"database/sql"
 )
 
 const addThemeToAction = `-- name: AddThemeToAction :exec
 INSERT INTO action_theme (action_id, theme_id)
-VALUES (x2b($1), x2b($2))
+SELECT a.id, t.id
+FROM action a
+JOIN theme t ON t.manager_id = a.manager_id
+WHERE a.id = x2b($1)
+  AND t.id = x2b($2)
+  AND a.manager_id = x2b($3)
+  AND a.deleted_at IS NULL AND t.deleted_at IS NULL
 `
 
 type AddThemeToActionParams struct {
-	ActionID string `db:"action_id" json:"action_id"`
-	ThemeID  string `db:"theme_id" json:"theme_id"`
+	ActionID  string `db:"action_id" json:"action_id"`
+	ThemeID   string `db:"theme_id" json:"theme_id"`
+	ManagerID string `db:"manager_id" json:"manager_id"`
 }
 
 func (q *Queries) AddThemeToAction(ctx context.Context, arg AddThemeToActionParams) error {
-	_, err := q.db.ExecContext(ctx, addThemeToAction, arg.ActionID, arg.ThemeID)
+	_, err := q.db.ExecContext(ctx, addThemeToAction, arg.ActionID, arg.ThemeID, arg.ManagerID)
 	return err
 }
 
 const listActionsByThemeID = `-- name: ListActionsByThemeID :many
-SELECT action.id, action.person_id, action.occurred_at, action.description, action."references", action.valence, action.created_at, action.updated_at
+SELECT action.id, action.person_id, action.occurred_at, action.description, action.valence, action.created_at, action.updated_at, action.manager_id, action.impact, action.deleted_at
 FROM action_theme at
 JOIN action ON at.action_id = action.id
-WHERE at.theme_id = x2b($1)
+WHERE at.theme_id = x2b($1) AND action.manager_id = x2b($2) AND action.deleted_at IS NULL
+  AND ($3::text IS NULL OR action.person_id = x2b($3))
 ORDER BY action.created_at DESC
-LIMIT $3 OFFSET $2
+LIMIT $5 OFFSET $4
 `
 
 type ListActionsByThemeIDParams struct {
-	ThemeID string `db:"theme_id" json:"theme_id"`
-	Offset  int32  `db:"offset" json:"offset"`
-	Limit   int32  `db:"limit" json:"limit"`
+	ThemeID   string         `db:"theme_id" json:"theme_id"`
+	ManagerID string         `db:"manager_id" json:"manager_id"`
+	PersonID  sql.NullString `db:"person_id" json:"person_id"`
+	Offset    int32          `db:"offset" json:"offset"`
+	Limit     int32          `db:"limit" json:"limit"`
 }
 
 type ListActionsByThemeIDRow struct {
@@ -44,7 +55,13 @@ type ListActionsByThemeIDRow struct {
 }
 
 func (q *Queries) ListActionsByThemeID(ctx context.Context, arg ListActionsByThemeIDParams) ([]ListActionsByThemeIDRow, error) {
-	rows, err := q.db.QueryContext(ctx, listActionsByThemeID, arg.ThemeID, arg.Offset, arg.Limit)
+	rows, err := q.db.QueryContext(ctx, listActionsByThemeID,
+		arg.ThemeID,
+		arg.ManagerID,
+		arg.PersonID,
+		arg.Offset,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -57,10 +74,12 @@ func (q *Queries) ListActionsByThemeID(ctx context.Context, arg ListActionsByThe
 			&i.Action.PersonID,
 			&i.Action.OccurredAt,
 			&i.Action.Description,
-			&i.Action.References,
 			&i.Action.Valence,
 			&i.Action.CreatedAt,
 			&i.Action.UpdatedAt,
+			&i.Action.ManagerID,
+			&i.Action.Impact,
+			&i.Action.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -76,18 +95,19 @@ func (q *Queries) ListActionsByThemeID(ctx context.Context, arg ListActionsByThe
 }
 
 const listThemesByActionID = `-- name: ListThemesByActionID :many
-SELECT theme.id, theme.person_id, theme.text, theme.created_at, theme.updated_at
+SELECT theme.id, theme.person_id, theme.text, theme.created_at, theme.updated_at, theme.manager_id, theme.archived_at, theme.description, theme.deleted_at
 FROM action_theme at
 JOIN theme ON at.theme_id = theme.id
-WHERE at.action_id = x2b($1)
+WHERE at.action_id = x2b($1) AND theme.manager_id = x2b($2) AND theme.deleted_at IS NULL
 ORDER BY theme.created_at DESC
-LIMIT $3 OFFSET $2
+LIMIT $4 OFFSET $3
 `
 
 type ListThemesByActionIDParams struct {
-	ActionID string `db:"action_id" json:"action_id"`
-	Offset   int32  `db:"offset" json:"offset"`
-	Limit    int32  `db:"limit" json:"limit"`
+	ActionID  string `db:"action_id" json:"action_id"`
+	ManagerID string `db:"manager_id" json:"manager_id"`
+	Offset    int32  `db:"offset" json:"offset"`
+	Limit     int32  `db:"limit" json:"limit"`
 }
 
 type ListThemesByActionIDRow struct {
@@ -95,7 +115,12 @@ type ListThemesByActionIDRow struct {
 }
 
 func (q *Queries) ListThemesByActionID(ctx context.Context, arg ListThemesByActionIDParams) ([]ListThemesByActionIDRow, error) {
-	rows, err := q.db.QueryContext(ctx, listThemesByActionID, arg.ActionID, arg.Offset, arg.Limit)
+	rows, err := q.db.QueryContext(ctx, listThemesByActionID,
+		arg.ActionID,
+		arg.ManagerID,
+		arg.Offset,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -109,6 +134,10 @@ func (q *Queries) ListThemesByActionID(ctx context.Context, arg ListThemesByActi
 			&i.Theme.Text,
 			&i.Theme.CreatedAt,
 			&i.Theme.UpdatedAt,
+			&i.Theme.ManagerID,
+			&i.Theme.ArchivedAt,
+			&i.Theme.Description,
+			&i.Theme.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -123,17 +152,78 @@ func (q *Queries) ListThemesByActionID(ctx context.Context, arg ListThemesByActi
 	return items, nil
 }
 
+const moveActionThemes = `-- name: MoveActionThemes :exec
+WITH moved AS (
+    DELETE FROM action_theme at
+    USING theme t
+    WHERE at.theme_id = x2b($1)
+      AND t.id = x2b($2) AND t.manager_id = x2b($3)
+    RETURNING at.action_id, t.id AS theme_id
+)
+INSERT INTO action_theme (action_id, theme_id)
+SELECT action_id, theme_id FROM moved
+ON CONFLICT DO NOTHING
+`
+
+type MoveActionThemesParams struct {
+	SourceThemeID string `db:"source_theme_id" json:"source_theme_id"`
+	TargetThemeID string `db:"target_theme_id" json:"target_theme_id"`
+	ManagerID     string `db:"manager_id" json:"manager_id"`
+}
+
+// The source theme's links are removed as they are copied, so the source is left
+// with none. Actions already linked to the target keep their one link.
+func (q *Queries) MoveActionThemes(ctx context.Context, arg MoveActionThemesParams) error {
+	_, err := q.db.ExecContext(ctx, moveActionThemes, arg.SourceThemeID, arg.TargetThemeID, arg.ManagerID)
+	return err
+}
+
+const moveActionToTheme = `-- name: MoveActionToTheme :execrows
+UPDATE action_theme at
+SET theme_id = x2b($1)
+FROM action a
+WHERE a.id = at.action_id
+  AND at.action_id = x2b($2)
+  AND at.theme_id = x2b($3)
+  AND a.manager_id = x2b($4)
+`
+
+type MoveActionToThemeParams struct {
+	TargetThemeID string `db:"target_theme_id" json:"target_theme_id"`
+	ActionID      string `db:"action_id" json:"action_id"`
+	SourceThemeID string `db:"source_theme_id" json:"source_theme_id"`
+	ManagerID     string `db:"manager_id" json:"manager_id"`
+}
+
+func (q *Queries) MoveActionToTheme(ctx context.Context, arg MoveActionToThemeParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, moveActionToTheme,
+		arg.TargetThemeID,
+		arg.ActionID,
+		arg.SourceThemeID,
+		arg.ManagerID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const removeThemeFromAction = `-- name: RemoveThemeFromAction :exec
-DELETE FROM action_theme
-WHERE action_id = x2b($1) AND theme_id = x2b($2)
+DELETE FROM action_theme at
+USING action a
+WHERE a.id = at.action_id
+  AND at.action_id = x2b($1)
+  AND at.theme_id = x2b($2)
+  AND a.manager_id = x2b($3)
 `
 
 type RemoveThemeFromActionParams struct {
-	ActionID string `db:"action_id" json:"action_id"`
-	ThemeID  string `db:"theme_id" json:"theme_id"`
+	ActionID  string `db:"action_id" json:"action_id"`
+	ThemeID   string `db:"theme_id" json:"theme_id"`
+	ManagerID string `db:"manager_id" json:"manager_id"`
 }
 
 func (q *Queries) RemoveThemeFromAction(ctx context.Context, arg RemoveThemeFromActionParams) error {
-	_, err := q.db.ExecContext(ctx, removeThemeFromAction, arg.ActionID, arg.ThemeID)
+	_, err := q.db.ExecContext(ctx, removeThemeFromAction, arg.ActionID, arg.ThemeID, arg.ManagerID)
 	return err
 }
@@ -9,50 +9,100 @@ import (
 	"context"
 	"database/sql"
 	"time"
+
+	"github.com/lib/pq"
 )
 
-const countActions = `-- name: CountActions :one
-SELECT COUNT(*) FROM action
+const countActionsByPersonID = `-- name: CountActionsByPersonID :one
+SELECT COUNT(*) FROM action WHERE person_id = x2b($1) AND manager_id = x2b($2) AND deleted_at IS NULL
 `
 
-func (q *Queries) CountActions(ctx context.Context) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countActions)
+type CountActionsByPersonIDParams struct {
+	PersonID  string `db:"person_id" json:"person_id"`
+	ManagerID string `db:"manager_id" json:"manager_id"`
+}
+
+func (q *Queries) CountActionsByPersonID(ctx context.Context, arg CountActionsByPersonIDParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countActionsByPersonID, arg.PersonID, arg.ManagerID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
-const countActionsByPersonID = `-- name: CountActionsByPersonID :one
-SELECT COUNT(*) FROM action WHERE person_id = x2b($1)
+const countActionsFiltered = `-- name: CountActionsFiltered :one
+SELECT COUNT(*)
+FROM action
+WHERE action.manager_id = x2b($1)
+  AND action.deleted_at IS NULL
+  AND ($2::TEXT IS NULL OR action.person_id = x2b($2))
+  AND ($3::valence_type IS NULL OR action.valence = $3)
+  AND ($4::TEXT IS NULL OR EXISTS (
+        SELECT 1 FROM action_theme atm
+        WHERE atm.action_id = action.id AND atm.theme_id = x2b($4)))
+  AND ($5::TIMESTAMPTZ IS NULL OR action.occurred_at >= $5)
+  AND ($6::TIMESTAMPTZ IS NULL OR action.occurred_at < $6)
+  AND ($7::TEXT IS NULL
+       OR to_tsvector('english', action.description) @@ websearch_to_tsquery('english', $7)
+       OR EXISTS (
+            SELECT 1 FROM action_reference ar
+            WHERE ar.action_id = action.id
+              AND to_tsvector('english', COALESCE(ar.title, '') || ' ' || ar.value) @@ websearch_to_tsquery('english', $7)))
+  AND ($8::BOOLEAN IS NULL
+       OR EXISTS (
+            SELECT 1 FROM action_conversation ac
+            JOIN conversation c ON c.id = ac.conversation_id
+            WHERE ac.action_id = action.id AND c.deleted_at IS NULL) = $8)
 `
 
-func (q *Queries) CountActionsByPersonID(ctx context.Context, personID string) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countActionsByPersonID, personID)
+type CountActionsFilteredParams struct {
+	ManagerID string          `db:"manager_id" json:"manager_id"`
+	PersonID  sql.NullString  `db:"person_id" json:"person_id"`
+	Valence   NullValenceType `db:"valence" json:"valence"`
+	ThemeID   sql.NullString  `db:"theme_id" json:"theme_id"`
+	Since     sql.NullTime    `db:"since" json:"since"`
+	Before    sql.NullTime    `db:"before" json:"before"`
+	Query     sql.NullString  `db:"query" json:"query"`
+	Discussed sql.NullBool    `db:"discussed" json:"discussed"`
+}
+
+func (q *Queries) CountActionsFiltered(ctx context.Context, arg CountActionsFilteredParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countActionsFiltered,
+		arg.ManagerID,
+		arg.PersonID,
+		arg.Valence,
+		arg.ThemeID,
+		arg.Since,
+		arg.Before,
+		arg.Query,
+		arg.Discussed,
+	)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
 const createAction = `-- name: CreateAction :one
-INSERT INTO action (id, person_id, occurred_at, description, "references", valence)
+INSERT INTO action (id, person_id, occurred_at, description, valence, impact, manager_id)
 VALUES (
     x2b($1),
     x2b($2),
     $3,
     $4,
     $5,
-    $6
+    $6,
+    x2b($7)
 )
-RETURNING action.id, action.person_id, action.occurred_at, action.description, action."references", action.valence, action.created_at, action.updated_at
+RETURNING action.id, action.person_id, action.occurred_at, action.description, action.valence, action.created_at, action.updated_at, action.manager_id, action.impact, action.deleted_at
 `
 
 type CreateActionParams struct {
-	ID          string         `db:"id" json:"id"`
-	PersonID    string         `db:"person_id" json:"person_id"`
-	OccurredAt  time.Time      `db:"occurred_at" json:"occurred_at"`
-	Description string         `db:"description" json:"description"`
-	References  sql.NullString `db:"references" json:"references"`
-	Valence     ValenceType    `db:"valence" json:"valence"`
+	ID          string          `db:"id" json:"id"`
+	PersonID    string          `db:"person_id" json:"person_id"`
+	OccurredAt  time.Time       `db:"occurred_at" json:"occurred_at"`
+	Description string          `db:"description" json:"description"`
+	Valence     ValenceType     `db:"valence" json:"valence"`
+	Impact      NullImpactLevel `db:"impact" json:"impact"`
+	ManagerID   string          `db:"manager_id" json:"manager_id"`
 }
 
 type CreateActionRow struct {
@@ -65,8 +115,9 @@ func (q *Queries) CreateAction(ctx context.Context, arg CreateActionParams) (Cre
 		arg.PersonID,
 		arg.OccurredAt,
 		arg.Description,
-		arg.References,
 		arg.Valence,
+		arg.Impact,
+		arg.ManagerID,
 	)
 	var i CreateActionRow
 	err := row.Scan(
@@ -74,106 +125,49 @@ func (q *Queries) CreateAction(ctx context.Context, arg CreateActionParams) (Cre
 		&i.Action.PersonID,
 		&i.Action.OccurredAt,
 		&i.Action.Description,
-		&i.Action.References,
 		&i.Action.Valence,
 		&i.Action.CreatedAt,
 		&i.Action.UpdatedAt,
+		&i.Action.ManagerID,
+		&i.Action.Impact,
+		&i.Action.DeletedAt,
 	)
 	return i, err
 }
 
-const deleteAction = `-- name: DeleteAction :exec
-DELETE FROM action
-WHERE id = x2b($1)
-`
-
-func (q *Queries) DeleteAction(ctx context.Context, id string) error {
-	_, err := q.db.ExecContext(ctx, deleteAction, id)
-	return err
-}
-
 const getActionByID = `-- name: GetActionByID :one
-SELECT action.id, action.person_id, action.occurred_at, action.description, action."references", action.valence, action.created_at, action.updated_at
+SELECT action.id, action.person_id, action.occurred_at, action.description, action.valence, action.created_at, action.updated_at, action.manager_id, action.impact, action.deleted_at
 FROM action
-WHERE id = x2b($1)
+WHERE id = x2b($1) AND manager_id = x2b($2) AND deleted_at IS NULL
 `
 
+type GetActionByIDParams struct {
+	ID        string `db:"id" json:"id"`
+	ManagerID string `db:"manager_id" json:"manager_id"`
+}
+
 type GetActionByIDRow struct {
 	Action Action `db:"action" json:"action"`
 }
 
-func (q *Queries) GetActionByID(ctx context.Context, id string) (GetActionByIDRow, error) {
-	row := q.db.QueryRowContext(ctx, getActionByID, id)
+func (q *Queries) GetActionByID(ctx context.Context, arg GetActionByIDParams) (GetActionByIDRow, error) {
+	row := q.db.QueryRowContext(ctx, getActionByID, arg.ID, arg.ManagerID)
 	var i GetActionByIDRow
 	err := row.Scan(
 		&i.Action.ID,
 		&i.Action.PersonID,
 		&i.Action.OccurredAt,
 		&i.Action.Description,
-		&i.Action.References,
 		&i.Action.Valence,
 		&i.Action.CreatedAt,
 		&i.Action.UpdatedAt,
+		&i.Action.ManagerID,
+		&i.Action.Impact,
+		&i.Action.DeletedAt,
 	)
 	return i, err
 }
 
-const getActionsByDateRange = `-- name: GetActionsByDateRange :many
-SELECT action.id, action.person_id, action.occurred_at, action.description, action."references", action.valence, action.created_at, action.updated_at
-FROM action
-WHERE occurred_at >= $1 AND occurred_at <= $2
-ORDER BY occurred_at DESC
-LIMIT $4 OFFSET $3
-`
-
-type GetActionsByDateRangeParams struct {
-	StartTime time.Time `db:"start_time" json:"start_time"`
-	EndTime   time.Time `db:"end_time" json:"end_time"`
-	Offset    int32     `db:"offset" json:"offset"`
-	Limit     int32     `db:"limit" json:"limit"`
-}
-
-type GetActionsByDateRangeRow struct {
-	Action Action `db:"action" json:"action"`
-}
-
-func (q *Queries) GetActionsByDateRange(ctx context.Context, arg GetActionsByDateRangeParams) ([]GetActionsByDateRangeRow, error) {
-	rows, err := q.db.QueryContext(ctx, getActionsByDateRange,
-		arg.StartTime,
-		arg.EndTime,
-		arg.Offset,
-		arg.Limit,
-	)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []GetActionsByDateRangeRow{}
-	for rows.Next() {
-		var i GetActionsByDateRangeRow
-		if err := rows.Scan(
-			&i.Action.ID,
-			&i.Action.PersonID,
-			&i.Action.OccurredAt,
-			&i.Action.Description,
-			&i.Action.References,
-			&i.Action.Valence,
-			&i.Action.CreatedAt,
-			&i.Action.UpdatedAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const getActionsWithPersonDetails = `-- name: GetActionsWithPersonDetails :many
 SELECT
     b2x(a.id) as action_id,
@@ -181,35 +175,35 @@ SELECT
     p.name as person_name,
     a.occurred_at,
     a.description,
-    a."references",
     a.valence,
     a.created_at,
     a.updated_at
 FROM action a
 JOIN person p ON a.person_id = p.id
+WHERE a.manager_id = x2b($1) AND a.deleted_at IS NULL
 ORDER BY a.occurred_at DESC
-LIMIT $2 OFFSET $1
+LIMIT $3 OFFSET $2
 `
 
 type GetActionsWithPersonDetailsParams struct {
-	Offset int32 `db:"offset" json:"offset"`
-	Limit  int32 `db:"limit" json:"limit"`
+	ManagerID string `db:"manager_id" json:"manager_id"`
+	Offset    int32  `db:"offset" json:"offset"`
+	Limit     int32  `db:"limit" json:"limit"`
 }
 
 type GetActionsWithPersonDetailsRow struct {
-	ActionID    string         `db:"action_id" json:"action_id"`
-	PersonID    string         `db:"person_id" json:"person_id"`
-	PersonName  string         `db:"person_name" json:"person_name"`
-	OccurredAt  time.Time      `db:"occurred_at" json:"occurred_at"`
-	Description string         `db:"description" json:"description"`
-	References  sql.NullString `db:"references" json:"references"`
-	Valence     ValenceType    `db:"valence" json:"valence"`
-	CreatedAt   time.Time      `db:"created_at" json:"created_at"`
-	UpdatedAt   time.Time      `db:"updated_at" json:"updated_at"`
+	ActionID    string      `db:"action_id" json:"action_id"`
+	PersonID    string      `db:"person_id" json:"person_id"`
+	PersonName  string      `db:"person_name" json:"person_name"`
+	OccurredAt  time.Time   `db:"occurred_at" json:"occurred_at"`
+	Description string      `db:"description" json:"description"`
+	Valence     ValenceType `db:"valence" json:"valence"`
+	CreatedAt   time.Time   `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time   `db:"updated_at" json:"updated_at"`
 }
 
 func (q *Queries) GetActionsWithPersonDetails(ctx context.Context, arg GetActionsWithPersonDetailsParams) ([]GetActionsWithPersonDetailsRow, error) {
-	rows, err := q.db.QueryContext(ctx, getActionsWithPersonDetails, arg.Offset, arg.Limit)
+	rows, err := q.db.QueryContext(ctx, getActionsWithPersonDetails, arg.ManagerID, arg.Offset, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -223,7 +217,6 @@ func (q *Queries) GetActionsWithPersonDetails(ctx context.Context, arg GetAction
 			&i.PersonName,
 			&i.OccurredAt,
 			&i.Description,
-			&i.References,
 			&i.Valence,
 			&i.CreatedAt,
 			&i.UpdatedAt,
@@ -242,18 +235,19 @@ func (q *Queries) GetActionsWithPersonDetails(ctx context.Context, arg GetAction
 }
 
 const getRecentActionsByPersonID = `-- name: GetRecentActionsByPersonID :many
-SELECT action.id, action.person_id, action.occurred_at, action.description, action."references", action.valence, action.created_at, action.updated_at
+SELECT action.id, action.person_id, action.occurred_at, action.description, action.valence, action.created_at, action.updated_at, action.manager_id, action.impact, action.deleted_at
 FROM action
-WHERE person_id = x2b($1) AND occurred_at >= $2
+WHERE person_id = x2b($1) AND occurred_at >= $2 AND manager_id = x2b($3) AND deleted_at IS NULL
 ORDER BY occurred_at DESC
-LIMIT $4 OFFSET $3
+LIMIT $5 OFFSET $4
 `
 
 type GetRecentActionsByPersonIDParams struct {
-	PersonID string    `db:"person_id" json:"person_id"`
-	Since    time.Time `db:"since" json:"since"`
-	Offset   int32     `db:"offset" json:"offset"`
-	Limit    int32     `db:"limit" json:"limit"`
+	PersonID  string    `db:"person_id" json:"person_id"`
+	Since     time.Time `db:"since" json:"since"`
+	ManagerID string    `db:"manager_id" json:"manager_id"`
+	Offset    int32     `db:"offset" json:"offset"`
+	Limit     int32     `db:"limit" json:"limit"`
 }
 
 type GetRecentActionsByPersonIDRow struct {
@@ -264,6 +258,7 @@ func (q *Queries) GetRecentActionsByPersonID(ctx context.Context, arg GetRecentA
 	rows, err := q.db.QueryContext(ctx, getRecentActionsByPersonID,
 		arg.PersonID,
 		arg.Since,
+		arg.ManagerID,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -279,61 +274,12 @@ func (q *Queries) GetRecentActionsByPersonID(ctx context.Context, arg GetRecentA
 			&i.Action.PersonID,
 			&i.Action.OccurredAt,
 			&i.Action.Description,
-			&i.Action.References,
 			&i.Action.Valence,
 			&i.Action.CreatedAt,
 			&i.Action.UpdatedAt,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
-const listActions = `-- name: ListActions :many
-SELECT action.id, action.person_id, action.occurred_at, action.description, action."references", action.valence, action.created_at, action.updated_at, person.name as person_name
-FROM action
-JOIN person ON action.person_id = person.id
-ORDER BY occurred_at DESC
-LIMIT $2 OFFSET $1
-`
-
-type ListActionsParams struct {
-	Offset int32 `db:"offset" json:"offset"`
-	Limit  int32 `db:"limit" json:"limit"`
-}
-
-type ListActionsRow struct {
-	Action     Action `db:"action" json:"action"`
-	PersonName string `db:"person_name" json:"person_name"`
-}
-
-func (q *Queries) ListActions(ctx context.Context, arg ListActionsParams) ([]ListActionsRow, error) {
-	rows, err := q.db.QueryContext(ctx, listActions, arg.Offset, arg.Limit)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	items := []ListActionsRow{}
-	for rows.Next() {
-		var i ListActionsRow
-		if err := rows.Scan(
-			&i.Action.ID,
-			&i.Action.PersonID,
-			&i.Action.OccurredAt,
-			&i.Action.Description,
-			&i.Action.References,
-			&i.Action.Valence,
-			&i.Action.CreatedAt,
-			&i.Action.UpdatedAt,
-			&i.PersonName,
+			&i.Action.ManagerID,
+			&i.Action.Impact,
+			&i.Action.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -349,17 +295,18 @@ func (q *Queries) ListActions(ctx context.Context, arg ListActionsParams) ([]Lis
 }
 
 const listActionsByPersonID = `-- name: ListActionsByPersonID :many
-SELECT action.id, action.person_id, action.occurred_at, action.description, action."references", action.valence, action.created_at, action.updated_at
+SELECT action.id, action.person_id, action.occurred_at, action.description, action.valence, action.created_at, action.updated_at, action.manager_id, action.impact, action.deleted_at
 FROM action
-WHERE person_id = x2b($1)
+WHERE person_id = x2b($1) AND manager_id = x2b($2) AND deleted_at IS NULL
 ORDER BY occurred_at DESC
-LIMIT $3 OFFSET $2
+LIMIT $4 OFFSET $3
 `
 
 type ListActionsByPersonIDParams struct {
-	PersonID string `db:"person_id" json:"person_id"`
-	Offset   int32  `db:"offset" json:"offset"`
-	Limit    int32  `db:"limit" json:"limit"`
+	PersonID  string `db:"person_id" json:"person_id"`
+	ManagerID string `db:"manager_id" json:"manager_id"`
+	Offset    int32  `db:"offset" json:"offset"`
+	Limit     int32  `db:"limit" json:"limit"`
 }
 
 type ListActionsByPersonIDRow struct {
@@ -367,7 +314,12 @@ type ListActionsByPersonIDRow struct {
 }
 
 func (q *Queries) ListActionsByPersonID(ctx context.Context, arg ListActionsByPersonIDParams) ([]ListActionsByPersonIDRow, error) {
-	rows, err := q.db.QueryContext(ctx, listActionsByPersonID, arg.PersonID, arg.Offset, arg.Limit)
+	rows, err := q.db.QueryContext(ctx, listActionsByPersonID,
+		arg.PersonID,
+		arg.ManagerID,
+		arg.Offset,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -380,10 +332,12 @@ func (q *Queries) ListActionsByPersonID(ctx context.Context, arg ListActionsByPe
 			&i.Action.PersonID,
 			&i.Action.OccurredAt,
 			&i.Action.Description,
-			&i.Action.References,
 			&i.Action.Valence,
 			&i.Action.CreatedAt,
 			&i.Action.UpdatedAt,
+			&i.Action.ManagerID,
+			&i.Action.Impact,
+			&i.Action.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -399,18 +353,19 @@ func (q *Queries) ListActionsByPersonID(ctx context.Context, arg ListActionsByPe
 }
 
 const listActionsByPersonIDAndValence = `-- name: ListActionsByPersonIDAndValence :many
-SELECT action.id, action.person_id, action.occurred_at, action.description, action."references", action.valence, action.created_at, action.updated_at
+SELECT action.id, action.person_id, action.occurred_at, action.description, action.valence, action.created_at, action.updated_at, action.manager_id, action.impact, action.deleted_at
 FROM action
-WHERE person_id = x2b($1) AND valence = $2
+WHERE person_id = x2b($1) AND valence = $2 AND manager_id = x2b($3) AND deleted_at IS NULL
 ORDER BY occurred_at DESC
-LIMIT $4 OFFSET $3
+LIMIT $5 OFFSET $4
 `
 
 type ListActionsByPersonIDAndValenceParams struct {
-	PersonID string      `db:"person_id" json:"person_id"`
-	Valence  ValenceType `db:"valence" json:"valence"`
-	Offset   int32       `db:"offset" json:"offset"`
-	Limit    int32       `db:"limit" json:"limit"`
+	PersonID  string      `db:"person_id" json:"person_id"`
+	Valence   ValenceType `db:"valence" json:"valence"`
+	ManagerID string      `db:"manager_id" json:"manager_id"`
+	Offset    int32       `db:"offset" json:"offset"`
+	Limit     int32       `db:"limit" json:"limit"`
 }
 
 type ListActionsByPersonIDAndValenceRow struct {
@@ -421,6 +376,7 @@ func (q *Queries) ListActionsByPersonIDAndValence(ctx context.Context, arg ListA
 	rows, err := q.db.QueryContext(ctx, listActionsByPersonIDAndValence,
 		arg.PersonID,
 		arg.Valence,
+		arg.ManagerID,
 		arg.Offset,
 		arg.Limit,
 	)
@@ -436,10 +392,12 @@ func (q *Queries) ListActionsByPersonIDAndValence(ctx context.Context, arg ListA
 			&i.Action.PersonID,
 			&i.Action.OccurredAt,
 			&i.Action.Description,
-			&i.Action.References,
 			&i.Action.Valence,
 			&i.Action.CreatedAt,
 			&i.Action.UpdatedAt,
+			&i.Action.ManagerID,
+			&i.Action.Impact,
+			&i.Action.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -454,42 +412,106 @@ func (q *Queries) ListActionsByPersonIDAndValence(ctx context.Context, arg ListA
 	return items, nil
 }
 
-const listActionsByValence = `-- name: ListActionsByValence :many
-SELECT action.id, action.person_id, action.occurred_at, action.description, action."references", action.valence, action.created_at, action.updated_at
+const listActionsFiltered = `-- name: ListActionsFiltered :many
+SELECT action.id, action.person_id, action.occurred_at, action.description, action.valence, action.created_at, action.updated_at, action.manager_id, action.impact, action.deleted_at, p.name AS person_name
 FROM action
-WHERE valence = $1
-ORDER BY occurred_at DESC
-LIMIT $3 OFFSET $2
+JOIN person p ON p.id = action.person_id
+WHERE action.manager_id = x2b($1)
+  AND action.deleted_at IS NULL
+  AND ($2::TEXT IS NULL OR action.person_id = x2b($2))
+  AND ($3::valence_type IS NULL OR action.valence = $3)
+  AND ($4::TEXT IS NULL OR EXISTS (
+        SELECT 1 FROM action_theme atm
+        WHERE atm.action_id = action.id AND atm.theme_id = x2b($4)))
+  AND ($5::TIMESTAMPTZ IS NULL OR action.occurred_at >= $5)
+  AND ($6::TIMESTAMPTZ IS NULL OR action.occurred_at < $6)
+  AND ($7::TEXT IS NULL
+       OR to_tsvector('english', action.description) @@ websearch_to_tsquery('english', $7)
+       OR EXISTS (
+            SELECT 1 FROM action_reference ar
+            WHERE ar.action_id = action.id
+              AND to_tsvector('english', COALESCE(ar.title, '') || ' ' || ar.value) @@ websearch_to_tsquery('english', $7)))
+  AND ($8::BOOLEAN IS NULL
+       OR EXISTS (
+            SELECT 1 FROM action_conversation ac
+            JOIN conversation c ON c.id = ac.conversation_id
+            WHERE ac.action_id = action.id AND c.deleted_at IS NULL) = $8)
+  AND ($9::TIMESTAMPTZ IS NULL OR CASE $10::TEXT
+        WHEN 'occurred_at_asc' THEN (action.occurred_at, action.id) > ($9, x2b($11))
+        WHEN 'created_at_desc' THEN (action.created_at, action.id) < ($9, x2b($11))
+        WHEN 'created_at_asc' THEN (action.created_at, action.id) > ($9, x2b($11))
+        ELSE (action.occurred_at, action.id) < ($9, x2b($11))
+      END)
+ORDER BY
+    CASE WHEN $10::TEXT = 'occurred_at_asc' THEN action.occurred_at END ASC,
+    CASE WHEN $10::TEXT = 'occurred_at_asc' THEN action.id END ASC,
+    CASE WHEN $10::TEXT = 'created_at_desc' THEN action.created_at END DESC,
+    CASE WHEN $10::TEXT = 'created_at_desc' THEN action.id END DESC,
+    CASE WHEN $10::TEXT = 'created_at_asc' THEN action.created_at END ASC,
+    CASE WHEN $10::TEXT = 'created_at_asc' THEN action.id END ASC,
+    action.occurred_at DESC,
+    action.id DESC
+LIMIT $13 OFFSET $12
 `
 
-type ListActionsByValenceParams struct {
-	Valence ValenceType `db:"valence" json:"valence"`
-	Offset  int32       `db:"offset" json:"offset"`
-	Limit   int32       `db:"limit" json:"limit"`
-}
-
-type ListActionsByValenceRow struct {
-	Action Action `db:"action" json:"action"`
+type ListActionsFilteredParams struct {
+	ManagerID string          `db:"manager_id" json:"manager_id"`
+	PersonID  sql.NullString  `db:"person_id" json:"person_id"`
+	Valence   NullValenceType `db:"valence" json:"valence"`
+	ThemeID   sql.NullString  `db:"theme_id" json:"theme_id"`
+	Since     sql.NullTime    `db:"since" json:"since"`
+	Before    sql.NullTime    `db:"before" json:"before"`
+	Query     sql.NullString  `db:"query" json:"query"`
+	Discussed sql.NullBool    `db:"discussed" json:"discussed"`
+	CursorAt  sql.NullTime    `db:"cursor_at" json:"cursor_at"`
+	Sort      string          `db:"sort" json:"sort"`
+	CursorID  sql.NullString  `db:"cursor_id" json:"cursor_id"`
+	Offset    int32           `db:"offset" json:"offset"`
+	Limit     int32           `db:"limit" json:"limit"`
+}
+
+type ListActionsFilteredRow struct {
+	Action     Action `db:"action" json:"action"`
+	PersonName string `db:"person_name" json:"person_name"`
 }
 
-func (q *Queries) ListActionsByValence(ctx context.Context, arg ListActionsByValenceParams) ([]ListActionsByValenceRow, error) {
-	rows, err := q.db.QueryContext(ctx, listActionsByValence, arg.Valence, arg.Offset, arg.Limit)
+// Every filter is optional; the WHERE clause must stay in step with CountActionsFiltered.
+// The cursor is the sort column and ID of the last row already returned.
+func (q *Queries) ListActionsFiltered(ctx context.Context, arg ListActionsFilteredParams) ([]ListActionsFilteredRow, error) {
+	rows, err := q.db.QueryContext(ctx, listActionsFiltered,
+		arg.ManagerID,
+		arg.PersonID,
+		arg.Valence,
+		arg.ThemeID,
+		arg.Since,
+		arg.Before,
+		arg.Query,
+		arg.Discussed,
+		arg.CursorAt,
+		arg.Sort,
+		arg.CursorID,
+		arg.Offset,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []ListActionsByValenceRow{}
+	items := []ListActionsFilteredRow{}
 	for rows.Next() {
-		var i ListActionsByValenceRow
+		var i ListActionsFilteredRow
 		if err := rows.Scan(
 			&i.Action.ID,
 			&i.Action.PersonID,
 			&i.Action.OccurredAt,
 			&i.Action.Description,
-			&i.Action.References,
 			&i.Action.Valence,
 			&i.Action.CreatedAt,
 			&i.Action.UpdatedAt,
+			&i.Action.ManagerID,
+			&i.Action.Impact,
+			&i.Action.DeletedAt,
+			&i.PersonName,
 		); err != nil {
 			return nil, err
 		}
@@ -504,42 +526,68 @@ func (q *Queries) ListActionsByValence(ctx context.Context, arg ListActionsByVal
 	return items, nil
 }
 
-const searchActionsByDescription = `-- name: SearchActionsByDescription :many
-SELECT action.id, action.person_id, action.occurred_at, action.description, action."references", action.valence, action.created_at, action.updated_at
-FROM action
-WHERE description ILIKE '%' || $1 || '%'
-ORDER BY occurred_at DESC
-LIMIT $3 OFFSET $2
+const listAgendaActions = `-- name: ListAgendaActions :many
+SELECT a.id, a.person_id, a.occurred_at, a.description, a.valence, a.created_at, a.updated_at, a.manager_id, a.impact, a.deleted_at,
+       COALESCE(t.ids, '{}')::TEXT[] AS theme_ids,
+       COALESCE(t.texts, '{}')::TEXT[] AS theme_texts
+FROM action a
+LEFT JOIN LATERAL (
+    SELECT ARRAY_AGG(b2x(th.id) ORDER BY th.created_at DESC) AS ids,
+           ARRAY_AGG(th.text ORDER BY th.created_at DESC) AS texts
+    FROM action_theme at
+    JOIN theme th ON th.id = at.theme_id
+    WHERE at.action_id = a.id AND th.manager_id = a.manager_id AND th.deleted_at IS NULL
+) t ON TRUE
+WHERE a.person_id = x2b($1)
+  AND a.manager_id = x2b($2)
+  AND a.deleted_at IS NULL
+  AND a.occurred_at > COALESCE(
+      (SELECT MAX(c.occurred_at) FROM conversation c WHERE c.person_id = a.person_id AND c.deleted_at IS NULL),
+      '-infinity'::timestamptz
+  )
+  AND NOT EXISTS (
+      SELECT 1 FROM action_conversation ac
+      JOIN conversation c ON c.id = ac.conversation_id
+      WHERE ac.action_id = a.id AND c.deleted_at IS NULL
+  )
+ORDER BY a.occurred_at DESC
 `
 
-type SearchActionsByDescriptionParams struct {
-	Search sql.NullString `db:"search" json:"search"`
-	Offset int32          `db:"offset" json:"offset"`
-	Limit  int32          `db:"limit" json:"limit"`
+type ListAgendaActionsParams struct {
+	PersonID  string `db:"person_id" json:"person_id"`
+	ManagerID string `db:"manager_id" json:"manager_id"`
 }
 
-type SearchActionsByDescriptionRow struct {
-	Action Action `db:"action" json:"action"`
+type ListAgendaActionsRow struct {
+	Action     Action   `db:"action" json:"action"`
+	ThemeIds   []string `db:"theme_ids" json:"theme_ids"`
+	ThemeTexts []string `db:"theme_texts" json:"theme_texts"`
 }
 
-func (q *Queries) SearchActionsByDescription(ctx context.Context, arg SearchActionsByDescriptionParams) ([]SearchActionsByDescriptionRow, error) {
-	rows, err := q.db.QueryContext(ctx, searchActionsByDescription, arg.Search, arg.Offset, arg.Limit)
+// Each action's themes come back as parallel arrays, newest theme first, so the
+// agenda can group actions without a query per action.
+func (q *Queries) ListAgendaActions(ctx context.Context, arg ListAgendaActionsParams) ([]ListAgendaActionsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listAgendaActions, arg.PersonID, arg.ManagerID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	items := []SearchActionsByDescriptionRow{}
+	items := []ListAgendaActionsRow{}
 	for rows.Next() {
-		var i SearchActionsByDescriptionRow
+		var i ListAgendaActionsRow
 		if err := rows.Scan(
 			&i.Action.ID,
 			&i.Action.PersonID,
 			&i.Action.OccurredAt,
 			&i.Action.Description,
-			&i.Action.References,
 			&i.Action.Valence,
 			&i.Action.CreatedAt,
 			&i.Action.UpdatedAt,
+			&i.Action.ManagerID,
+			&i.Action.Impact,
+			&i.Action.DeletedAt,
+			pq.Array(&i.ThemeIds),
+			pq.Array(&i.ThemeTexts),
 		); err != nil {
 			return nil, err
 		}
@@ -559,20 +607,21 @@ UPDATE action
 SET person_id = x2b($1),
     occurred_at = $2,
     description = $3,
-    "references" = $4,
-    valence = $5,
+    valence = $4,
+    impact = $5,
     updated_at = NOW()
-WHERE id = x2b($6)
-RETURNING action.id, action.person_id, action.occurred_at, action.description, action."references", action.valence, action.created_at, action.updated_at
+WHERE id = x2b($6) AND manager_id = x2b($7) AND deleted_at IS NULL
+RETURNING action.id, action.person_id, action.occurred_at, action.description, action.valence, action.created_at, action.updated_at, action.manager_id, action.impact, action.deleted_at
 `
 
 type UpdateActionParams struct {
-	PersonID    string         `db:"person_id" json:"person_id"`
-	OccurredAt  time.Time      `db:"occurred_at" json:"occurred_at"`
-	Description string         `db:"description" json:"description"`
-	References  sql.NullString `db:"references" json:"references"`
-	Valence     ValenceType    `db:"valence" json:"valence"`
-	ID          string         `db:"id" json:"id"`
+	PersonID    string          `db:"person_id" json:"person_id"`
+	OccurredAt  time.Time       `db:"occurred_at" json:"occurred_at"`
+	Description string          `db:"description" json:"description"`
+	Valence     ValenceType     `db:"valence" json:"valence"`
+	Impact      NullImpactLevel `db:"impact" json:"impact"`
+	ID          string          `db:"id" json:"id"`
+	ManagerID   string          `db:"manager_id" json:"manager_id"`
 }
 
 type UpdateActionRow struct {
@@ -584,9 +633,10 @@ func (q *Queries) UpdateAction(ctx context.Context, arg UpdateActionParams) (Upd
 		arg.PersonID,
 		arg.OccurredAt,
 		arg.Description,
-		arg.References,
 		arg.Valence,
+		arg.Impact,
 		arg.ID,
+		arg.ManagerID,
 	)
 	var i UpdateActionRow
 	err := row.Scan(
@@ -594,10 +644,12 @@ func (q *Queries) UpdateAction(ctx context.Context, arg UpdateActionParams) (Upd
 		&i.Action.PersonID,
 		&i.Action.OccurredAt,
 		&i.Action.Description,
-		&i.Action.References,
 		&i.Action.Valence,
 		&i.Action.CreatedAt,
 		&i.Action.UpdatedAt,
+		&i.Action.ManagerID,
+		&i.Action.Impact,
+		&i.Action.DeletedAt,
 	)
 	return i, err
 }
@@ -7,34 +7,44 @@ package db
 
 import (
 	"context"
+
+	"github.com/lib/pq"
 )
 
 const addThemeToConversation = `-- name: AddThemeToConversation :exec
 INSERT INTO conversation_theme (conversation_id, theme_id)
-VALUES (x2b($1), x2b($2))
+SELECT c.id, t.id
+FROM conversation c
+JOIN theme t ON t.manager_id = c.manager_id
+WHERE c.id = x2b($1)
+  AND t.id = x2b($2)
+  AND c.manager_id = x2b($3)
+  AND c.deleted_at IS NULL AND t.deleted_at IS NULL
 `
 
 type AddThemeToConversationParams struct {
 	ConversationID string `db:"conversation_id" json:"conversation_id"`
 	ThemeID        string `db:"theme_id" json:"theme_id"`
+	ManagerID      string `db:"manager_id" json:"manager_id"`
 }
 
 func (q *Queries) AddThemeToConversation(ctx context.Context, arg AddThemeToConversationParams) error {
-	_, err := q.db.ExecContext(ctx, addThemeToConversation, arg.ConversationID, arg.ThemeID)
+	_, err := q.db.ExecContext(ctx, addThemeToConversation, arg.ConversationID, arg.ThemeID, arg.ManagerID)
 	return err
 }
 
 const listThemesByConversationID = `-- name: ListThemesByConversationID :many
-SELECT theme.id, theme.person_id, theme.text, theme.created_at, theme.updated_at
+SELECT theme.id, theme.person_id, theme.text, theme.created_at, theme.updated_at, theme.manager_id, theme.archived_at, theme.description, theme.deleted_at
 FROM conversation_theme ct
 JOIN theme ON ct.theme_id = theme.id
-WHERE ct.conversation_id = x2b($1)
+WHERE ct.conversation_id = x2b($1) AND theme.manager_id = x2b($2) AND theme.deleted_at IS NULL
 ORDER BY theme.created_at DESC
-LIMIT $3 OFFSET $2
+LIMIT $4 OFFSET $3
 `
 
 type ListThemesByConversationIDParams struct {
 	ConversationID string `db:"conversation_id" json:"conversation_id"`
+	ManagerID      string `db:"manager_id" json:"manager_id"`
 	Offset         int32  `db:"offset" json:"offset"`
 	Limit          int32  `db:"limit" json:"limit"`
 }
@@ -44,7 +54,12 @@ type ListThemesByConversationIDRow struct {
 }
 
 func (q *Queries) ListThemesByConversationID(ctx context.Context, arg ListThemesByConversationIDParams) ([]ListThemesByConversationIDRow, error) {
-	rows, err := q.db.QueryContext(ctx, listThemesByConversationID, arg.ConversationID, arg.Offset, arg.Limit)
+	rows, err := q.db.QueryContext(ctx, listThemesByConversationID,
+		arg.ConversationID,
+		arg.ManagerID,
+		arg.Offset,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -58,6 +73,10 @@ func (q *Queries) ListThemesByConversationID(ctx context.Context, arg ListThemes
 			&i.Theme.Text,
 			&i.Theme.CreatedAt,
 			&i.Theme.UpdatedAt,
+			&i.Theme.ManagerID,
+			&i.Theme.ArchivedAt,
+			&i.Theme.Description,
+			&i.Theme.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -71,3 +90,101 @@ func (q *Queries) ListThemesByConversationID(ctx context.Context, arg ListThemes
 	}
 	return items, nil
 }
+
+const listThemesByConversationIDs = `-- name: ListThemesByConversationIDs :many
+SELECT b2x(ct.conversation_id) AS conversation_id, theme.id, theme.person_id, theme.text, theme.created_at, theme.updated_at, theme.manager_id, theme.archived_at, theme.description, theme.deleted_at
+FROM conversation_theme ct
+JOIN theme ON ct.theme_id = theme.id
+WHERE ct.conversation_id IN (SELECT x2b(id) FROM unnest($1::text[]) AS id)
+  AND theme.manager_id = x2b($2) AND theme.deleted_at IS NULL
+ORDER BY ct.conversation_id, theme.created_at DESC
+`
+
+type ListThemesByConversationIDsParams struct {
+	ConversationIds []string `db:"conversation_ids" json:"conversation_ids"`
+	ManagerID       string   `db:"manager_id" json:"manager_id"`
+}
+
+type ListThemesByConversationIDsRow struct {
+	ConversationID string `db:"conversation_id" json:"conversation_id"`
+	Theme          Theme  `db:"theme" json:"theme"`
+}
+
+func (q *Queries) ListThemesByConversationIDs(ctx context.Context, arg ListThemesByConversationIDsParams) ([]ListThemesByConversationIDsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listThemesByConversationIDs, pq.Array(arg.ConversationIds), arg.ManagerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListThemesByConversationIDsRow{}
+	for rows.Next() {
+		var i ListThemesByConversationIDsRow
+		if err := rows.Scan(
+			&i.ConversationID,
+			&i.Theme.ID,
+			&i.Theme.PersonID,
+			&i.Theme.Text,
+			&i.Theme.CreatedAt,
+			&i.Theme.UpdatedAt,
+			&i.Theme.ManagerID,
+			&i.Theme.ArchivedAt,
+			&i.Theme.Description,
+			&i.Theme.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const moveConversationThemes = `-- name: MoveConversationThemes :exec
+WITH moved AS (
+    DELETE FROM conversation_theme ct
+    USING theme t
+    WHERE ct.theme_id = x2b($1)
+      AND t.id = x2b($2) AND t.manager_id = x2b($3)
+    RETURNING ct.conversation_id, t.id AS theme_id
+)
+INSERT INTO conversation_theme (conversation_id, theme_id)
+SELECT conversation_id, theme_id FROM moved
+ON CONFLICT DO NOTHING
+`
+
+type MoveConversationThemesParams struct {
+	SourceThemeID string `db:"source_theme_id" json:"source_theme_id"`
+	TargetThemeID string `db:"target_theme_id" json:"target_theme_id"`
+	ManagerID     string `db:"manager_id" json:"manager_id"`
+}
+
+// Moves rather than copies, like MoveActionThemes.
+func (q *Queries) MoveConversationThemes(ctx context.Context, arg MoveConversationThemesParams) error {
+	_, err := q.db.ExecContext(ctx, moveConversationThemes, arg.SourceThemeID, arg.TargetThemeID, arg.ManagerID)
+	return err
+}
+
+const removeThemeFromConversation = `-- name: RemoveThemeFromConversation :exec
+DELETE FROM conversation_theme ct
+USING conversation c
+WHERE c.id = ct.conversation_id
+  AND ct.conversation_id = x2b($1)
+  AND ct.theme_id = x2b($2)
+  AND c.manager_id = x2b($3)
+`
+
+type RemoveThemeFromConversationParams struct {
+	ConversationID string `db:"conversation_id" json:"conversation_id"`
+	ThemeID        string `db:"theme_id" json:"theme_id"`
+	ManagerID      string `db:"manager_id" json:"manager_id"`
+}
+
+func (q *Queries) RemoveThemeFromConversation(ctx context.Context, arg RemoveThemeFromConversationParams) error {
+	_, err := q.db.ExecContext(ctx, removeThemeFromConversation, arg.ConversationID, arg.ThemeID, arg.ManagerID)
+	return err
+}
@@ -7,33 +7,72 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"time"
 )
 
+const countConversations = `-- name: CountConversations :one
+SELECT COUNT(*)
+FROM conversation c
+WHERE c.manager_id = x2b($1) AND c.deleted_at IS NULL
+  AND ($2::text IS NULL OR c.person_id = x2b($2))
+  AND ($3::text IS NULL OR EXISTS (
+      SELECT 1 FROM conversation_theme ct
+      WHERE ct.conversation_id = c.id AND ct.theme_id = x2b($3)
+  ))
+  AND ($4::timestamptz IS NULL OR c.occurred_at >= $4)
+  AND ($5::timestamptz IS NULL OR c.occurred_at < $5)
+`
+
+type CountConversationsParams struct {
+	ManagerID      string         `db:"manager_id" json:"manager_id"`
+	PersonID       sql.NullString `db:"person_id" json:"person_id"`
+	ThemeID        sql.NullString `db:"theme_id" json:"theme_id"`
+	OccurredFrom   sql.NullTime   `db:"occurred_from" json:"occurred_from"`
+	OccurredBefore sql.NullTime   `db:"occurred_before" json:"occurred_before"`
+}
+
+func (q *Queries) CountConversations(ctx context.Context, arg CountConversationsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countConversations,
+		arg.ManagerID,
+		arg.PersonID,
+		arg.ThemeID,
+		arg.OccurredFrom,
+		arg.OccurredBefore,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const countConversationsByPersonID = `-- name: CountConversationsByPersonID :one
-SELECT COUNT(DISTINCT c.id)
+SELECT COUNT(*)
 FROM conversation c
-JOIN action_conversation ac ON ac.conversation_id = c.id
-JOIN action a ON a.id = ac.action_id
-WHERE a.person_id = x2b($1)
+WHERE c.person_id = x2b($1) AND c.manager_id = x2b($2) AND c.deleted_at IS NULL
 `
 
-func (q *Queries) CountConversationsByPersonID(ctx context.Context, personID string) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countConversationsByPersonID, personID)
+type CountConversationsByPersonIDParams struct {
+	PersonID  string `db:"person_id" json:"person_id"`
+	ManagerID string `db:"manager_id" json:"manager_id"`
+}
+
+func (q *Queries) CountConversationsByPersonID(ctx context.Context, arg CountConversationsByPersonIDParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countConversationsByPersonID, arg.PersonID, arg.ManagerID)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
 const createConversation = `-- name: CreateConversation :one
-INSERT INTO conversation (id, person_id, description, occurred_at)
+INSERT INTO conversation (id, person_id, description, occurred_at, manager_id)
 VALUES (
     x2b($1),
     x2b($2),
     $3,
-    $4
+    $4,
+    x2b($5)
 )
-RETURNING conversation.id, conversation.description, conversation.occurred_at, conversation.created_at, conversation.updated_at, conversation.person_id
+RETURNING conversation.id, conversation.description, conversation.occurred_at, conversation.created_at, conversation.updated_at, conversation.person_id, conversation.manager_id, conversation.deleted_at
 `
 
 type CreateConversationParams struct {
@@ -41,6 +80,7 @@ type CreateConversationParams struct {
 	PersonID    string    `db:"person_id" json:"person_id"`
 	Description string    `db:"description" json:"description"`
 	OccurredAt  time.Time `db:"occurred_at" json:"occurred_at"`
+	ManagerID   string    `db:"manager_id" json:"manager_id"`
 }
 
 type CreateConversationRow struct {
@@ -53,6 +93,7 @@ func (q *Queries) CreateConversation(ctx context.Context, arg CreateConversation
 		arg.PersonID,
 		arg.Description,
 		arg.OccurredAt,
+		arg.ManagerID,
 	)
 	var i CreateConversationRow
 	err := row.Scan(
@@ -62,25 +103,125 @@ func (q *Queries) CreateConversation(ctx context.Context, arg CreateConversation
 		&i.Conversation.CreatedAt,
 		&i.Conversation.UpdatedAt,
 		&i.Conversation.PersonID,
+		&i.Conversation.ManagerID,
+		&i.Conversation.DeletedAt,
 	)
 	return i, err
 }
 
+const getConversationByID = `-- name: GetConversationByID :one
+SELECT conversation.id, conversation.description, conversation.occurred_at, conversation.created_at, conversation.updated_at, conversation.person_id, conversation.manager_id, conversation.deleted_at
+FROM conversation
+WHERE id = x2b($1) AND manager_id = x2b($2) AND deleted_at IS NULL
+`
+
+type GetConversationByIDParams struct {
+	ID        string `db:"id" json:"id"`
+	ManagerID string `db:"manager_id" json:"manager_id"`
+}
+
+type GetConversationByIDRow struct {
+	Conversation Conversation `db:"conversation" json:"conversation"`
+}
+
+func (q *Queries) GetConversationByID(ctx context.Context, arg GetConversationByIDParams) (GetConversationByIDRow, error) {
+	row := q.db.QueryRowContext(ctx, getConversationByID, arg.ID, arg.ManagerID)
+	var i GetConversationByIDRow
+	err := row.Scan(
+		&i.Conversation.ID,
+		&i.Conversation.Description,
+		&i.Conversation.OccurredAt,
+		&i.Conversation.CreatedAt,
+		&i.Conversation.UpdatedAt,
+		&i.Conversation.PersonID,
+		&i.Conversation.ManagerID,
+		&i.Conversation.DeletedAt,
+	)
+	return i, err
+}
+
+const listConversations = `-- name: ListConversations :many
+SELECT c.id, c.description, c.occurred_at, c.created_at, c.updated_at, c.person_id, c.manager_id, c.deleted_at
+FROM conversation c
+WHERE c.manager_id = x2b($1) AND c.deleted_at IS NULL
+  AND ($2::text IS NULL OR c.person_id = x2b($2))
+  AND ($3::text IS NULL OR EXISTS (
+      SELECT 1 FROM conversation_theme ct
+      WHERE ct.conversation_id = c.id AND ct.theme_id = x2b($3)
+  ))
+  AND ($4::timestamptz IS NULL OR c.occurred_at >= $4)
+  AND ($5::timestamptz IS NULL OR c.occurred_at < $5)
+ORDER BY c.occurred_at DESC
+LIMIT $7 OFFSET $6
+`
+
+type ListConversationsParams struct {
+	ManagerID      string         `db:"manager_id" json:"manager_id"`
+	PersonID       sql.NullString `db:"person_id" json:"person_id"`
+	ThemeID        sql.NullString `db:"theme_id" json:"theme_id"`
+	OccurredFrom   sql.NullTime   `db:"occurred_from" json:"occurred_from"`
+	OccurredBefore sql.NullTime   `db:"occurred_before" json:"occurred_before"`
+	Offset         int32          `db:"offset" json:"offset"`
+	Limit          int32          `db:"limit" json:"limit"`
+}
+
+type ListConversationsRow struct {
+	Conversation Conversation `db:"conversation" json:"conversation"`
+}
+
+func (q *Queries) ListConversations(ctx context.Context, arg ListConversationsParams) ([]ListConversationsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listConversations,
+		arg.ManagerID,
+		arg.PersonID,
+		arg.ThemeID,
+		arg.OccurredFrom,
+		arg.OccurredBefore,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListConversationsRow{}
+	for rows.Next() {
+		var i ListConversationsRow
+		if err := rows.Scan(
+			&i.Conversation.ID,
+			&i.Conversation.Description,
+			&i.Conversation.OccurredAt,
+			&i.Conversation.CreatedAt,
+			&i.Conversation.UpdatedAt,
+			&i.Conversation.PersonID,
+			&i.Conversation.ManagerID,
+			&i.Conversation.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listConversationsByPersonID = `-- name: ListConversationsByPersonID :many
-SELECT DISTINCT ON (c.id)
-    c.id, c.description, c.occurred_at, c.created_at, c.updated_at, c.person_id
+SELECT c.id, c.description, c.occurred_at, c.created_at, c.updated_at, c.person_id, c.manager_id, c.deleted_at
 FROM conversation c
-JOIN action_conversation ac ON ac.conversation_id = c.id
-JOIN action a ON a.id = ac.action_id
-WHERE a.person_id = x2b($1)
-ORDER BY c.id, c.occurred_at DESC
-LIMIT $3 OFFSET $2
+WHERE c.person_id = x2b($1) AND c.manager_id = x2b($2) AND c.deleted_at IS NULL
+ORDER BY c.occurred_at DESC
+LIMIT $4 OFFSET $3
 `
 
 type ListConversationsByPersonIDParams struct {
-	PersonID string `db:"person_id" json:"person_id"`
-	Offset   int32  `db:"offset" json:"offset"`
-	Limit    int32  `db:"limit" json:"limit"`
+	PersonID  string `db:"person_id" json:"person_id"`
+	ManagerID string `db:"manager_id" json:"manager_id"`
+	Offset    int32  `db:"offset" json:"offset"`
+	Limit     int32  `db:"limit" json:"limit"`
 }
 
 type ListConversationsByPersonIDRow struct {
@@ -88,7 +229,12 @@ type ListConversationsByPersonIDRow struct {
 }
 
 func (q *Queries) ListConversationsByPersonID(ctx context.Context, arg ListConversationsByPersonIDParams) ([]ListConversationsByPersonIDRow, error) {
-	rows, err := q.db.QueryContext(ctx, listConversationsByPersonID, arg.PersonID, arg.Offset, arg.Limit)
+	rows, err := q.db.QueryContext(ctx, listConversationsByPersonID,
+		arg.PersonID,
+		arg.ManagerID,
+		arg.Offset,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -103,6 +249,8 @@ func (q *Queries) ListConversationsByPersonID(ctx context.Context, arg ListConve
 			&i.Conversation.CreatedAt,
 			&i.Conversation.UpdatedAt,
 			&i.Conversation.PersonID,
+			&i.Conversation.ManagerID,
+			&i.Conversation.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -116,3 +264,47 @@ func (q *Queries) ListConversationsByPersonID(ctx context.Context, arg ListConve
 	}
 	return items, nil
 }
+
+const updateConversation = `-- name: UpdateConversation :one
+UPDATE conversation
+SET person_id = x2b($1),
+    description = $2,
+    occurred_at = $3,
+    updated_at = NOW()
+WHERE id = x2b($4) AND manager_id = x2b($5) AND deleted_at IS NULL
+RETURNING conversation.id, conversation.description, conversation.occurred_at, conversation.created_at, conversation.updated_at, conversation.person_id, conversation.manager_id, conversation.deleted_at
+`
+
+type UpdateConversationParams struct {
+	PersonID    string    `db:"person_id" json:"person_id"`
+	Description string    `db:"description" json:"description"`
+	OccurredAt  time.Time `db:"occurred_at" json:"occurred_at"`
+	ID          string    `db:"id" json:"id"`
+	ManagerID   string    `db:"manager_id" json:"manager_id"`
+}
+
+type UpdateConversationRow struct {
+	Conversation Conversation `db:"conversation" json:"conversation"`
+}
+
+func (q *Queries) UpdateConversation(ctx context.Context, arg UpdateConversationParams) (UpdateConversationRow, error) {
+	row := q.db.QueryRowContext(ctx, updateConversation,
+		arg.PersonID,
+		arg.Description,
+		arg.OccurredAt,
+		arg.ID,
+		arg.ManagerID,
+	)
+	var i UpdateConversationRow
+	err := row.Scan(
+		&i.Conversation.ID,
+		&i.Conversation.Description,
+		&i.Conversation.OccurredAt,
+		&i.Conversation.CreatedAt,
+		&i.Conversation.UpdatedAt,
+		&i.Conversation.PersonID,
+		&i.Conversation.ManagerID,
+		&i.Conversation.DeletedAt,
+	)
+	return i, err
+}
@@ -7,17 +7,260 @@ package db
 import (
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"time"
 
 	xidb "github.com/rs/xid/b"
 )
 
+type ApiTokenScope string
+
+const (
+	ApiTokenScopeRead  ApiTokenScope = "read"
+	ApiTokenScopeWrite ApiTokenScope = "write"
+)
+
+func (e *ApiTokenScope) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ApiTokenScope(s)
+	case string:
+		*e = ApiTokenScope(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ApiTokenScope: %T", src)
+	}
+	return nil
+}
+
+type NullApiTokenScope struct {
+	ApiTokenScope ApiTokenScope `json:"api_token_scope"`
+	Valid         bool          `json:"valid"` // Valid is true if ApiTokenScope is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullApiTokenScope) Scan(value interface{}) error {
+	if value == nil {
+		ns.ApiTokenScope, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ApiTokenScope.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullApiTokenScope) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ApiTokenScope), nil
+}
+
+func (e ApiTokenScope) Valid() bool {
+	switch e {
+	case ApiTokenScopeRead,
+		ApiTokenScopeWrite:
+		return true
+	}
+	return false
+}
+
+func AllApiTokenScopeValues() []ApiTokenScope {
+	return []ApiTokenScope{
+		ApiTokenScopeRead,
+		ApiTokenScopeWrite,
+	}
+}
+
+type FollowUpOwner string
+
+const (
+	FollowUpOwnerManager FollowUpOwner = "manager"
+	FollowUpOwnerReport  FollowUpOwner = "report"
+)
+
+func (e *FollowUpOwner) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = FollowUpOwner(s)
+	case string:
+		*e = FollowUpOwner(s)
+	default:
+		return fmt.Errorf("unsupported scan type for FollowUpOwner: %T", src)
+	}
+	return nil
+}
+
+type NullFollowUpOwner struct {
+	FollowUpOwner FollowUpOwner `json:"follow_up_owner"`
+	Valid         bool          `json:"valid"` // Valid is true if FollowUpOwner is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullFollowUpOwner) Scan(value interface{}) error {
+	if value == nil {
+		ns.FollowUpOwner, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.FollowUpOwner.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullFollowUpOwner) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.FollowUpOwner), nil
+}
+
+func (e FollowUpOwner) Valid() bool {
+	switch e {
+	case FollowUpOwnerManager,
+		FollowUpOwnerReport:
+		return true
+	}
+	return false
+}
+
+func AllFollowUpOwnerValues() []FollowUpOwner {
+	return []FollowUpOwner{
+		FollowUpOwnerManager,
+		FollowUpOwnerReport,
+	}
+}
+
+type ImpactLevel string
+
+const (
+	ImpactLevelLow    ImpactLevel = "low"
+	ImpactLevelMedium ImpactLevel = "medium"
+	ImpactLevelHigh   ImpactLevel = "high"
+)
+
+func (e *ImpactLevel) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ImpactLevel(s)
+	case string:
+		*e = ImpactLevel(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ImpactLevel: %T", src)
+	}
+	return nil
+}
+
+type NullImpactLevel struct {
+	ImpactLevel ImpactLevel `json:"impact_level"`
+	Valid       bool        `json:"valid"` // Valid is true if ImpactLevel is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullImpactLevel) Scan(value interface{}) error {
+	if value == nil {
+		ns.ImpactLevel, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ImpactLevel.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullImpactLevel) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ImpactLevel), nil
+}
+
+func (e ImpactLevel) Valid() bool {
+	switch e {
+	case ImpactLevelLow,
+		ImpactLevelMedium,
+		ImpactLevelHigh:
+		return true
+	}
+	return false
+}
+
+func AllImpactLevelValues() []ImpactLevel {
+	return []ImpactLevel{
+		ImpactLevelLow,
+		ImpactLevelMedium,
+		ImpactLevelHigh,
+	}
+}
+
+type ReferenceKind string
+
+const (
+	ReferenceKindUrl         ReferenceKind = "url"
+	ReferenceKindTicket      ReferenceKind = "ticket"
+	ReferenceKindPullRequest ReferenceKind = "pull_request"
+	ReferenceKindNote        ReferenceKind = "note"
+)
+
+func (e *ReferenceKind) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = ReferenceKind(s)
+	case string:
+		*e = ReferenceKind(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ReferenceKind: %T", src)
+	}
+	return nil
+}
+
+type NullReferenceKind struct {
+	ReferenceKind ReferenceKind `json:"reference_kind"`
+	Valid         bool          `json:"valid"` // Valid is true if ReferenceKind is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullReferenceKind) Scan(value interface{}) error {
+	if value == nil {
+		ns.ReferenceKind, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.ReferenceKind.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullReferenceKind) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.ReferenceKind), nil
+}
+
+func (e ReferenceKind) Valid() bool {
+	switch e {
+	case ReferenceKindUrl,
+		ReferenceKindTicket,
+		ReferenceKindPullRequest,
+		ReferenceKindNote:
+		return true
+	}
+	return false
+}
+
+func AllReferenceKindValues() []ReferenceKind {
+	return []ReferenceKind{
+		ReferenceKindUrl,
+		ReferenceKindTicket,
+		ReferenceKindPullRequest,
+		ReferenceKindNote,
+	}
+}
+
 type ValenceType string
 
 const (
 	ValenceTypePositive ValenceType = "positive"
 	ValenceTypeNegative ValenceType = "negative"
+	ValenceTypeNeutral  ValenceType = "neutral"
 )
 
 func (e *ValenceType) Scan(src interface{}) error {
@@ -58,7 +301,8 @@ func (ns NullValenceType) Value() (driver.Value, error) {
 func (e ValenceType) Valid() bool {
 	switch e {
 	case ValenceTypePositive,
-		ValenceTypeNegative:
+		ValenceTypeNegative,
+		ValenceTypeNeutral:
 		return true
 	}
 	return false
@@ -68,18 +312,21 @@ func AllValenceTypeValues() []ValenceType {
 	return []ValenceType{
 		ValenceTypePositive,
 		ValenceTypeNegative,
+		ValenceTypeNeutral,
 	}
 }
 
 type Action struct {
-	ID          xidb.ID        `db:"id" json:"id"`
-	PersonID    xidb.ID        `db:"person_id" json:"person_id"`
-	OccurredAt  time.Time      `db:"occurred_at" json:"occurred_at"`
-	Description string         `db:"description" json:"description"`
-	References  sql.NullString `db:"references" json:"references"`
-	Valence     ValenceType    `db:"valence" json:"valence"`
-	CreatedAt   time.Time      `db:"created_at" json:"created_at"`
-	UpdatedAt   time.Time      `db:"updated_at" json:"updated_at"`
+	ID          xidb.ID         `db:"id" json:"id"`
+	PersonID    xidb.ID         `db:"person_id" json:"person_id"`
+	OccurredAt  time.Time       `db:"occurred_at" json:"occurred_at"`
+	Description string          `db:"description" json:"description"`
+	Valence     ValenceType     `db:"valence" json:"valence"`
+	CreatedAt   time.Time       `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time       `db:"updated_at" json:"updated_at"`
+	ManagerID   xidb.ID         `db:"manager_id" json:"manager_id"`
+	Impact      NullImpactLevel `db:"impact" json:"impact"`
+	DeletedAt   sql.NullTime    `db:"deleted_at" json:"deleted_at"`
 }
 
 type ActionConversation struct {
@@ -89,6 +336,29 @@ type ActionConversation struct {
 	UpdatedAt      time.Time `db:"updated_at" json:"updated_at"`
 }
 
+type ActionReference struct {
+	ActionID  []byte         `db:"action_id" json:"action_id"`
+	Position  int32          `db:"position" json:"position"`
+	Kind      ReferenceKind  `db:"kind" json:"kind"`
+	Value     string         `db:"value" json:"value"`
+	Title     sql.NullString `db:"title" json:"title"`
+	CreatedAt time.Time      `db:"created_at" json:"created_at"`
+}
+
+type ActionRevision struct {
+	ID          []byte          `db:"id" json:"id"`
+	ActionID    []byte          `db:"action_id" json:"action_id"`
+	ManagerID   []byte          `db:"manager_id" json:"manager_id"`
+	Description string          `db:"description" json:"description"`
+	Valence     ValenceType     `db:"valence" json:"valence"`
+	Impact      NullImpactLevel `db:"impact" json:"impact"`
+	OccurredAt  time.Time       `db:"occurred_at" json:"occurred_at"`
+	References  json.RawMessage `db:"references" json:"references"`
+	ThemeIds    []string        `db:"theme_ids" json:"theme_ids"`
+	ThemeTexts  []string        `db:"theme_texts" json:"theme_texts"`
+	CreatedAt   time.Time       `db:"created_at" json:"created_at"`
+}
+
 type ActionTheme struct {
 	ActionID  []byte    `db:"action_id" json:"action_id"`
 	ThemeID   []byte    `db:"theme_id" json:"theme_id"`
@@ -96,13 +366,65 @@ type ActionTheme struct {
 	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
 }
 
+type ApiToken struct {
+	ID         xidb.ID       `db:"id" json:"id"`
+	ManagerID  xidb.ID       `db:"manager_id" json:"manager_id"`
+	Name       string        `db:"name" json:"name"`
+	TokenHash  []byte        `db:"token_hash" json:"token_hash"`
+	Scope      ApiTokenScope `db:"scope" json:"scope"`
+	ExpiresAt  sql.NullTime  `db:"expires_at" json:"expires_at"`
+	LastUsedAt sql.NullTime  `db:"last_used_at" json:"last_used_at"`
+	RevokedAt  sql.NullTime  `db:"revoked_at" json:"revoked_at"`
+	CreatedAt  time.Time     `db:"created_at" json:"created_at"`
+}
+
+type Attachment struct {
+	ID             xidb.ID   `db:"id" json:"id"`
+	ManagerID      xidb.ID   `db:"manager_id" json:"manager_id"`
+	ActionID       xidb.ID   `db:"action_id" json:"action_id"`
+	ConversationID xidb.ID   `db:"conversation_id" json:"conversation_id"`
+	Filename       string    `db:"filename" json:"filename"`
+	ContentType    string    `db:"content_type" json:"content_type"`
+	SizeBytes      int64     `db:"size_bytes" json:"size_bytes"`
+	Sha256         string    `db:"sha256" json:"sha256"`
+	StorageKey     string    `db:"storage_key" json:"storage_key"`
+	CreatedAt      time.Time `db:"created_at" json:"created_at"`
+}
+
+type AuditLog struct {
+	ID         []byte    `db:"id" json:"id"`
+	ManagerID  []byte    `db:"manager_id" json:"manager_id"`
+	Operation  string    `db:"operation" json:"operation"`
+	EntityIds  []string  `db:"entity_ids" json:"entity_ids"`
+	AuthMethod string    `db:"auth_method" json:"auth_method"`
+	Method     string    `db:"method" json:"method"`
+	Path       string    `db:"path" json:"path"`
+	RemoteAddr string    `db:"remote_addr" json:"remote_addr"`
+	UserAgent  string    `db:"user_agent" json:"user_agent"`
+	Outcome    string    `db:"outcome" json:"outcome"`
+	CreatedAt  time.Time `db:"created_at" json:"created_at"`
+}
+
 type Conversation struct {
-	ID          []byte    `db:"id" json:"id"`
-	Description string    `db:"description" json:"description"`
-	OccurredAt  time.Time `db:"occurred_at" json:"occurred_at"`
-	CreatedAt   time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt   time.Time `db:"updated_at" json:"updated_at"`
-	PersonID    []byte    `db:"person_id" json:"person_id"`
+	ID          []byte       `db:"id" json:"id"`
+	Description string       `db:"description" json:"description"`
+	OccurredAt  time.Time    `db:"occurred_at" json:"occurred_at"`
+	CreatedAt   time.Time    `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time    `db:"updated_at" json:"updated_at"`
+	PersonID    []byte       `db:"person_id" json:"person_id"`
+	ManagerID   xidb.ID      `db:"manager_id" json:"manager_id"`
+	DeletedAt   sql.NullTime `db:"deleted_at" json:"deleted_at"`
+}
+
+type ConversationRevision struct {
+	ID             []byte    `db:"id" json:"id"`
+	ConversationID []byte    `db:"conversation_id" json:"conversation_id"`
+	ManagerID      []byte    `db:"manager_id" json:"manager_id"`
+	Description    string    `db:"description" json:"description"`
+	OccurredAt     time.Time `db:"occurred_at" json:"occurred_at"`
+	ThemeIds       []string  `db:"theme_ids" json:"theme_ids"`
+	ThemeTexts     []string  `db:"theme_texts" json:"theme_texts"`
+	CreatedAt      time.Time `db:"created_at" json:"created_at"`
 }
 
 type ConversationTheme struct {
@@ -112,11 +434,64 @@ type ConversationTheme struct {
 	UpdatedAt      time.Time `db:"updated_at" json:"updated_at"`
 }
 
-type Person struct {
-	ID        xidb.ID   `db:"id" json:"id"`
-	Name      string    `db:"name" json:"name"`
+type FollowUp struct {
+	ID             xidb.ID       `db:"id" json:"id"`
+	ConversationID xidb.ID       `db:"conversation_id" json:"conversation_id"`
+	ManagerID      xidb.ID       `db:"manager_id" json:"manager_id"`
+	Description    string        `db:"description" json:"description"`
+	Owner          FollowUpOwner `db:"owner" json:"owner"`
+	DueOn          sql.NullTime  `db:"due_on" json:"due_on"`
+	CompletedAt    sql.NullTime  `db:"completed_at" json:"completed_at"`
+	CreatedAt      time.Time     `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time     `db:"updated_at" json:"updated_at"`
+}
+
+type LadderLevel struct {
+	ID          xidb.ID        `db:"id" json:"id"`
+	ManagerID   xidb.ID        `db:"manager_id" json:"manager_id"`
+	Name        string         `db:"name" json:"name"`
+	Rank        int32          `db:"rank" json:"rank"`
+	Description sql.NullString `db:"description" json:"description"`
+	CreatedAt   time.Time      `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time      `db:"updated_at" json:"updated_at"`
+}
+
+type LevelExpectation struct {
+	ID          xidb.ID   `db:"id" json:"id"`
+	LevelID     xidb.ID   `db:"level_id" json:"level_id"`
+	ThemeID     xidb.ID   `db:"theme_id" json:"theme_id"`
+	Description string    `db:"description" json:"description"`
+	CreatedAt   time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type Manager struct {
+	ID           xidb.ID   `db:"id" json:"id"`
+	Email        string    `db:"email" json:"email"`
+	Name         string    `db:"name" json:"name"`
+	PasswordHash string    `db:"password_hash" json:"password_hash"`
+	CreatedAt    time.Time `db:"created_at" json:"created_at"`
+	UpdatedAt    time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type ManagerSession struct {
+	TokenHash []byte    `db:"token_hash" json:"token_hash"`
+	ManagerID xidb.ID   `db:"manager_id" json:"manager_id"`
+	ExpiresAt time.Time `db:"expires_at" json:"expires_at"`
 	CreatedAt time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+}
+
+type Person struct {
+	ID             xidb.ID       `db:"id" json:"id"`
+	Name           string        `db:"name" json:"name"`
+	CreatedAt      time.Time     `db:"created_at" json:"created_at"`
+	UpdatedAt      time.Time     `db:"updated_at" json:"updated_at"`
+	ManagerID      xidb.ID       `db:"manager_id" json:"manager_id"`
+	ReportsTo      []byte        `db:"reports_to" json:"reports_to"`
+	CadenceDays    sql.NullInt32 `db:"cadence_days" json:"cadence_days"`
+	CurrentLevelID xidb.ID       `db:"current_level_id" json:"current_level_id"`
+	TargetLevelID  xidb.ID       `db:"target_level_id" json:"target_level_id"`
+	DeletedAt      sql.NullTime  `db:"deleted_at" json:"deleted_at"`
 }
 
 type SchemaMigration struct {
@@ -124,9 +499,13 @@ type SchemaMigration struct {
 }
 
 type Theme struct {
-	ID        xidb.ID   `db:"id" json:"id"`
-	PersonID  xidb.ID   `db:"person_id" json:"person_id"`
-	Text      string    `db:"text" json:"text"`
-	CreatedAt time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+	ID          xidb.ID        `db:"id" json:"id"`
+	PersonID    xidb.ID        `db:"person_id" json:"person_id"`
+	Text        string         `db:"text" json:"text"`
+	CreatedAt   time.Time      `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time      `db:"updated_at" json:"updated_at"`
+	ManagerID   xidb.ID        `db:"manager_id" json:"manager_id"`
+	ArchivedAt  sql.NullTime   `db:"archived_at" json:"archived_at"`
+	Description sql.NullString `db:"description" json:"description"`
+	DeletedAt   sql.NullTime   `db:"deleted_at" json:"deleted_at"`
 }
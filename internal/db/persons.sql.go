@@ -12,75 +12,100 @@ import (
 )
 
 const countPersons = `-- name: CountPersons :one
-SELECT COUNT(*) FROM person
+SELECT COUNT(*)
+FROM person
+WHERE manager_id = x2b($1) AND deleted_at IS NULL
+  AND ($2::boolean IS NULL
+       OR COALESCE(person_next_due_at(id, cadence_days, created_at) < NOW(), FALSE) = $2)
 `
 
-func (q *Queries) CountPersons(ctx context.Context) (int64, error) {
-	row := q.db.QueryRowContext(ctx, countPersons)
+type CountPersonsParams struct {
+	ManagerID string       `db:"manager_id" json:"manager_id"`
+	Overdue   sql.NullBool `db:"overdue" json:"overdue"`
+}
+
+func (q *Queries) CountPersons(ctx context.Context, arg CountPersonsParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPersons, arg.ManagerID, arg.Overdue)
 	var count int64
 	err := row.Scan(&count)
 	return count, err
 }
 
 const createPerson = `-- name: CreatePerson :one
-INSERT INTO person (id, name)
-VALUES (x2b($1), $2)
-RETURNING b2x(id) as id, name, created_at, updated_at
+INSERT INTO person (id, name, manager_id, reports_to, cadence_days)
+VALUES (x2b($1), $2, x2b($3), x2b($4), $5)
+RETURNING b2x(id) as id, name, COALESCE(b2x(reports_to), '') AS reports_to, cadence_days, person_next_due_at(id, cadence_days, created_at) AS next_due_at, created_at, updated_at
 `
 
 type CreatePersonParams struct {
-	ID   string `db:"id" json:"id"`
-	Name string `db:"name" json:"name"`
+	ID          string         `db:"id" json:"id"`
+	Name        string         `db:"name" json:"name"`
+	ManagerID   string         `db:"manager_id" json:"manager_id"`
+	ReportsTo   sql.NullString `db:"reports_to" json:"reports_to"`
+	CadenceDays sql.NullInt32  `db:"cadence_days" json:"cadence_days"`
 }
 
 type CreatePersonRow struct {
-	ID        string    `db:"id" json:"id"`
-	Name      string    `db:"name" json:"name"`
-	CreatedAt time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+	ID          string        `db:"id" json:"id"`
+	Name        string        `db:"name" json:"name"`
+	ReportsTo   string        `db:"reports_to" json:"reports_to"`
+	CadenceDays sql.NullInt32 `db:"cadence_days" json:"cadence_days"`
+	NextDueAt   sql.NullTime  `db:"next_due_at" json:"next_due_at"`
+	CreatedAt   time.Time     `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time     `db:"updated_at" json:"updated_at"`
 }
 
 func (q *Queries) CreatePerson(ctx context.Context, arg CreatePersonParams) (CreatePersonRow, error) {
-	row := q.db.QueryRowContext(ctx, createPerson, arg.ID, arg.Name)
+	row := q.db.QueryRowContext(ctx, createPerson,
+		arg.ID,
+		arg.Name,
+		arg.ManagerID,
+		arg.ReportsTo,
+		arg.CadenceDays,
+	)
 	var i CreatePersonRow
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
+		&i.ReportsTo,
+		&i.CadenceDays,
+		&i.NextDueAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
 	return i, err
 }
 
-const deletePerson = `-- name: DeletePerson :exec
-DELETE FROM person
-WHERE id = x2b($1)
-`
-
-func (q *Queries) DeletePerson(ctx context.Context, id string) error {
-	_, err := q.db.ExecContext(ctx, deletePerson, id)
-	return err
-}
-
 const getPersonByID = `-- name: GetPersonByID :one
-SELECT b2x(id) as id, name, created_at, updated_at
+SELECT b2x(id) as id, name, COALESCE(b2x(reports_to), '') AS reports_to, cadence_days, person_next_due_at(id, cadence_days, created_at) AS next_due_at, created_at, updated_at
 FROM person
-WHERE id = x2b($1)
+WHERE id = x2b($1) AND manager_id = x2b($2) AND deleted_at IS NULL
 `
 
+type GetPersonByIDParams struct {
+	ID        string `db:"id" json:"id"`
+	ManagerID string `db:"manager_id" json:"manager_id"`
+}
+
 type GetPersonByIDRow struct {
-	ID        string    `db:"id" json:"id"`
-	Name      string    `db:"name" json:"name"`
-	CreatedAt time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+	ID          string        `db:"id" json:"id"`
+	Name        string        `db:"name" json:"name"`
+	ReportsTo   string        `db:"reports_to" json:"reports_to"`
+	CadenceDays sql.NullInt32 `db:"cadence_days" json:"cadence_days"`
+	NextDueAt   sql.NullTime  `db:"next_due_at" json:"next_due_at"`
+	CreatedAt   time.Time     `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time     `db:"updated_at" json:"updated_at"`
 }
 
-func (q *Queries) GetPersonByID(ctx context.Context, id string) (GetPersonByIDRow, error) {
-	row := q.db.QueryRowContext(ctx, getPersonByID, id)
+func (q *Queries) GetPersonByID(ctx context.Context, arg GetPersonByIDParams) (GetPersonByIDRow, error) {
+	row := q.db.QueryRowContext(ctx, getPersonByID, arg.ID, arg.ManagerID)
 	var i GetPersonByIDRow
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
+		&i.ReportsTo,
+		&i.CadenceDays,
+		&i.NextDueAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -90,9 +115,14 @@ func (q *Queries) GetPersonByID(ctx context.Context, id string) (GetPersonByIDRo
 const getPersonByName = `-- name: GetPersonByName :one
 SELECT b2x(id) as id, name, created_at, updated_at
 FROM person
-WHERE name = $1
+WHERE name = $1 AND manager_id = x2b($2) AND deleted_at IS NULL
 `
 
+type GetPersonByNameParams struct {
+	Name      string `db:"name" json:"name"`
+	ManagerID string `db:"manager_id" json:"manager_id"`
+}
+
 type GetPersonByNameRow struct {
 	ID        string    `db:"id" json:"id"`
 	Name      string    `db:"name" json:"name"`
@@ -100,8 +130,8 @@ type GetPersonByNameRow struct {
 	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
 }
 
-func (q *Queries) GetPersonByName(ctx context.Context, name string) (GetPersonByNameRow, error) {
-	row := q.db.QueryRowContext(ctx, getPersonByName, name)
+func (q *Queries) GetPersonByName(ctx context.Context, arg GetPersonByNameParams) (GetPersonByNameRow, error) {
+	row := q.db.QueryRowContext(ctx, getPersonByName, arg.Name, arg.ManagerID)
 	var i GetPersonByNameRow
 	err := row.Scan(
 		&i.ID,
@@ -112,27 +142,80 @@ func (q *Queries) GetPersonByName(ctx context.Context, name string) (GetPersonBy
 	return i, err
 }
 
+const listOrgChart = `-- name: ListOrgChart :many
+SELECT b2x(id) as id, name, COALESCE(b2x(reports_to), '') AS reports_to
+FROM person
+WHERE manager_id = x2b($1) AND deleted_at IS NULL
+ORDER BY name
+`
+
+type ListOrgChartRow struct {
+	ID        string `db:"id" json:"id"`
+	Name      string `db:"name" json:"name"`
+	ReportsTo string `db:"reports_to" json:"reports_to"`
+}
+
+func (q *Queries) ListOrgChart(ctx context.Context, managerID string) ([]ListOrgChartRow, error) {
+	rows, err := q.db.QueryContext(ctx, listOrgChart, managerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListOrgChartRow{}
+	for rows.Next() {
+		var i ListOrgChartRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.ReportsTo,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listPersons = `-- name: ListPersons :many
-SELECT b2x(id) as id, name, created_at, updated_at
+SELECT b2x(id) as id, name, COALESCE(b2x(reports_to), '') AS reports_to, cadence_days, person_next_due_at(id, cadence_days, created_at) AS next_due_at, created_at, updated_at
 FROM person
+WHERE manager_id = x2b($1) AND deleted_at IS NULL
+  AND ($2::boolean IS NULL
+       OR COALESCE(person_next_due_at(id, cadence_days, created_at) < NOW(), FALSE) = $2)
 ORDER BY created_at DESC
-LIMIT $2 OFFSET $1
+LIMIT $4 OFFSET $3
 `
 
 type ListPersonsParams struct {
-	Offset int32 `db:"offset" json:"offset"`
-	Limit  int32 `db:"limit" json:"limit"`
+	ManagerID string       `db:"manager_id" json:"manager_id"`
+	Overdue   sql.NullBool `db:"overdue" json:"overdue"`
+	Offset    int32        `db:"offset" json:"offset"`
+	Limit     int32        `db:"limit" json:"limit"`
 }
 
 type ListPersonsRow struct {
-	ID        string    `db:"id" json:"id"`
-	Name      string    `db:"name" json:"name"`
-	CreatedAt time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+	ID          string        `db:"id" json:"id"`
+	Name        string        `db:"name" json:"name"`
+	ReportsTo   string        `db:"reports_to" json:"reports_to"`
+	CadenceDays sql.NullInt32 `db:"cadence_days" json:"cadence_days"`
+	NextDueAt   sql.NullTime  `db:"next_due_at" json:"next_due_at"`
+	CreatedAt   time.Time     `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time     `db:"updated_at" json:"updated_at"`
 }
 
 func (q *Queries) ListPersons(ctx context.Context, arg ListPersonsParams) ([]ListPersonsRow, error) {
-	rows, err := q.db.QueryContext(ctx, listPersons, arg.Offset, arg.Limit)
+	rows, err := q.db.QueryContext(ctx, listPersons,
+		arg.ManagerID,
+		arg.Overdue,
+		arg.Offset,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -143,6 +226,9 @@ func (q *Queries) ListPersons(ctx context.Context, arg ListPersonsParams) ([]Lis
 		if err := rows.Scan(
 			&i.ID,
 			&i.Name,
+			&i.ReportsTo,
+			&i.CadenceDays,
+			&i.NextDueAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -165,6 +251,8 @@ SELECT
     p.name,
     p.created_at,
     p.updated_at,
+    p.cadence_days,
+    person_next_due_at(p.id, p.cadence_days, p.created_at) AS next_due_at,
     COALESCE(la.description, '') AS last_action_desc,
     COALESCE(la.occurred_at, '0001-01-01T00:00:00Z'::timestamptz) AS last_action_at,
     COALESCE(lc.description, '') AS last_conversation_desc,
@@ -173,39 +261,54 @@ FROM person p
 LEFT JOIN LATERAL (
     SELECT description, occurred_at
     FROM action
-    WHERE person_id = p.id
+    WHERE person_id = p.id AND deleted_at IS NULL
     ORDER BY occurred_at DESC
     LIMIT 1
 ) la ON TRUE
 LEFT JOIN LATERAL (
     SELECT description, occurred_at
     FROM conversation
-    WHERE person_id = p.id
+    WHERE person_id = p.id AND deleted_at IS NULL
     ORDER BY occurred_at DESC
     LIMIT 1
 ) lc ON TRUE
+WHERE p.manager_id = x2b($1) AND p.deleted_at IS NULL
+  AND ($2::text IS NULL OR p.reports_to = x2b($2))
+  AND ($3::boolean IS NULL
+       OR COALESCE(person_next_due_at(p.id, p.cadence_days, p.created_at) < NOW(), FALSE) = $3)
 ORDER BY p.created_at DESC
-LIMIT $2 OFFSET $1
+LIMIT $5 OFFSET $4
 `
 
 type ListPersonsWithLastActivityParams struct {
-	Offset int32 `db:"offset" json:"offset"`
-	Limit  int32 `db:"limit" json:"limit"`
+	ManagerID string         `db:"manager_id" json:"manager_id"`
+	ReportsTo sql.NullString `db:"reports_to" json:"reports_to"`
+	Overdue   sql.NullBool   `db:"overdue" json:"overdue"`
+	Offset    int32          `db:"offset" json:"offset"`
+	Limit     int32          `db:"limit" json:"limit"`
 }
 
 type ListPersonsWithLastActivityRow struct {
-	ID                   string    `db:"id" json:"id"`
-	Name                 string    `db:"name" json:"name"`
-	CreatedAt            time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt            time.Time `db:"updated_at" json:"updated_at"`
-	LastActionDesc       string    `db:"last_action_desc" json:"last_action_desc"`
-	LastActionAt         time.Time `db:"last_action_at" json:"last_action_at"`
-	LastConversationDesc string    `db:"last_conversation_desc" json:"last_conversation_desc"`
-	LastConversationAt   time.Time `db:"last_conversation_at" json:"last_conversation_at"`
+	ID                   string        `db:"id" json:"id"`
+	Name                 string        `db:"name" json:"name"`
+	CreatedAt            time.Time     `db:"created_at" json:"created_at"`
+	UpdatedAt            time.Time     `db:"updated_at" json:"updated_at"`
+	CadenceDays          sql.NullInt32 `db:"cadence_days" json:"cadence_days"`
+	NextDueAt            sql.NullTime  `db:"next_due_at" json:"next_due_at"`
+	LastActionDesc       string        `db:"last_action_desc" json:"last_action_desc"`
+	LastActionAt         time.Time     `db:"last_action_at" json:"last_action_at"`
+	LastConversationDesc string        `db:"last_conversation_desc" json:"last_conversation_desc"`
+	LastConversationAt   time.Time     `db:"last_conversation_at" json:"last_conversation_at"`
 }
 
 func (q *Queries) ListPersonsWithLastActivity(ctx context.Context, arg ListPersonsWithLastActivityParams) ([]ListPersonsWithLastActivityRow, error) {
-	rows, err := q.db.QueryContext(ctx, listPersonsWithLastActivity, arg.Offset, arg.Limit)
+	rows, err := q.db.QueryContext(ctx, listPersonsWithLastActivity,
+		arg.ManagerID,
+		arg.ReportsTo,
+		arg.Overdue,
+		arg.Offset,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -218,6 +321,8 @@ func (q *Queries) ListPersonsWithLastActivity(ctx context.Context, arg ListPerso
 			&i.Name,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.CadenceDays,
+			&i.NextDueAt,
 			&i.LastActionDesc,
 			&i.LastActionAt,
 			&i.LastConversationDesc,
@@ -239,15 +344,16 @@ func (q *Queries) ListPersonsWithLastActivity(ctx context.Context, arg ListPerso
 const searchPersonsByName = `-- name: SearchPersonsByName :many
 SELECT b2x(id) as id, name, created_at, updated_at
 FROM person
-WHERE name ILIKE '%' || $1 || '%'
+WHERE name ILIKE '%' || $1 || '%' AND manager_id = x2b($2) AND deleted_at IS NULL
 ORDER BY name
-LIMIT $3 OFFSET $2
+LIMIT $4 OFFSET $3
 `
 
 type SearchPersonsByNameParams struct {
-	Search sql.NullString `db:"search" json:"search"`
-	Offset int32          `db:"offset" json:"offset"`
-	Limit  int32          `db:"limit" json:"limit"`
+	Search    sql.NullString `db:"search" json:"search"`
+	ManagerID string         `db:"manager_id" json:"manager_id"`
+	Offset    int32          `db:"offset" json:"offset"`
+	Limit     int32          `db:"limit" json:"limit"`
 }
 
 type SearchPersonsByNameRow struct {
@@ -258,7 +364,12 @@ type SearchPersonsByNameRow struct {
 }
 
 func (q *Queries) SearchPersonsByName(ctx context.Context, arg SearchPersonsByNameParams) ([]SearchPersonsByNameRow, error) {
-	rows, err := q.db.QueryContext(ctx, searchPersonsByName, arg.Search, arg.Offset, arg.Limit)
+	rows, err := q.db.QueryContext(ctx, searchPersonsByName,
+		arg.Search,
+		arg.ManagerID,
+		arg.Offset,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -287,29 +398,44 @@ func (q *Queries) SearchPersonsByName(ctx context.Context, arg SearchPersonsByNa
 
 const updatePerson = `-- name: UpdatePerson :one
 UPDATE person
-SET name = $1, updated_at = NOW()
-WHERE id = x2b($2)
-RETURNING b2x(id) as id, name, created_at, updated_at
+SET name = $1, reports_to = x2b($2), cadence_days = $3, updated_at = NOW()
+WHERE id = x2b($4) AND manager_id = x2b($5) AND deleted_at IS NULL
+RETURNING b2x(id) as id, name, COALESCE(b2x(reports_to), '') AS reports_to, cadence_days, person_next_due_at(id, cadence_days, created_at) AS next_due_at, created_at, updated_at
 `
 
 type UpdatePersonParams struct {
-	Name string `db:"name" json:"name"`
-	ID   string `db:"id" json:"id"`
+	Name        string         `db:"name" json:"name"`
+	ReportsTo   sql.NullString `db:"reports_to" json:"reports_to"`
+	CadenceDays sql.NullInt32  `db:"cadence_days" json:"cadence_days"`
+	ID          string         `db:"id" json:"id"`
+	ManagerID   string         `db:"manager_id" json:"manager_id"`
 }
 
 type UpdatePersonRow struct {
-	ID        string    `db:"id" json:"id"`
-	Name      string    `db:"name" json:"name"`
-	CreatedAt time.Time `db:"created_at" json:"created_at"`
-	UpdatedAt time.Time `db:"updated_at" json:"updated_at"`
+	ID          string        `db:"id" json:"id"`
+	Name        string        `db:"name" json:"name"`
+	ReportsTo   string        `db:"reports_to" json:"reports_to"`
+	CadenceDays sql.NullInt32 `db:"cadence_days" json:"cadence_days"`
+	NextDueAt   sql.NullTime  `db:"next_due_at" json:"next_due_at"`
+	CreatedAt   time.Time     `db:"created_at" json:"created_at"`
+	UpdatedAt   time.Time     `db:"updated_at" json:"updated_at"`
 }
 
 func (q *Queries) UpdatePerson(ctx context.Context, arg UpdatePersonParams) (UpdatePersonRow, error) {
-	row := q.db.QueryRowContext(ctx, updatePerson, arg.Name, arg.ID)
+	row := q.db.QueryRowContext(ctx, updatePerson,
+		arg.Name,
+		arg.ReportsTo,
+		arg.CadenceDays,
+		arg.ID,
+		arg.ManagerID,
+	)
 	var i UpdatePersonRow
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
+		&i.ReportsTo,
+		&i.CadenceDays,
+		&i.NextDueAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -9,44 +9,147 @@ import (
 )
 
 type Querier interface {
+	AddActionReference(ctx context.Context, arg AddActionReferenceParams) error
 	AddActionToConversation(ctx context.Context, arg AddActionToConversationParams) error
 	AddThemeToAction(ctx context.Context, arg AddThemeToActionParams) error
 	AddThemeToConversation(ctx context.Context, arg AddThemeToConversationParams) error
-	CountActions(ctx context.Context) (int64, error)
-	CountActionsByPersonID(ctx context.Context, personID string) (int64, error)
-	CountConversationsByPersonID(ctx context.Context, personID string) (int64, error)
-	CountPersons(ctx context.Context) (int64, error)
+	CompleteFollowUp(ctx context.Context, arg CompleteFollowUpParams) (int64, error)
+	CountActionsByPersonID(ctx context.Context, arg CountActionsByPersonIDParams) (int64, error)
+	CountActionsFiltered(ctx context.Context, arg CountActionsFilteredParams) (int64, error)
+	CountAuditLog(ctx context.Context, arg CountAuditLogParams) (int64, error)
+	CountConversations(ctx context.Context, arg CountConversationsParams) (int64, error)
+	CountConversationsByPersonID(ctx context.Context, arg CountConversationsByPersonIDParams) (int64, error)
+	CountFollowUps(ctx context.Context, arg CountFollowUpsParams) (int64, error)
+	CountPersons(ctx context.Context, arg CountPersonsParams) (int64, error)
+	CountThemes(ctx context.Context, arg CountThemesParams) (int64, error)
+	// Takes the same filters as ListTimelineByPersonID.
+	CountTimelineByPersonID(ctx context.Context, arg CountTimelineByPersonIDParams) (int64, error)
+	CreateAPIToken(ctx context.Context, arg CreateAPITokenParams) (CreateAPITokenRow, error)
 	CreateAction(ctx context.Context, arg CreateActionParams) (CreateActionRow, error)
+	CreateAttachment(ctx context.Context, arg CreateAttachmentParams) (CreateAttachmentRow, error)
 	CreateConversation(ctx context.Context, arg CreateConversationParams) (CreateConversationRow, error)
+	CreateFollowUp(ctx context.Context, arg CreateFollowUpParams) (CreateFollowUpRow, error)
+	CreateManager(ctx context.Context, arg CreateManagerParams) (CreateManagerRow, error)
 	CreatePerson(ctx context.Context, arg CreatePersonParams) (CreatePersonRow, error)
+	CreateSession(ctx context.Context, arg CreateSessionParams) error
 	CreateTheme(ctx context.Context, arg CreateThemeParams) (CreateThemeRow, error)
-	DeleteAction(ctx context.Context, id string) error
-	DeletePerson(ctx context.Context, id string) error
-	DeleteTheme(ctx context.Context, id string) error
-	GetActionByID(ctx context.Context, id string) (GetActionByIDRow, error)
-	GetActionsByDateRange(ctx context.Context, arg GetActionsByDateRangeParams) ([]GetActionsByDateRangeRow, error)
+	DeleteActionReferences(ctx context.Context, actionID string) error
+	DeleteAttachment(ctx context.Context, arg DeleteAttachmentParams) (int64, error)
+	DeleteExpiredSessions(ctx context.Context) error
+	DeleteSession(ctx context.Context, tokenHash []byte) error
+	GetActionByID(ctx context.Context, arg GetActionByIDParams) (GetActionByIDRow, error)
+	GetActionRevision(ctx context.Context, arg GetActionRevisionParams) (GetActionRevisionRow, error)
 	GetActionsWithPersonDetails(ctx context.Context, arg GetActionsWithPersonDetailsParams) ([]GetActionsWithPersonDetailsRow, error)
-	GetPersonByID(ctx context.Context, id string) (GetPersonByIDRow, error)
-	GetPersonByName(ctx context.Context, name string) (GetPersonByNameRow, error)
+	GetAttachmentByID(ctx context.Context, arg GetAttachmentByIDParams) (GetAttachmentByIDRow, error)
+	GetConversationByID(ctx context.Context, arg GetConversationByIDParams) (GetConversationByIDRow, error)
+	GetConversationRevision(ctx context.Context, arg GetConversationRevisionParams) (GetConversationRevisionRow, error)
+	GetFollowUpByID(ctx context.Context, arg GetFollowUpByIDParams) (GetFollowUpByIDRow, error)
+	GetLadderLevelByID(ctx context.Context, arg GetLadderLevelByIDParams) (GetLadderLevelByIDRow, error)
+	GetManagerByAPIToken(ctx context.Context, tokenHash []byte) (GetManagerByAPITokenRow, error)
+	GetManagerByEmail(ctx context.Context, email string) (GetManagerByEmailRow, error)
+	GetManagerBySessionToken(ctx context.Context, tokenHash []byte) (GetManagerBySessionTokenRow, error)
+	GetPersonByID(ctx context.Context, arg GetPersonByIDParams) (GetPersonByIDRow, error)
+	GetPersonByName(ctx context.Context, arg GetPersonByNameParams) (GetPersonByNameRow, error)
+	GetPersonLevels(ctx context.Context, arg GetPersonLevelsParams) (GetPersonLevelsRow, error)
 	GetRecentActionsByPersonID(ctx context.Context, arg GetRecentActionsByPersonIDParams) ([]GetRecentActionsByPersonIDRow, error)
-	GetThemeByID(ctx context.Context, id string) (GetThemeByIDRow, error)
-	ListActions(ctx context.Context, arg ListActionsParams) ([]ListActionsRow, error)
+	GetThemeByID(ctx context.Context, arg GetThemeByIDParams) (GetThemeByIDRow, error)
+	GetThemeWithCounts(ctx context.Context, arg GetThemeWithCountsParams) (GetThemeWithCountsRow, error)
+	ListAPITokens(ctx context.Context, managerID string) ([]ListAPITokensRow, error)
+	// Oldest first, the order changes are worked out in.
+	ListActionRevisions(ctx context.Context, arg ListActionRevisionsParams) ([]ListActionRevisionsRow, error)
+	ListActionsByConversationID(ctx context.Context, arg ListActionsByConversationIDParams) ([]ListActionsByConversationIDRow, error)
+	ListActionsByConversationIDs(ctx context.Context, arg ListActionsByConversationIDsParams) ([]ListActionsByConversationIDsRow, error)
 	ListActionsByPersonID(ctx context.Context, arg ListActionsByPersonIDParams) ([]ListActionsByPersonIDRow, error)
 	ListActionsByPersonIDAndValence(ctx context.Context, arg ListActionsByPersonIDAndValenceParams) ([]ListActionsByPersonIDAndValenceRow, error)
 	ListActionsByThemeID(ctx context.Context, arg ListActionsByThemeIDParams) ([]ListActionsByThemeIDRow, error)
-	ListActionsByValence(ctx context.Context, arg ListActionsByValenceParams) ([]ListActionsByValenceRow, error)
+	// Every filter is optional; the WHERE clause must stay in step with CountActionsFiltered.
+	// The cursor is the sort column and ID of the last row already returned.
+	ListActionsFiltered(ctx context.Context, arg ListActionsFilteredParams) ([]ListActionsFilteredRow, error)
+	// Each action's themes come back as parallel arrays, newest theme first, so the
+	// agenda can group actions without a query per action.
+	ListAgendaActions(ctx context.Context, arg ListAgendaActionsParams) ([]ListAgendaActionsRow, error)
+	// Includes the attachments of trashed actions and conversations, so that
+	// purging them can find the files to remove.
+	ListAttachments(ctx context.Context, arg ListAttachmentsParams) ([]ListAttachmentsRow, error)
+	// A person filter also matches entries about the person's actions,
+	// conversations and themes, including ones in the trash.
+	ListAuditLog(ctx context.Context, arg ListAuditLogParams) ([]ListAuditLogRow, error)
+	ListConversationRevisions(ctx context.Context, arg ListConversationRevisionsParams) ([]ListConversationRevisionsRow, error)
+	ListConversations(ctx context.Context, arg ListConversationsParams) ([]ListConversationsRow, error)
+	ListConversationsByActionID(ctx context.Context, arg ListConversationsByActionIDParams) ([]ListConversationsByActionIDRow, error)
 	ListConversationsByPersonID(ctx context.Context, arg ListConversationsByPersonIDParams) ([]ListConversationsByPersonIDRow, error)
+	ListFollowUps(ctx context.Context, arg ListFollowUpsParams) ([]ListFollowUpsRow, error)
+	ListLadderLevels(ctx context.Context, managerID string) ([]ListLadderLevelsRow, error)
+	ListLevelExpectations(ctx context.Context, arg ListLevelExpectationsParams) ([]ListLevelExpectationsRow, error)
+	ListOrgChart(ctx context.Context, managerID string) ([]ListOrgChartRow, error)
+	// Storage keys of every file attached to the person's actions and
+	// conversations, trashed or not, for removing them when the person is purged.
+	ListPersonAttachmentKeys(ctx context.Context, arg ListPersonAttachmentKeysParams) ([]string, error)
 	ListPersons(ctx context.Context, arg ListPersonsParams) ([]ListPersonsRow, error)
 	ListPersonsWithLastActivity(ctx context.Context, arg ListPersonsWithLastActivityParams) ([]ListPersonsWithLastActivityRow, error)
+	ListReferencesByActionIDs(ctx context.Context, arg ListReferencesByActionIDsParams) ([]ListReferencesByActionIDsRow, error)
 	ListThemes(ctx context.Context, arg ListThemesParams) ([]ListThemesRow, error)
 	ListThemesByActionID(ctx context.Context, arg ListThemesByActionIDParams) ([]ListThemesByActionIDRow, error)
 	ListThemesByConversationID(ctx context.Context, arg ListThemesByConversationIDParams) ([]ListThemesByConversationIDRow, error)
+	ListThemesByConversationIDs(ctx context.Context, arg ListThemesByConversationIDsParams) ([]ListThemesByConversationIDsRow, error)
 	ListThemesByPersonID(ctx context.Context, arg ListThemesByPersonIDParams) ([]ListThemesByPersonIDRow, error)
+	ListThemesWithCounts(ctx context.Context, arg ListThemesWithCountsParams) ([]ListThemesWithCountsRow, error)
+	// One page of a person's actions and conversations, newest first, with the
+	// themes of each entry aggregated alongside it. theme_ids and theme_texts are
+	// built in the same order so they can be zipped back together. A valence
+	// filter only matches actions.
+	ListTimelineByPersonID(ctx context.Context, arg ListTimelineByPersonIDParams) ([]ListTimelineByPersonIDRow, error)
+	// Summarises the filtered timeline by period (week, month or quarter), taking
+	// periods in UTC so they line up with the dates the API reports.
+	ListTimelineGroups(ctx context.Context, arg ListTimelineGroupsParams) ([]ListTimelineGroupsRow, error)
+	// Records trashed along with their person are listed under the person only.
+	ListTrash(ctx context.Context, arg ListTrashParams) ([]ListTrashRow, error)
+	// The source theme's links are removed as they are copied, so the source is left
+	// with none. Actions already linked to the target keep their one link.
+	MoveActionThemes(ctx context.Context, arg MoveActionThemesParams) error
+	MoveActionToTheme(ctx context.Context, arg MoveActionToThemeParams) (int64, error)
+	// Moves rather than copies, like MoveActionThemes.
+	MoveConversationThemes(ctx context.Context, arg MoveConversationThemesParams) error
+	PurgeAction(ctx context.Context, arg PurgeActionParams) (int64, error)
+	PurgeConversation(ctx context.Context, arg PurgeConversationParams) (int64, error)
+	PurgePerson(ctx context.Context, arg PurgePersonParams) (int64, error)
+	PurgeTheme(ctx context.Context, arg PurgeThemeParams) (int64, error)
+	// Snapshots the action as it now stands. Nothing is written when the content
+	// matches the latest revision, so saving an unchanged form adds no history.
+	RecordActionRevision(ctx context.Context, arg RecordActionRevisionParams) error
+	RecordAudit(ctx context.Context, arg RecordAuditParams) error
+	RecordConversationRevision(ctx context.Context, arg RecordConversationRevisionParams) error
+	RemoveActionFromConversation(ctx context.Context, arg RemoveActionFromConversationParams) error
 	RemoveThemeFromAction(ctx context.Context, arg RemoveThemeFromActionParams) error
-	SearchActionsByDescription(ctx context.Context, arg SearchActionsByDescriptionParams) ([]SearchActionsByDescriptionRow, error)
+	RemoveThemeFromConversation(ctx context.Context, arg RemoveThemeFromConversationParams) error
+	// An action cannot come back while its person is still in the trash.
+	RestoreAction(ctx context.Context, arg RestoreActionParams) (int64, error)
+	RestoreConversation(ctx context.Context, arg RestoreConversationParams) (int64, error)
+	// Anything of theirs trashed separately before the person stays in the trash.
+	RestorePerson(ctx context.Context, arg RestorePersonParams) (int64, error)
+	RestoreTheme(ctx context.Context, arg RestoreThemeParams) (int64, error)
+	RevokeAPIToken(ctx context.Context, arg RevokeAPITokenParams) error
+	// The tsvector expressions match the GIN indexes in the add_search_indexes
+	// migration. Matches are wrapped in \x02 and \x03 so callers can highlight
+	// them without trusting any markup in the stored text.
+	Search(ctx context.Context, arg SearchParams) ([]SearchRow, error)
 	SearchPersonsByName(ctx context.Context, arg SearchPersonsByNameParams) ([]SearchPersonsByNameRow, error)
+	SetPersonLevels(ctx context.Context, arg SetPersonLevelsParams) (int64, error)
+	TouchAPIToken(ctx context.Context, id string) error
+	TrashAction(ctx context.Context, arg TrashActionParams) (int64, error)
+	TrashConversation(ctx context.Context, arg TrashConversationParams) (int64, error)
+	// The person's actions, conversations and themes go to the trash with them.
+	// NOW() is fixed for the statement, so everything shares one deleted_at and
+	// RestorePerson can bring back exactly that set.
+	TrashPerson(ctx context.Context, arg TrashPersonParams) (int64, error)
+	TrashTheme(ctx context.Context, arg TrashThemeParams) (int64, error)
 	UpdateAction(ctx context.Context, arg UpdateActionParams) (UpdateActionRow, error)
+	UpdateConversation(ctx context.Context, arg UpdateConversationParams) (UpdateConversationRow, error)
 	UpdatePerson(ctx context.Context, arg UpdatePersonParams) (UpdatePersonRow, error)
+	UpdateTheme(ctx context.Context, arg UpdateThemeParams) (UpdateThemeRow, error)
+	UpsertFrameworkTheme(ctx context.Context, arg UpsertFrameworkThemeParams) (UpsertFrameworkThemeRow, error)
+	UpsertLadderLevel(ctx context.Context, arg UpsertLadderLevelParams) (UpsertLadderLevelRow, error)
+	UpsertLevelExpectation(ctx context.Context, arg UpsertLevelExpectationParams) error
 }
 
 var _ Querier = (*Queries)(nil)
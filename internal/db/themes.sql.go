@@ -7,18 +7,49 @@ package db
 
 import (
 	"context"
+	"database/sql"
 )
 
+const countThemes = `-- name: CountThemes :one
+SELECT COUNT(*)
+FROM theme t
+WHERE t.manager_id = x2b($1) AND t.deleted_at IS NULL
+  AND ($2::text IS NULL OR t.person_id = x2b($2))
+  AND ($3::boolean OR t.archived_at IS NULL)
+  AND ($4::boolean IS NULL OR (t.person_id IS NULL) = $4)
+`
+
+type CountThemesParams struct {
+	ManagerID       string         `db:"manager_id" json:"manager_id"`
+	PersonID        sql.NullString `db:"person_id" json:"person_id"`
+	IncludeArchived bool           `db:"include_archived" json:"include_archived"`
+	Framework       sql.NullBool   `db:"framework" json:"framework"`
+}
+
+func (q *Queries) CountThemes(ctx context.Context, arg CountThemesParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countThemes,
+		arg.ManagerID,
+		arg.PersonID,
+		arg.IncludeArchived,
+		arg.Framework,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createTheme = `-- name: CreateTheme :one
-INSERT INTO theme (id, person_id, text)
-VALUES (x2b($1), x2b($2), $3)
-RETURNING theme.id, theme.person_id, theme.text, theme.created_at, theme.updated_at
+INSERT INTO theme (id, person_id, text, description, manager_id)
+VALUES (x2b($1), x2b($2), $3, $4, x2b($5))
+RETURNING theme.id, theme.person_id, theme.text, theme.created_at, theme.updated_at, theme.manager_id, theme.archived_at, theme.description, theme.deleted_at
 `
 
 type CreateThemeParams struct {
-	ID       string `db:"id" json:"id"`
-	PersonID string `db:"person_id" json:"person_id"`
-	Text     string `db:"text" json:"text"`
+	ID          string         `db:"id" json:"id"`
+	PersonID    sql.NullString `db:"person_id" json:"person_id"`
+	Text        string         `db:"text" json:"text"`
+	Description sql.NullString `db:"description" json:"description"`
+	ManagerID   string         `db:"manager_id" json:"manager_id"`
 }
 
 type CreateThemeRow struct {
@@ -26,7 +57,13 @@ type CreateThemeRow struct {
 }
 
 func (q *Queries) CreateTheme(ctx context.Context, arg CreateThemeParams) (CreateThemeRow, error) {
-	row := q.db.QueryRowContext(ctx, createTheme, arg.ID, arg.PersonID, arg.Text)
+	row := q.db.QueryRowContext(ctx, createTheme,
+		arg.ID,
+		arg.PersonID,
+		arg.Text,
+		arg.Description,
+		arg.ManagerID,
+	)
 	var i CreateThemeRow
 	err := row.Scan(
 		&i.Theme.ID,
@@ -34,32 +71,31 @@ func (q *Queries) CreateTheme(ctx context.Context, arg CreateThemeParams) (Creat
 		&i.Theme.Text,
 		&i.Theme.CreatedAt,
 		&i.Theme.UpdatedAt,
+		&i.Theme.ManagerID,
+		&i.Theme.ArchivedAt,
+		&i.Theme.Description,
+		&i.Theme.DeletedAt,
 	)
 	return i, err
 }
 
-const deleteTheme = `-- name: DeleteTheme :exec
-DELETE FROM theme
-WHERE id = x2b($1)
-`
-
-func (q *Queries) DeleteTheme(ctx context.Context, id string) error {
-	_, err := q.db.ExecContext(ctx, deleteTheme, id)
-	return err
-}
-
 const getThemeByID = `-- name: GetThemeByID :one
-SELECT theme.id, theme.person_id, theme.text, theme.created_at, theme.updated_at
+SELECT theme.id, theme.person_id, theme.text, theme.created_at, theme.updated_at, theme.manager_id, theme.archived_at, theme.description, theme.deleted_at
 FROM theme
-WHERE id = x2b($1)
+WHERE id = x2b($1) AND manager_id = x2b($2) AND deleted_at IS NULL
 `
 
+type GetThemeByIDParams struct {
+	ID        string `db:"id" json:"id"`
+	ManagerID string `db:"manager_id" json:"manager_id"`
+}
+
 type GetThemeByIDRow struct {
 	Theme Theme `db:"theme" json:"theme"`
 }
 
-func (q *Queries) GetThemeByID(ctx context.Context, id string) (GetThemeByIDRow, error) {
-	row := q.db.QueryRowContext(ctx, getThemeByID, id)
+func (q *Queries) GetThemeByID(ctx context.Context, arg GetThemeByIDParams) (GetThemeByIDRow, error) {
+	row := q.db.QueryRowContext(ctx, getThemeByID, arg.ID, arg.ManagerID)
 	var i GetThemeByIDRow
 	err := row.Scan(
 		&i.Theme.ID,
@@ -67,20 +103,67 @@ func (q *Queries) GetThemeByID(ctx context.Context, id string) (GetThemeByIDRow,
 		&i.Theme.Text,
 		&i.Theme.CreatedAt,
 		&i.Theme.UpdatedAt,
+		&i.Theme.ManagerID,
+		&i.Theme.ArchivedAt,
+		&i.Theme.Description,
+		&i.Theme.DeletedAt,
+	)
+	return i, err
+}
+
+const getThemeWithCounts = `-- name: GetThemeWithCounts :one
+SELECT
+    t.id, t.person_id, t.text, t.created_at, t.updated_at, t.manager_id, t.archived_at, t.description, t.deleted_at,
+    (SELECT COUNT(*) FROM action_theme at JOIN action a ON a.id = at.action_id
+     WHERE at.theme_id = t.id AND a.deleted_at IS NULL) AS action_count,
+    (SELECT COUNT(*) FROM conversation_theme ct JOIN conversation c ON c.id = ct.conversation_id
+     WHERE ct.theme_id = t.id AND c.deleted_at IS NULL) AS conversation_count
+FROM theme t
+WHERE t.id = x2b($1) AND t.manager_id = x2b($2) AND t.deleted_at IS NULL
+`
+
+type GetThemeWithCountsParams struct {
+	ID        string `db:"id" json:"id"`
+	ManagerID string `db:"manager_id" json:"manager_id"`
+}
+
+type GetThemeWithCountsRow struct {
+	Theme             Theme `db:"theme" json:"theme"`
+	ActionCount       int64 `db:"action_count" json:"action_count"`
+	ConversationCount int64 `db:"conversation_count" json:"conversation_count"`
+}
+
+func (q *Queries) GetThemeWithCounts(ctx context.Context, arg GetThemeWithCountsParams) (GetThemeWithCountsRow, error) {
+	row := q.db.QueryRowContext(ctx, getThemeWithCounts, arg.ID, arg.ManagerID)
+	var i GetThemeWithCountsRow
+	err := row.Scan(
+		&i.Theme.ID,
+		&i.Theme.PersonID,
+		&i.Theme.Text,
+		&i.Theme.CreatedAt,
+		&i.Theme.UpdatedAt,
+		&i.Theme.ManagerID,
+		&i.Theme.ArchivedAt,
+		&i.Theme.Description,
+		&i.Theme.DeletedAt,
+		&i.ActionCount,
+		&i.ConversationCount,
 	)
 	return i, err
 }
 
 const listThemes = `-- name: ListThemes :many
-SELECT theme.id, theme.person_id, theme.text, theme.created_at, theme.updated_at
+SELECT theme.id, theme.person_id, theme.text, theme.created_at, theme.updated_at, theme.manager_id, theme.archived_at, theme.description, theme.deleted_at
 FROM theme
+WHERE manager_id = x2b($1) AND deleted_at IS NULL
 ORDER BY created_at DESC
-LIMIT $2 OFFSET $1
+LIMIT $3 OFFSET $2
 `
 
 type ListThemesParams struct {
-	Offset int32 `db:"offset" json:"offset"`
-	Limit  int32 `db:"limit" json:"limit"`
+	ManagerID string `db:"manager_id" json:"manager_id"`
+	Offset    int32  `db:"offset" json:"offset"`
+	Limit     int32  `db:"limit" json:"limit"`
 }
 
 type ListThemesRow struct {
@@ -88,7 +171,7 @@ type ListThemesRow struct {
 }
 
 func (q *Queries) ListThemes(ctx context.Context, arg ListThemesParams) ([]ListThemesRow, error) {
-	rows, err := q.db.QueryContext(ctx, listThemes, arg.Offset, arg.Limit)
+	rows, err := q.db.QueryContext(ctx, listThemes, arg.ManagerID, arg.Offset, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -102,6 +185,10 @@ func (q *Queries) ListThemes(ctx context.Context, arg ListThemesParams) ([]ListT
 			&i.Theme.Text,
 			&i.Theme.CreatedAt,
 			&i.Theme.UpdatedAt,
+			&i.Theme.ManagerID,
+			&i.Theme.ArchivedAt,
+			&i.Theme.Description,
+			&i.Theme.DeletedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -117,17 +204,18 @@ func (q *Queries) ListThemes(ctx context.Context, arg ListThemesParams) ([]ListT
 }
 
 const listThemesByPersonID = `-- name: ListThemesByPersonID :many
-SELECT theme.id, theme.person_id, theme.text, theme.created_at, theme.updated_at
+SELECT theme.id, theme.person_id, theme.text, theme.created_at, theme.updated_at, theme.manager_id, theme.archived_at, theme.description, theme.deleted_at
 FROM theme
-WHERE person_id = x2b($1)
-ORDER BY created_at DESC
-LIMIT $3 OFFSET $2
+WHERE (person_id = x2b($1) OR person_id IS NULL) AND manager_id = x2b($2) AND deleted_at IS NULL
+ORDER BY person_id IS NULL DESC, created_at DESC
+LIMIT $4 OFFSET $3
 `
 
 type ListThemesByPersonIDParams struct {
-	PersonID string `db:"person_id" json:"person_id"`
-	Offset   int32  `db:"offset" json:"offset"`
-	Limit    int32  `db:"limit" json:"limit"`
+	PersonID  string `db:"person_id" json:"person_id"`
+	ManagerID string `db:"manager_id" json:"manager_id"`
+	Offset    int32  `db:"offset" json:"offset"`
+	Limit     int32  `db:"limit" json:"limit"`
 }
 
 type ListThemesByPersonIDRow struct {
@@ -135,7 +223,12 @@ type ListThemesByPersonIDRow struct {
 }
 
 func (q *Queries) ListThemesByPersonID(ctx context.Context, arg ListThemesByPersonIDParams) ([]ListThemesByPersonIDRow, error) {
-	rows, err := q.db.QueryContext(ctx, listThemesByPersonID, arg.PersonID, arg.Offset, arg.Limit)
+	rows, err := q.db.QueryContext(ctx, listThemesByPersonID,
+		arg.PersonID,
+		arg.ManagerID,
+		arg.Offset,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -149,6 +242,83 @@ func (q *Queries) ListThemesByPersonID(ctx context.Context, arg ListThemesByPers
 			&i.Theme.Text,
 			&i.Theme.CreatedAt,
 			&i.Theme.UpdatedAt,
+			&i.Theme.ManagerID,
+			&i.Theme.ArchivedAt,
+			&i.Theme.Description,
+			&i.Theme.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listThemesWithCounts = `-- name: ListThemesWithCounts :many
+SELECT
+    t.id, t.person_id, t.text, t.created_at, t.updated_at, t.manager_id, t.archived_at, t.description, t.deleted_at,
+    (SELECT COUNT(*) FROM action_theme at JOIN action a ON a.id = at.action_id
+     WHERE at.theme_id = t.id AND a.deleted_at IS NULL) AS action_count,
+    (SELECT COUNT(*) FROM conversation_theme ct JOIN conversation c ON c.id = ct.conversation_id
+     WHERE ct.theme_id = t.id AND c.deleted_at IS NULL) AS conversation_count
+FROM theme t
+WHERE t.manager_id = x2b($1) AND t.deleted_at IS NULL
+  AND ($2::text IS NULL OR t.person_id = x2b($2))
+  AND ($3::boolean OR t.archived_at IS NULL)
+  AND ($4::boolean IS NULL OR (t.person_id IS NULL) = $4)
+ORDER BY t.text
+LIMIT $6 OFFSET $5
+`
+
+type ListThemesWithCountsParams struct {
+	ManagerID       string         `db:"manager_id" json:"manager_id"`
+	PersonID        sql.NullString `db:"person_id" json:"person_id"`
+	IncludeArchived bool           `db:"include_archived" json:"include_archived"`
+	Framework       sql.NullBool   `db:"framework" json:"framework"`
+	Offset          int32          `db:"offset" json:"offset"`
+	Limit           int32          `db:"limit" json:"limit"`
+}
+
+type ListThemesWithCountsRow struct {
+	Theme             Theme `db:"theme" json:"theme"`
+	ActionCount       int64 `db:"action_count" json:"action_count"`
+	ConversationCount int64 `db:"conversation_count" json:"conversation_count"`
+}
+
+func (q *Queries) ListThemesWithCounts(ctx context.Context, arg ListThemesWithCountsParams) ([]ListThemesWithCountsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listThemesWithCounts,
+		arg.ManagerID,
+		arg.PersonID,
+		arg.IncludeArchived,
+		arg.Framework,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListThemesWithCountsRow{}
+	for rows.Next() {
+		var i ListThemesWithCountsRow
+		if err := rows.Scan(
+			&i.Theme.ID,
+			&i.Theme.PersonID,
+			&i.Theme.Text,
+			&i.Theme.CreatedAt,
+			&i.Theme.UpdatedAt,
+			&i.Theme.ManagerID,
+			&i.Theme.ArchivedAt,
+			&i.Theme.Description,
+			&i.Theme.DeletedAt,
+			&i.ActionCount,
+			&i.ConversationCount,
 		); err != nil {
 			return nil, err
 		}
@@ -162,3 +332,86 @@ func (q *Queries) ListThemesByPersonID(ctx context.Context, arg ListThemesByPers
 	}
 	return items, nil
 }
+
+const updateTheme = `-- name: UpdateTheme :one
+UPDATE theme
+SET text = $1,
+    archived_at = CASE WHEN $2::boolean THEN COALESCE(archived_at, NOW()) ELSE NULL END,
+    updated_at = NOW()
+WHERE id = x2b($3) AND manager_id = x2b($4) AND deleted_at IS NULL
+RETURNING theme.id, theme.person_id, theme.text, theme.created_at, theme.updated_at, theme.manager_id, theme.archived_at, theme.description, theme.deleted_at
+`
+
+type UpdateThemeParams struct {
+	Text      string `db:"text" json:"text"`
+	Archived  bool   `db:"archived" json:"archived"`
+	ID        string `db:"id" json:"id"`
+	ManagerID string `db:"manager_id" json:"manager_id"`
+}
+
+type UpdateThemeRow struct {
+	Theme Theme `db:"theme" json:"theme"`
+}
+
+func (q *Queries) UpdateTheme(ctx context.Context, arg UpdateThemeParams) (UpdateThemeRow, error) {
+	row := q.db.QueryRowContext(ctx, updateTheme,
+		arg.Text,
+		arg.Archived,
+		arg.ID,
+		arg.ManagerID,
+	)
+	var i UpdateThemeRow
+	err := row.Scan(
+		&i.Theme.ID,
+		&i.Theme.PersonID,
+		&i.Theme.Text,
+		&i.Theme.CreatedAt,
+		&i.Theme.UpdatedAt,
+		&i.Theme.ManagerID,
+		&i.Theme.ArchivedAt,
+		&i.Theme.Description,
+		&i.Theme.DeletedAt,
+	)
+	return i, err
+}
+
+const upsertFrameworkTheme = `-- name: UpsertFrameworkTheme :one
+INSERT INTO theme (id, person_id, text, description, manager_id)
+VALUES (x2b($1), NULL, $2, $3, x2b($4))
+ON CONFLICT (manager_id, LOWER(text)) WHERE person_id IS NULL AND deleted_at IS NULL
+DO UPDATE SET description = EXCLUDED.description, updated_at = NOW()
+RETURNING theme.id, theme.person_id, theme.text, theme.created_at, theme.updated_at, theme.manager_id, theme.archived_at, theme.description, theme.deleted_at
+`
+
+type UpsertFrameworkThemeParams struct {
+	ID          string         `db:"id" json:"id"`
+	Text        string         `db:"text" json:"text"`
+	Description sql.NullString `db:"description" json:"description"`
+	ManagerID   string         `db:"manager_id" json:"manager_id"`
+}
+
+type UpsertFrameworkThemeRow struct {
+	Theme Theme `db:"theme" json:"theme"`
+}
+
+func (q *Queries) UpsertFrameworkTheme(ctx context.Context, arg UpsertFrameworkThemeParams) (UpsertFrameworkThemeRow, error) {
+	row := q.db.QueryRowContext(ctx, upsertFrameworkTheme,
+		arg.ID,
+		arg.Text,
+		arg.Description,
+		arg.ManagerID,
+	)
+	var i UpsertFrameworkThemeRow
+	err := row.Scan(
+		&i.Theme.ID,
+		&i.Theme.PersonID,
+		&i.Theme.Text,
+		&i.Theme.CreatedAt,
+		&i.Theme.UpdatedAt,
+		&i.Theme.ManagerID,
+		&i.Theme.ArchivedAt,
+		&i.Theme.Description,
+		&i.Theme.DeletedAt,
+	)
+	return i, err
+}
@@ -0,0 +1,164 @@
+// Package framework loads a competency framework file into the manager's framework
+// themes, which can be attached to any report's actions and conversations.
+//
+// The file is YAML or JSON. Levels are optional, listed from most junior to most
+// senior, and say what each level expects for a competency:
+//
+//	competencies:
+//	  - name: Technical leadership
+//	    description: Sets direction for the team's technical work
+//	  - name: Communication
+//	levels:
+//	  - name: L4
+//	    expectations:
+//	      - competency: Technical leadership
+//	        description: Leads well-scoped projects end to end
+package framework
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/rs/xid"
+	"gopkg.in/yaml.v3"
+
+	"pepo/internal/db"
+)
+
+type Competency struct {
+	Name        string `yaml:"name" json:"name"`
+	Description string `yaml:"description" json:"description"`
+}
+
+type Expectation struct {
+	Competency  string `yaml:"competency" json:"competency"`
+	Description string `yaml:"description" json:"description"`
+}
+
+type Level struct {
+	Name         string        `yaml:"name" json:"name"`
+	Description  string        `yaml:"description" json:"description"`
+	Expectations []Expectation `yaml:"expectations" json:"expectations"`
+}
+
+type Framework struct {
+	Competencies []Competency `yaml:"competencies" json:"competencies"`
+	Levels       []Level      `yaml:"levels" json:"levels"`
+}
+
+// Parse reads a YAML or JSON framework. Competency names are required and must be
+// unique ignoring case, matching how framework themes are stored; every expectation
+// must name a competency from the same file.
+func Parse(data []byte) (*Framework, error) {
+	var f Framework
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("invalid framework file: %w", err)
+	}
+	if len(f.Competencies) == 0 {
+		return nil, fmt.Errorf("framework file has no competencies")
+	}
+
+	seen := map[string]bool{}
+	for i := range f.Competencies {
+		c := &f.Competencies[i]
+		c.Name = strings.TrimSpace(c.Name)
+		c.Description = strings.TrimSpace(c.Description)
+		if c.Name == "" {
+			return nil, fmt.Errorf("competency %d has no name", i+1)
+		}
+		key := strings.ToLower(c.Name)
+		if seen[key] {
+			return nil, fmt.Errorf("competency %q is listed twice", c.Name)
+		}
+		seen[key] = true
+	}
+
+	levels := map[string]bool{}
+	for i := range f.Levels {
+		l := &f.Levels[i]
+		l.Name = strings.TrimSpace(l.Name)
+		l.Description = strings.TrimSpace(l.Description)
+		if l.Name == "" {
+			return nil, fmt.Errorf("level %d has no name", i+1)
+		}
+		if levels[l.Name] {
+			return nil, fmt.Errorf("level %q is listed twice", l.Name)
+		}
+		levels[l.Name] = true
+
+		competencies := map[string]bool{}
+		for j := range l.Expectations {
+			e := &l.Expectations[j]
+			e.Competency = strings.TrimSpace(e.Competency)
+			e.Description = strings.TrimSpace(e.Description)
+			key := strings.ToLower(e.Competency)
+			if !seen[key] {
+				return nil, fmt.Errorf("level %q expects unknown competency %q", l.Name, e.Competency)
+			}
+			if competencies[key] {
+				return nil, fmt.Errorf("level %q lists %q twice", l.Name, e.Competency)
+			}
+			competencies[key] = true
+			if e.Description == "" {
+				return nil, fmt.Errorf("level %q has no description for %q", l.Name, e.Competency)
+			}
+		}
+	}
+	return &f, nil
+}
+
+// Import creates a framework theme for each competency and a ladder level for each
+// level, or refreshes ones that already exist, so importing the same file twice is
+// harmless. It runs in one transaction and returns how many competencies were written.
+func Import(ctx context.Context, conn *sql.DB, queries *db.Queries, managerID string, f *Framework) (int, error) {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	qtx := queries.WithTx(tx)
+
+	themeIDs := map[string]string{}
+	for _, c := range f.Competencies {
+		row, err := qtx.UpsertFrameworkTheme(ctx, db.UpsertFrameworkThemeParams{
+			ID:          xid.New().String(),
+			Text:        c.Name,
+			Description: sql.NullString{String: c.Description, Valid: c.Description != ""},
+			ManagerID:   managerID,
+		})
+		if err != nil {
+			return 0, fmt.Errorf("importing %q: %w", c.Name, err)
+		}
+		themeIDs[strings.ToLower(c.Name)] = row.Theme.ID.String()
+	}
+
+	for i, l := range f.Levels {
+		row, err := qtx.UpsertLadderLevel(ctx, db.UpsertLadderLevelParams{
+			ID:          xid.New().String(),
+			ManagerID:   managerID,
+			Name:        l.Name,
+			Rank:        int32(i + 1),
+			Description: sql.NullString{String: l.Description, Valid: l.Description != ""},
+		})
+		if err != nil {
+			return 0, fmt.Errorf("importing level %q: %w", l.Name, err)
+		}
+		for _, e := range l.Expectations {
+			if err := qtx.UpsertLevelExpectation(ctx, db.UpsertLevelExpectationParams{
+				ID:          xid.New().String(),
+				LevelID:     row.LadderLevel.ID.String(),
+				ThemeID:     themeIDs[strings.ToLower(e.Competency)],
+				Description: e.Description,
+			}); err != nil {
+				return 0, fmt.Errorf("importing level %q: %w", l.Name, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return len(f.Competencies), nil
+}
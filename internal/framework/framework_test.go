@@ -0,0 +1,71 @@
+package framework
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	t.Run("reads YAML", func(t *testing.T) {
+		f, err := Parse([]byte(`
+competencies:
+  - name: " Technical leadership "
+    description: Sets direction for the team's technical work
+  - name: Communication
+`))
+		if err != nil {
+			t.Fatalf("Parse returned error: %v", err)
+		}
+		if len(f.Competencies) != 2 {
+			t.Fatalf("Expected 2 competencies, got %d", len(f.Competencies))
+		}
+		if f.Competencies[0].Name != "Technical leadership" {
+			t.Errorf("Expected trimmed name, got %q", f.Competencies[0].Name)
+		}
+		if f.Competencies[1].Description != "" {
+			t.Errorf("Expected empty description, got %q", f.Competencies[1].Description)
+		}
+	})
+
+	t.Run("reads JSON", func(t *testing.T) {
+		f, err := Parse([]byte(`{"competencies": [{"name": "Execution", "description": "Ships reliably"}]}`))
+		if err != nil {
+			t.Fatalf("Parse returned error: %v", err)
+		}
+		if len(f.Competencies) != 1 || f.Competencies[0].Description != "Ships reliably" {
+			t.Errorf("Unexpected competencies: %+v", f.Competencies)
+		}
+	})
+
+	t.Run("reads levels", func(t *testing.T) {
+		f, err := Parse([]byte(`
+competencies:
+  - name: Communication
+levels:
+  - name: L3
+    expectations:
+      - competency: communication
+        description: Keeps the team informed
+  - name: L4
+`))
+		if err != nil {
+			t.Fatalf("Parse returned error: %v", err)
+		}
+		if len(f.Levels) != 2 || len(f.Levels[0].Expectations) != 1 {
+			t.Errorf("Unexpected levels: %+v", f.Levels)
+		}
+	})
+
+	t.Run("rejects bad files", func(t *testing.T) {
+		cases := map[string]string{
+			"empty":          "competencies: []",
+			"missing name":   "competencies:\n  - description: no name",
+			"duplicate name": "competencies:\n  - name: Communication\n  - name: communication",
+			"not a mapping":  "- Communication",
+			"unknown competency": "competencies:\n  - name: Communication\nlevels:\n  - name: L3\n    expectations:\n" +
+				"      - competency: Delivery\n        description: Ships",
+		}
+		for name, data := range cases {
+			if _, err := Parse([]byte(data)); err == nil {
+				t.Errorf("%s: expected an error", name)
+			}
+		}
+	})
+}
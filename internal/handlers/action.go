@@ -4,24 +4,30 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strings"
 
 	"github.com/rs/xid"
 
 	"pepo/internal/api"
+	"pepo/internal/auth"
+	"pepo/internal/cursor"
 	"pepo/internal/db"
+	"pepo/internal/references"
 	"pepo/templates"
 
 	"go.uber.org/zap"
 )
 
 type ActionHandler struct {
+	conn    *sql.DB
 	queries *db.Queries
 }
 
-func NewActionHandler(queries *db.Queries) *ActionHandler {
+func NewActionHandler(conn *sql.DB, queries *db.Queries) *ActionHandler {
 	return &ActionHandler{
+		conn:    conn,
 		queries: queries,
 	}
 }
@@ -38,8 +44,8 @@ func convertToAPIAction(action db.Action) api.Action {
 		UpdatedAt:   action.UpdatedAt,
 	}
 
-	if action.References.Valid {
-		apiAction.References = api.OptNilString{Value: action.References.String, Set: true}
+	if action.Impact.Valid {
+		apiAction.Impact = api.NewOptNilActionImpact(api.ActionImpact(action.Impact.ImpactLevel))
 	}
 
 	return apiAction
@@ -48,20 +54,79 @@ func convertToAPIAction(action db.Action) api.Action {
 // API Handlers
 
 func (h *ActionHandler) CreateAction(ctx context.Context, req *api.CreateActionRequest) (api.CreateActionRes, error) {
+	managerID := auth.ManagerID(ctx)
+
+	// The person must belong to the signed-in manager
+	if _, err := h.queries.GetPersonByID(ctx, db.GetPersonByIDParams{
+		ID:        req.PersonID,
+		ManagerID: managerID,
+	}); err != nil {
+		if err == sql.ErrNoRows {
+			return &api.CreateActionBadRequest{
+				Message: "Person not found",
+				Code:    "INVALID_PERSON",
+			}, nil
+		}
+		zap.L().Error("error getting person", zap.Error(err))
+		return &api.CreateActionInternalServerError{
+			Message: "Failed to create action",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	// Check the themes before anything is written, so a bad one leaves no action behind
+	if msg, code, err := h.checkActionLinks(ctx, managerID, req.PersonID, req.Themes, nil); err != nil {
+		zap.L().Error("error checking action links", zap.Error(err))
+		return &api.CreateActionInternalServerError{
+			Message: "Failed to create action",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	} else if msg != "" {
+		return &api.CreateActionBadRequest{
+			Message: msg,
+			Code:    code,
+		}, nil
+	}
+
+	entries, msg := referenceEntries(req.References)
+	if msg != "" {
+		return &api.CreateActionBadRequest{
+			Message: msg,
+			Code:    "VALIDATION_ERROR",
+		}, nil
+	}
+
 	// Generate new xid for the action
 	actionID := xid.New().String()
 
+	impact := db.NullImpactLevel{}
+	if v, ok := req.Impact.Get(); ok {
+		impact = db.NullImpactLevel{ImpactLevel: db.ImpactLevel(v), Valid: true}
+	}
+
 	// Use the provided occurred_at time
 	occurredAt := req.OccurredAt
 
+	tx, err := h.conn.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return &api.CreateActionInternalServerError{
+			Message: "Failed to create action",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+	defer tx.Rollback()
+	qtx := h.queries.WithTx(tx)
+
 	// Create action in database
-	row, err := h.queries.CreateAction(ctx, db.CreateActionParams{
+	row, err := qtx.CreateAction(ctx, db.CreateActionParams{
 		ID:          actionID,
 		PersonID:    req.PersonID,
 		OccurredAt:  occurredAt,
 		Description: req.Description,
-		References:  sql.NullString{String: req.References.Or(""), Valid: req.References.IsSet()},
 		Valence:     db.ValenceType(req.Valence),
+		Impact:      impact,
+		ManagerID:   managerID,
 	})
 	action := row.Action
 	if err != nil {
@@ -74,22 +139,10 @@ func (h *ActionHandler) CreateAction(ctx context.Context, req *api.CreateActionR
 
 	// Associate provided themes with the new action
 	for _, tID := range req.Themes {
-		if _, err := h.queries.GetThemeByID(ctx, tID); err != nil {
-			if err == sql.ErrNoRows {
-				return &api.CreateActionBadRequest{
-					Message: "Theme not found",
-					Code:    "INVALID_THEME",
-				}, nil
-			}
-			zap.L().Error("error getting theme", zap.Error(err))
-			return &api.CreateActionInternalServerError{
-				Message: "Failed to associate theme",
-				Code:    "INTERNAL_ERROR",
-			}, nil
-		}
-		if err := h.queries.AddThemeToAction(ctx, db.AddThemeToActionParams{
-			ActionID: actionID,
-			ThemeID:  tID,
+		if err := qtx.AddThemeToAction(ctx, db.AddThemeToActionParams{
+			ActionID:  actionID,
+			ThemeID:   tID,
+			ManagerID: managerID,
 		}); err != nil {
 			zap.L().Error("error adding theme to action", zap.Error(err))
 			return &api.CreateActionInternalServerError{
@@ -99,6 +152,30 @@ func (h *ActionHandler) CreateAction(ctx context.Context, req *api.CreateActionR
 		}
 	}
 
+	if err := saveReferences(ctx, qtx, actionID, entries); err != nil {
+		zap.L().Error("error saving action references", zap.Error(err))
+		return &api.CreateActionInternalServerError{
+			Message: "Failed to save references",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	if err := recordActionRevision(ctx, qtx, managerID, actionID); err != nil {
+		zap.L().Error("error recording action revision", zap.Error(err))
+		return &api.CreateActionInternalServerError{
+			Message: "Failed to save revision",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		zap.L().Error("error committing action", zap.Error(err))
+		return &api.CreateActionInternalServerError{
+			Message: "Failed to create action",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
 	// Convert to API response
 	apiAction := &api.Action{
 		ID:          action.ID.String(),
@@ -110,15 +187,21 @@ func (h *ActionHandler) CreateAction(ctx context.Context, req *api.CreateActionR
 		UpdatedAt:   action.UpdatedAt,
 	}
 
-	if action.References.Valid {
-		apiAction.References = api.OptNilString{Value: action.References.String, Set: true}
+	if action.Impact.Valid {
+		apiAction.Impact = api.NewOptNilActionImpact(api.ActionImpact(action.Impact.ImpactLevel))
+	}
+	for _, e := range entries {
+		apiAction.References = append(apiAction.References, apiReference(e))
 	}
 
 	return apiAction, nil
 }
 
 func (h *ActionHandler) GetActionById(ctx context.Context, params api.GetActionByIdParams) (api.GetActionByIdRes, error) {
-	row, err := h.queries.GetActionByID(ctx, params.ID)
+	row, err := h.queries.GetActionByID(ctx, db.GetActionByIDParams{
+		ID:        params.ID,
+		ManagerID: auth.ManagerID(ctx),
+	})
 	action := row.Action
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -144,10 +227,11 @@ func (h *ActionHandler) GetActionById(ctx context.Context, params api.GetActionB
 		UpdatedAt:   action.UpdatedAt,
 	}
 
-	if action.References.Valid {
-		apiAction.References = api.OptNilString{Value: action.References.String, Set: true}
+	if action.Impact.Valid {
+		apiAction.Impact = api.NewOptNilActionImpact(api.ActionImpact(action.Impact.ImpactLevel))
 	}
 
+	h.loadActionLinks(ctx, auth.ManagerID(ctx), apiAction)
 	return apiAction, nil
 }
 
@@ -162,87 +246,125 @@ func (h *ActionHandler) GetActions(ctx context.Context, params api.GetActionsPar
 		offset = int32(params.Offset.Value)
 	}
 
-	var apiActions []api.Action
-	var total int64
-	var err error
+	filter := actionFilter(auth.ManagerID(ctx), params)
+	sort := string(params.Sort.Or(api.GetActionsSortOccurredAtDesc))
 
-	// Handle different filtering options
-	if params.PersonID.IsSet() && params.Valence.IsSet() {
-		// Filter by both person and valence
-		rows, err := h.queries.ListActionsByPersonIDAndValence(ctx, db.ListActionsByPersonIDAndValenceParams{
-			PersonID: params.PersonID.Value,
-			Valence:  db.ValenceType(params.Valence.Value),
-			Offset:   offset,
-			Limit:    limit,
-		})
-		if err == nil {
-			apiActions = make([]api.Action, len(rows))
-			for i, row := range rows {
-				a := row.Action
-				apiActions[i] = convertToAPIAction(a)
-			}
-			total, err = h.queries.CountActionsByPersonID(ctx, params.PersonID.Value)
-		}
-	} else if params.PersonID.IsSet() {
-		// Filter by person only
-		rows, err := h.queries.ListActionsByPersonID(ctx, db.ListActionsByPersonIDParams{
-			PersonID: params.PersonID.Value,
-			Offset:   offset,
-			Limit:    limit,
-		})
-		if err == nil {
-			apiActions = make([]api.Action, len(rows))
-			for i, row := range rows {
-				a := row.Action
-				apiActions[i] = convertToAPIAction(a)
-			}
-			total, err = h.queries.CountActionsByPersonID(ctx, params.PersonID.Value)
-		}
-	} else if params.Valence.IsSet() {
-		// Filter by valence only
-		rows, err := h.queries.ListActionsByValence(ctx, db.ListActionsByValenceParams{
-			Valence: db.ValenceType(params.Valence.Value),
-			Offset:  offset,
-			Limit:   limit,
-		})
-		if err == nil {
-			apiActions = make([]api.Action, len(rows))
-			for i, row := range rows {
-				a := row.Action
-				apiActions[i] = convertToAPIAction(a)
-			}
-			total, err = h.queries.CountActions(ctx)
-		}
-	} else {
-		// No filters
-		rows, err := h.queries.ListActions(ctx, db.ListActionsParams{
-			Offset: offset,
-			Limit:  limit,
-		})
-		if err == nil {
-			apiActions = make([]api.Action, len(rows))
-			for i, row := range rows {
-				a := row.Action
-				action := convertToAPIAction(a)
-				action.PersonName = api.NewOptString(row.PersonName)
-				apiActions[i] = action
-			}
-			total, err = h.queries.CountActions(ctx)
+	apiActions, next, err := h.actionPage(ctx, filter, sort, params.Cursor.Or(""), offset, limit)
+	if err != nil {
+		if errors.Is(err, cursor.ErrInvalid) {
+			return &api.GetActionsBadRequest{
+				Message: "Invalid cursor",
+				Code:    "VALIDATION_ERROR",
+			}, nil
 		}
+		zap.L().Error("error listing actions", zap.Error(err))
+		return &api.GetActionsInternalServerError{
+			Message: "Failed to list actions",
+			Code:    "INTERNAL_ERROR",
+		}, nil
 	}
 
+	total, err := h.queries.CountActionsFiltered(ctx, filter)
 	if err != nil {
-		zap.L().Error("error listing actions", zap.Error(err))
-		return &api.Error{
+		zap.L().Error("error counting actions", zap.Error(err))
+		return &api.GetActionsInternalServerError{
 			Message: "Failed to list actions",
 			Code:    "INTERNAL_ERROR",
 		}, nil
 	}
 
-	return &api.GetActionsOKApplicationJSON{
+	res := &api.GetActionsOKApplicationJSON{
 		Actions: apiActions,
 		Total:   int(total),
-	}, nil
+	}
+	if next != "" {
+		res.NextCursor = api.NewOptString(next)
+	}
+	return res, nil
+}
+
+// actionPage lists one page of actions matching the filter. A cursor takes
+// precedence over the offset; the returned cursor is empty on the last page.
+func (h *ActionHandler) actionPage(ctx context.Context, filter db.CountActionsFilteredParams, sort, after string, offset, limit int32) ([]api.Action, string, error) {
+	params := db.ListActionsFilteredParams{
+		ManagerID: filter.ManagerID,
+		PersonID:  filter.PersonID,
+		Valence:   filter.Valence,
+		ThemeID:   filter.ThemeID,
+		Since:     filter.Since,
+		Before:    filter.Before,
+		Query:     filter.Query,
+		Discussed: filter.Discussed,
+		Sort:      sort,
+		Offset:    offset,
+		// One extra row tells us whether another page follows
+		Limit: limit + 1,
+	}
+	if after != "" {
+		at, id, err := cursor.Decode(after)
+		if err != nil {
+			return nil, "", err
+		}
+		params.CursorAt = sql.NullTime{Time: at, Valid: true}
+		params.CursorID = sql.NullString{String: id, Valid: true}
+		params.Offset = 0
+	}
+
+	rows, err := h.queries.ListActionsFiltered(ctx, params)
+	if err != nil {
+		return nil, "", err
+	}
+
+	next := ""
+	if len(rows) > int(limit) {
+		rows = rows[:limit]
+		last := rows[len(rows)-1].Action
+		key := last.OccurredAt
+		if strings.HasPrefix(sort, "created_at") {
+			key = last.CreatedAt
+		}
+		next = cursor.Encode(key, last.ID.String())
+	}
+
+	apiActions := make([]api.Action, len(rows))
+	for i, row := range rows {
+		action := convertToAPIAction(row.Action)
+		action.PersonName = api.NewOptString(row.PersonName)
+		apiActions[i] = action
+	}
+	if err := attachReferences(ctx, h.queries, filter.ManagerID, apiActions); err != nil {
+		return nil, "", err
+	}
+	return apiActions, next, nil
+}
+
+// actionFilter turns the list parameters into the filter shared by the list and
+// count queries. Unset parameters stay NULL and match every action.
+func actionFilter(managerID string, params api.GetActionsParams) db.CountActionsFilteredParams {
+	filter := db.CountActionsFilteredParams{ManagerID: managerID}
+	if id, ok := params.PersonID.Get(); ok {
+		filter.PersonID = sql.NullString{String: id, Valid: true}
+	}
+	if v, ok := params.Valence.Get(); ok {
+		filter.Valence = db.NullValenceType{ValenceType: db.ValenceType(v), Valid: true}
+	}
+	if id, ok := params.ThemeID.Get(); ok {
+		filter.ThemeID = sql.NullString{String: id, Valid: true}
+	}
+	if from, ok := params.From.Get(); ok {
+		filter.Since = sql.NullTime{Time: from, Valid: true}
+	}
+	// "to" includes the whole day
+	if to, ok := params.To.Get(); ok {
+		filter.Before = sql.NullTime{Time: to.AddDate(0, 0, 1), Valid: true}
+	}
+	if q := strings.TrimSpace(params.Q.Or("")); q != "" {
+		filter.Query = sql.NullString{String: q, Valid: true}
+	}
+	if discussed, ok := params.Discussed.Get(); ok {
+		filter.Discussed = sql.NullBool{Bool: discussed, Valid: true}
+	}
+	return filter
 }
 
 func (h *ActionHandler) UpdateAction(ctx context.Context, req *api.UpdateActionRequest, params api.UpdateActionParams) (api.UpdateActionRes, error) {
@@ -254,15 +376,73 @@ func (h *ActionHandler) UpdateAction(ctx context.Context, req *api.UpdateActionR
 		}, nil
 	}
 
-	row, err := h.queries.UpdateAction(ctx, db.UpdateActionParams{
+	managerID := auth.ManagerID(ctx)
+
+	// The person must belong to the signed-in manager
+	if _, err := h.queries.GetPersonByID(ctx, db.GetPersonByIDParams{
+		ID:        req.PersonID,
+		ManagerID: managerID,
+	}); err != nil {
+		if err == sql.ErrNoRows {
+			return &api.UpdateActionBadRequest{
+				Message: "Person not found",
+				Code:    "INVALID_PERSON",
+			}, nil
+		}
+		zap.L().Error("error getting person", zap.Error(err))
+		return &api.UpdateActionInternalServerError{
+			Message: "Failed to update action",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	if msg, code, err := h.checkActionLinks(ctx, managerID, req.PersonID, req.Themes, req.Conversations); err != nil {
+		zap.L().Error("error checking action links", zap.Error(err))
+		return &api.UpdateActionInternalServerError{
+			Message: "Failed to update action",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	} else if msg != "" {
+		return &api.UpdateActionBadRequest{
+			Message: msg,
+			Code:    code,
+		}, nil
+	}
+
+	entries, msg := referenceEntries(req.References)
+	if msg != "" {
+		return &api.UpdateActionBadRequest{
+			Message: msg,
+			Code:    "VALIDATION_ERROR",
+		}, nil
+	}
+
+	impact := db.NullImpactLevel{}
+	if v, ok := req.Impact.Get(); ok {
+		impact = db.NullImpactLevel{ImpactLevel: db.ImpactLevel(v), Valid: true}
+	}
+
+	// The row and its links change together, so a failed link never leaves a half-edited action
+	tx, err := h.conn.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return &api.UpdateActionInternalServerError{
+			Message: "Failed to update action",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+	defer tx.Rollback()
+	qtx := h.queries.WithTx(tx)
+
+	row, err := qtx.UpdateAction(ctx, db.UpdateActionParams{
 		ID:          params.ID,
 		PersonID:    req.PersonID,
 		OccurredAt:  req.OccurredAt,
 		Description: req.Description,
-		References:  sql.NullString{String: req.References.Or(""), Valid: req.References.IsSet()},
 		Valence:     db.ValenceType(req.Valence),
+		Impact:      impact,
+		ManagerID:   managerID,
 	})
-	action := row.Action
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return &api.UpdateActionNotFound{
@@ -277,70 +457,304 @@ func (h *ActionHandler) UpdateAction(ctx context.Context, req *api.UpdateActionR
 		}, nil
 	}
 
-	// Update themes based on request data
-	selected := map[string]bool{}
-	for _, t := range req.Themes {
-		selected[t] = true
+	if err := syncActionLinks(ctx, qtx, managerID, params.ID, req.Themes, req.Conversations); err != nil {
+		zap.L().Error("error updating action links", zap.Error(err))
+		return &api.UpdateActionInternalServerError{
+			Message: "Failed to update themes and conversations",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	if err := saveReferences(ctx, qtx, params.ID, entries); err != nil {
+		zap.L().Error("error saving action references", zap.Error(err))
+		return &api.UpdateActionInternalServerError{
+			Message: "Failed to save references",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	if err := recordActionRevision(ctx, qtx, managerID, params.ID); err != nil {
+		zap.L().Error("error recording action revision", zap.Error(err))
+		return &api.UpdateActionInternalServerError{
+			Message: "Failed to save revision",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		zap.L().Error("error committing action update", zap.Error(err))
+		return &api.UpdateActionInternalServerError{
+			Message: "Failed to update action",
+			Code:    "INTERNAL_ERROR",
+		}, nil
 	}
 
-	existingRows, err := h.queries.ListThemesByActionID(ctx, db.ListThemesByActionIDParams{
-		ActionID: params.ID,
-		Offset:   0,
-		Limit:    100,
+	apiAction := convertToAPIAction(row.Action)
+	h.loadActionLinks(ctx, managerID, &apiAction)
+	return &apiAction, nil
+}
+
+// checkActionLinks verifies that every theme belongs to the manager and every
+// conversation is one with the action's person. A non-empty message describes
+// the first link that is not allowed.
+func (h *ActionHandler) checkActionLinks(ctx context.Context, managerID, personID string, themeIDs, conversationIDs []string) (string, string, error) {
+	for _, tID := range themeIDs {
+		if _, err := h.queries.GetThemeByID(ctx, db.GetThemeByIDParams{
+			ID:        tID,
+			ManagerID: managerID,
+		}); err != nil {
+			if err == sql.ErrNoRows {
+				return "Theme not found", "INVALID_THEME", nil
+			}
+			return "", "", err
+		}
+	}
+	for _, cID := range conversationIDs {
+		row, err := h.queries.GetConversationByID(ctx, db.GetConversationByIDParams{
+			ID:        cID,
+			ManagerID: managerID,
+		})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return "Conversation not found", "INVALID_CONVERSATION", nil
+			}
+			return "", "", err
+		}
+		if convPersonID, _ := xid.FromBytes(row.Conversation.PersonID); convPersonID.String() != personID {
+			return "Conversation is with a different person", "INVALID_CONVERSATION", nil
+		}
+	}
+	return "", "", nil
+}
+
+// syncActionLinks makes the action's themes and conversations match the given
+// IDs, adding missing links and removing the rest.
+func syncActionLinks(ctx context.Context, q *db.Queries, managerID, actionID string, themeIDs, conversationIDs []string) error {
+	if err := syncActionThemes(ctx, q, managerID, actionID, themeIDs); err != nil {
+		return err
+	}
+
+	conversationRows, err := q.ListConversationsByActionID(ctx, db.ListConversationsByActionIDParams{
+		ActionID:  actionID,
+		ManagerID: managerID,
+		Offset:    0,
+		Limit:     100,
 	})
-	if err == nil {
-		existing := map[string]bool{}
-		for _, row := range existingRows {
-			id := row.Theme.ID.String()
-			existing[id] = true
-			if !selected[id] {
-				if err := h.queries.RemoveThemeFromAction(ctx, db.RemoveThemeFromActionParams{
-					ActionID: params.ID,
-					ThemeID:  id,
-				}); err != nil {
-					zap.L().Error("error removing theme from action", zap.Error(err))
-					return &api.UpdateActionInternalServerError{
-						Message: "Failed to update themes",
-						Code:    "INTERNAL_ERROR",
-					}, nil
-				}
+	if err != nil {
+		return err
+	}
+	selectedConversations := map[string]bool{}
+	for _, id := range conversationIDs {
+		selectedConversations[id] = true
+	}
+	existingConversations := map[string]bool{}
+	for _, row := range conversationRows {
+		convID, _ := xid.FromBytes(row.Conversation.ID)
+		id := convID.String()
+		existingConversations[id] = true
+		if !selectedConversations[id] {
+			if err := q.RemoveActionFromConversation(ctx, db.RemoveActionFromConversationParams{
+				ConversationID: id,
+				ActionID:       actionID,
+				ManagerID:      managerID,
+			}); err != nil {
+				return err
 			}
 		}
-		for id := range selected {
-			if !existing[id] {
-				if err := h.queries.AddThemeToAction(ctx, db.AddThemeToActionParams{
-					ActionID: params.ID,
-					ThemeID:  id,
-				}); err != nil {
-					zap.L().Error("error adding theme to action", zap.Error(err))
-					return &api.UpdateActionInternalServerError{
-						Message: "Failed to update themes",
-						Code:    "INTERNAL_ERROR",
-					}, nil
-				}
+	}
+	for id := range selectedConversations {
+		if !existingConversations[id] {
+			if err := q.AddActionToConversation(ctx, db.AddActionToConversationParams{
+				ActionID:       actionID,
+				ConversationID: id,
+				ManagerID:      managerID,
+			}); err != nil {
+				return err
 			}
 		}
 	}
 
-	apiAction := &api.Action{
-		ID:          action.ID.String(),
-		PersonID:    action.PersonID.String(),
-		OccurredAt:  action.OccurredAt,
-		Description: action.Description,
-		Valence:     api.ActionValence(action.Valence),
-		CreatedAt:   action.CreatedAt,
-		UpdatedAt:   action.UpdatedAt,
+	return nil
+}
+
+// syncActionThemes makes the action's themes match the given IDs
+func syncActionThemes(ctx context.Context, q *db.Queries, managerID, actionID string, themeIDs []string) error {
+	themeRows, err := q.ListThemesByActionID(ctx, db.ListThemesByActionIDParams{
+		ActionID:  actionID,
+		ManagerID: managerID,
+		Offset:    0,
+		Limit:     100,
+	})
+	if err != nil {
+		return err
+	}
+	selectedThemes := map[string]bool{}
+	for _, id := range themeIDs {
+		selectedThemes[id] = true
+	}
+	existingThemes := map[string]bool{}
+	for _, row := range themeRows {
+		id := row.Theme.ID.String()
+		existingThemes[id] = true
+		if !selectedThemes[id] {
+			if err := q.RemoveThemeFromAction(ctx, db.RemoveThemeFromActionParams{
+				ActionID:  actionID,
+				ThemeID:   id,
+				ManagerID: managerID,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	for id := range selectedThemes {
+		if !existingThemes[id] {
+			if err := q.AddThemeToAction(ctx, db.AddThemeToActionParams{
+				ActionID:  actionID,
+				ThemeID:   id,
+				ManagerID: managerID,
+			}); err != nil {
+				return err
+			}
+		}
 	}
 
-	if action.References.Valid {
-		apiAction.References = api.OptNilString{Value: action.References.String, Set: true}
+	return nil
+}
+
+// loadActionLinks fills in the action's themes and conversation IDs
+func (h *ActionHandler) loadActionLinks(ctx context.Context, managerID string, action *api.Action) {
+	if themeRows, err := h.queries.ListThemesByActionID(ctx, db.ListThemesByActionIDParams{
+		ActionID:  action.ID,
+		ManagerID: managerID,
+		Offset:    0,
+		Limit:     100,
+	}); err == nil {
+		action.Themes = make([]api.Theme, len(themeRows))
+		for i, row := range themeRows {
+			action.Themes[i] = api.Theme{ID: row.Theme.ID.String(), Text: row.Theme.Text}
+		}
+	} else {
+		zap.L().Error("error listing action themes", zap.Error(err))
 	}
 
-	return apiAction, nil
+	if conversationRows, err := h.queries.ListConversationsByActionID(ctx, db.ListConversationsByActionIDParams{
+		ActionID:  action.ID,
+		ManagerID: managerID,
+		Offset:    0,
+		Limit:     100,
+	}); err == nil {
+		action.Conversations = make([]string, len(conversationRows))
+		for i, row := range conversationRows {
+			convID, _ := xid.FromBytes(row.Conversation.ID)
+			action.Conversations[i] = convID.String()
+		}
+	} else {
+		zap.L().Error("error listing action conversations", zap.Error(err))
+	}
+
+	if refs, err := listReferences(ctx, h.queries, managerID, []string{action.ID}); err == nil {
+		action.References = refs[action.ID]
+	} else {
+		zap.L().Error("error listing action references", zap.Error(err))
+	}
+}
+
+// referenceEntries validates submitted references, classifying any sent
+// without a kind. A non-empty message describes the first invalid one.
+func referenceEntries(inputs []api.ReferenceInput) ([]references.Entry, string) {
+	entries := make([]references.Entry, 0, len(inputs))
+	for _, in := range inputs {
+		value := strings.TrimSpace(in.Value)
+		if value == "" {
+			return nil, "Reference value is required"
+		}
+		entry := references.Entry{Value: value, Title: strings.TrimSpace(in.Title.Or(""))}
+		if kind, ok := in.Kind.Get(); ok {
+			entry.Kind = references.Kind(kind)
+		} else {
+			entry.Kind = references.Classify(value)
+		}
+		entries = append(entries, entry)
+	}
+	return entries, ""
+}
+
+// saveReferences replaces the action's references, keeping the given order
+func saveReferences(ctx context.Context, q *db.Queries, actionID string, entries []references.Entry) error {
+	if err := q.DeleteActionReferences(ctx, actionID); err != nil {
+		return err
+	}
+	for i, e := range entries {
+		if err := q.AddActionReference(ctx, db.AddActionReferenceParams{
+			ActionID: actionID,
+			Position: int32(i),
+			Kind:     db.ReferenceKind(e.Kind),
+			Value:    e.Value,
+			Title:    sql.NullString{String: e.Title, Valid: e.Title != ""},
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listReferences loads the references of several actions at once, keyed by action ID
+func listReferences(ctx context.Context, q *db.Queries, managerID string, actionIDs []string) (map[string][]api.Reference, error) {
+	refs := map[string][]api.Reference{}
+	if len(actionIDs) == 0 {
+		return refs, nil
+	}
+	rows, err := q.ListReferencesByActionIDs(ctx, db.ListReferencesByActionIDsParams{
+		ActionIds: actionIDs,
+		ManagerID: managerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		refs[row.ActionID] = append(refs[row.ActionID], apiReference(references.Entry{
+			Kind:  references.Kind(row.Kind),
+			Value: row.Value,
+			Title: row.Title.String,
+		}))
+	}
+	return refs, nil
+}
+
+// attachReferences fills in the references of every action in the slice
+func attachReferences(ctx context.Context, q *db.Queries, managerID string, actions []api.Action) error {
+	ids := make([]string, len(actions))
+	for i, a := range actions {
+		ids[i] = a.ID
+	}
+	refs, err := listReferences(ctx, q, managerID, ids)
+	if err != nil {
+		return err
+	}
+	for i := range actions {
+		actions[i].References = refs[actions[i].ID]
+	}
+	return nil
+}
+
+func apiReference(e references.Entry) api.Reference {
+	ref := api.Reference{Kind: api.ReferenceKind(e.Kind), Value: e.Value}
+	if e.Title != "" {
+		ref.Title = api.NewOptNilString(e.Title)
+	}
+	if href := e.Href(); href != "" {
+		ref.URL = api.NewOptNilString(href)
+	}
+	return ref
 }
 
+// DeleteAction moves the action to the trash; its links and attachments stay
+// until it is purged
 func (h *ActionHandler) DeleteAction(ctx context.Context, params api.DeleteActionParams) (api.DeleteActionRes, error) {
-	err := h.queries.DeleteAction(ctx, params.ID)
+	trashed, err := h.queries.TrashAction(ctx, db.TrashActionParams{
+		ID:        params.ID,
+		ManagerID: auth.ManagerID(ctx),
+	})
 	if err != nil {
 		zap.L().Error("error deleting action", zap.Error(err))
 		return &api.DeleteActionInternalServerError{
@@ -348,6 +762,12 @@ func (h *ActionHandler) DeleteAction(ctx context.Context, params api.DeleteActio
 			Code:    "INTERNAL_ERROR",
 		}, nil
 	}
+	if trashed == 0 {
+		return &api.DeleteActionNotFound{
+			Message: "Action not found",
+			Code:    "NOT_FOUND",
+		}, nil
+	}
 
 	return &api.DeleteActionNoContent{}, nil
 }
@@ -363,40 +783,22 @@ func (h *ActionHandler) GetPersonActions(ctx context.Context, params api.GetPers
 		offset = int32(params.Offset.Value)
 	}
 
-	var apiActions []api.Action
-	var err error
-
-	if params.Valence.IsSet() {
-		rows, err := h.queries.ListActionsByPersonIDAndValence(ctx, db.ListActionsByPersonIDAndValenceParams{
-			PersonID: params.ID,
-			Valence:  db.ValenceType(params.Valence.Value),
-			Offset:   offset,
-			Limit:    limit,
-		})
-		if err == nil {
-			apiActions = make([]api.Action, len(rows))
-			for i, r := range rows {
-				a := r.Action
-				apiActions[i] = convertToAPIAction(a)
-			}
-		}
-	} else {
-		rows, err := h.queries.ListActionsByPersonID(ctx, db.ListActionsByPersonIDParams{
-			PersonID: params.ID,
-			Offset:   offset,
-			Limit:    limit,
-		})
-		if err == nil {
-			apiActions = make([]api.Action, len(rows))
-			for i, r := range rows {
-				a := r.Action
-
-				apiActions[i] = convertToAPIAction(a)
-			}
-		}
+	filter := db.CountActionsFilteredParams{
+		ManagerID: auth.ManagerID(ctx),
+		PersonID:  sql.NullString{String: params.ID, Valid: true},
+	}
+	if v, ok := params.Valence.Get(); ok {
+		filter.Valence = db.NullValenceType{ValenceType: db.ValenceType(v), Valid: true}
 	}
 
+	apiActions, next, err := h.actionPage(ctx, filter, string(api.GetActionsSortOccurredAtDesc), params.Cursor.Or(""), offset, limit)
 	if err != nil {
+		if errors.Is(err, cursor.ErrInvalid) {
+			return &api.GetPersonActionsBadRequest{
+				Message: "Invalid cursor",
+				Code:    "VALIDATION_ERROR",
+			}, nil
+		}
 		zap.L().Error("error getting person actions", zap.Error(err))
 		return &api.GetPersonActionsInternalServerError{
 			Message: "Failed to get person actions",
@@ -404,8 +806,7 @@ func (h *ActionHandler) GetPersonActions(ctx context.Context, params api.GetPers
 		}, nil
 	}
 
-	// Get total count for this person
-	total, err := h.queries.CountActionsByPersonID(ctx, params.ID)
+	total, err := h.queries.CountActionsFiltered(ctx, filter)
 	if err != nil {
 		zap.L().Error("error counting person actions", zap.Error(err))
 		return &api.GetPersonActionsInternalServerError{
@@ -414,10 +815,14 @@ func (h *ActionHandler) GetPersonActions(ctx context.Context, params api.GetPers
 		}, nil
 	}
 
-	return &api.GetPersonActionsOKApplicationJSON{
+	res := &api.GetPersonActionsOKApplicationJSON{
 		Actions: apiActions,
 		Total:   int(total),
-	}, nil
+	}
+	if next != "" {
+		res.NextCursor = api.NewOptString(next)
+	}
+	return res, nil
 }
 
 func (h *ActionHandler) HandleGetThemesForSelect(w http.ResponseWriter, r *http.Request) {
@@ -428,12 +833,28 @@ func (h *ActionHandler) HandleGetThemesForSelect(w http.ResponseWriter, r *http.
 		return
 	}
 
+	managerID := auth.ManagerID(r.Context())
+
 	selected := map[string]bool{}
 	if actionID := r.URL.Query().Get("action_id"); actionID != "" {
 		rows, err := h.queries.ListThemesByActionID(r.Context(), db.ListThemesByActionIDParams{
-			ActionID: actionID,
-			Offset:   0,
-			Limit:    100,
+			ActionID:  actionID,
+			ManagerID: managerID,
+			Offset:    0,
+			Limit:     100,
+		})
+		if err == nil {
+			for _, row := range rows {
+				selected[row.Theme.ID.String()] = true
+			}
+		}
+	}
+	if conversationID := r.URL.Query().Get("conversation_id"); conversationID != "" {
+		rows, err := h.queries.ListThemesByConversationID(r.Context(), db.ListThemesByConversationIDParams{
+			ConversationID: conversationID,
+			ManagerID:      managerID,
+			Offset:         0,
+			Limit:          100,
 		})
 		if err == nil {
 			for _, row := range rows {
@@ -443,9 +864,10 @@ func (h *ActionHandler) HandleGetThemesForSelect(w http.ResponseWriter, r *http.
 	}
 
 	rows, err := h.queries.ListThemesByPersonID(r.Context(), db.ListThemesByPersonIDParams{
-		PersonID: personID,
-		Offset:   0,
-		Limit:    100,
+		PersonID:  personID,
+		ManagerID: managerID,
+		Offset:    0,
+		Limit:     100,
 	})
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
@@ -453,20 +875,31 @@ func (h *ActionHandler) HandleGetThemesForSelect(w http.ResponseWriter, r *http.
 		return
 	}
 
-	themes := make([]templates.Theme, len(rows))
-	for i, row := range rows {
-		t := row.Theme
-		themes[i] = templates.Theme{
-			ID:       t.ID.String(),
-			Text:     t.Text,
-			Selected: selected[t.ID.String()],
-		}
-	}
+	themes := selectableThemes(rows, selected)
 
 	w.Header().Set("Content-Type", "text/html")
 	templates.ThemeSelectOptions(themes).Render(r.Context(), w)
 }
 
+// selectableThemes drops archived themes from the picker unless the record already uses them,
+// so saving an edit form never silently unlinks one
+func selectableThemes(rows []db.ListThemesByPersonIDRow, selected map[string]bool) []templates.Theme {
+	themes := make([]templates.Theme, 0, len(rows))
+	for _, row := range rows {
+		t := row.Theme
+		if t.ArchivedAt.Valid && !selected[t.ID.String()] {
+			continue
+		}
+		themes = append(themes, templates.Theme{
+			ID:        t.ID.String(),
+			Text:      t.Text,
+			Framework: t.PersonID.IsNil(),
+			Selected:  selected[t.ID.String()],
+		})
+	}
+	return themes
+}
+
 func (h *ActionHandler) HandleCreateTheme(w http.ResponseWriter, r *http.Request) {
 	var req struct {
 		PersonID string   `json:"person_id"`
@@ -487,11 +920,28 @@ func (h *ActionHandler) HandleCreateTheme(w http.ResponseWriter, r *http.Request
 		return
 	}
 
+	managerID := auth.ManagerID(r.Context())
+
+	if _, err := h.queries.GetPersonByID(r.Context(), db.GetPersonByIDParams{
+		ID:        personID,
+		ManagerID: managerID,
+	}); err != nil {
+		if err == sql.ErrNoRows {
+			w.WriteHeader(http.StatusBadRequest)
+		} else {
+			zap.L().Error("error getting person", zap.Error(err))
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+		templates.ThemeSelectError().Render(r.Context(), w)
+		return
+	}
+
 	themeID := xid.New().String()
 	if _, err := h.queries.CreateTheme(r.Context(), db.CreateThemeParams{
-		ID:       themeID,
-		PersonID: personID,
-		Text:     text,
+		ID:        themeID,
+		PersonID:  sql.NullString{String: personID, Valid: true},
+		Text:      text,
+		ManagerID: managerID,
 	}); err != nil {
 		zap.L().Error("error creating theme", zap.Error(err))
 		w.WriteHeader(http.StatusInternalServerError)
@@ -506,9 +956,10 @@ func (h *ActionHandler) HandleCreateTheme(w http.ResponseWriter, r *http.Request
 	selected[themeID] = true
 
 	rows, err := h.queries.ListThemesByPersonID(r.Context(), db.ListThemesByPersonIDParams{
-		PersonID: personID,
-		Offset:   0,
-		Limit:    100,
+		PersonID:  personID,
+		ManagerID: managerID,
+		Offset:    0,
+		Limit:     100,
 	})
 	if err != nil {
 		zap.L().Error("error listing themes", zap.Error(err))
@@ -517,15 +968,7 @@ func (h *ActionHandler) HandleCreateTheme(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	themes := make([]templates.Theme, len(rows))
-	for i, row := range rows {
-		t := row.Theme
-		themes[i] = templates.Theme{
-			ID:       t.ID.String(),
-			Text:     t.Text,
-			Selected: selected[t.ID.String()],
-		}
-	}
+	themes := selectableThemes(rows, selected)
 
 	w.Header().Set("Content-Type", "text/html")
 	templates.ThemeSelectOptions(themes).Render(r.Context(), w)
@@ -533,29 +976,81 @@ func (h *ActionHandler) HandleCreateTheme(w http.ResponseWriter, r *http.Request
 
 func (h *ActionHandler) HandleGetActionsForSelect(w http.ResponseWriter, r *http.Request) {
 	personID := r.URL.Query().Get("person_id")
-	if personID == "" {
+	if personID == "" && r.URL.Query().Get("theme_id") == "" {
 		w.WriteHeader(http.StatusBadRequest)
 		templates.ActionSelectError().Render(r.Context(), w)
 		return
 	}
 
-	rows, err := h.queries.ListActionsByPersonID(r.Context(), db.ListActionsByPersonIDParams{
-		PersonID: personID,
-		Offset:   0,
-		Limit:    100,
-	})
-	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		templates.ActionSelectError().Render(r.Context(), w)
-		return
+	managerID := auth.ManagerID(r.Context())
+
+	selected := map[string]bool{}
+	// A new conversation starts with the person's undiscussed actions selected
+	if r.URL.Query().Get("agenda") == "true" {
+		rows, err := h.queries.ListAgendaActions(r.Context(), db.ListAgendaActionsParams{
+			PersonID:  personID,
+			ManagerID: managerID,
+		})
+		if err == nil {
+			for _, row := range rows {
+				selected[row.Action.ID.String()] = true
+			}
+		}
+	}
+	if conversationID := r.URL.Query().Get("conversation_id"); conversationID != "" {
+		rows, err := h.queries.ListActionsByConversationID(r.Context(), db.ListActionsByConversationIDParams{
+			ConversationID: conversationID,
+			ManagerID:      managerID,
+			Offset:         0,
+			Limit:          100,
+		})
+		if err == nil {
+			for _, row := range rows {
+				selected[row.Action.ID.String()] = true
+			}
+		}
+	}
+
+	var rows []db.Action
+	if themeID := r.URL.Query().Get("theme_id"); themeID != "" {
+		// Splitting a theme only offers the actions that carry it
+		themed, err := h.queries.ListActionsByThemeID(r.Context(), db.ListActionsByThemeIDParams{
+			ThemeID:   themeID,
+			ManagerID: managerID,
+			Offset:    0,
+			Limit:     100,
+		})
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			templates.ActionSelectError().Render(r.Context(), w)
+			return
+		}
+		for _, row := range themed {
+			rows = append(rows, row.Action)
+		}
+	} else {
+		all, err := h.queries.ListActionsByPersonID(r.Context(), db.ListActionsByPersonIDParams{
+			PersonID:  personID,
+			ManagerID: managerID,
+			Offset:    0,
+			Limit:     100,
+		})
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			templates.ActionSelectError().Render(r.Context(), w)
+			return
+		}
+		for _, row := range all {
+			rows = append(rows, row.Action)
+		}
 	}
 
 	actions := make([]templates.ActionOption, len(rows))
-	for i, row := range rows {
-		a := row.Action
+	for i, a := range rows {
 		actions[i] = templates.ActionOption{
 			ID:          a.ID.String(),
 			Description: a.Description,
+			Selected:    selected[a.ID.String()],
 		}
 	}
 
@@ -0,0 +1,301 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	"github.com/rs/xid"
+	"go.uber.org/zap"
+
+	"pepo/internal/auth"
+	"pepo/internal/blobstore"
+	"pepo/internal/db"
+	"pepo/templates"
+)
+
+// maxAttachmentSize caps a single upload at 10 MB
+const maxAttachmentSize = 10 << 20
+
+// allowedAttachmentTypes are matched against the sniffed content type, not the
+// one the browser claims, so a renamed executable is still rejected
+var allowedAttachmentTypes = map[string]bool{
+	"image/png":       true,
+	"image/jpeg":      true,
+	"image/gif":       true,
+	"image/webp":      true,
+	"application/pdf": true,
+	"text/plain":      true,
+}
+
+type AttachmentHandler struct {
+	queries *db.Queries
+	blobs   blobstore.Store
+}
+
+func NewAttachmentHandler(queries *db.Queries, blobs blobstore.Store) *AttachmentHandler {
+	return &AttachmentHandler{queries: queries, blobs: blobs}
+}
+
+// attachmentParent reads which action or conversation a request is about
+func attachmentParent(r *http.Request) (field, id string) {
+	if id := strings.TrimSpace(r.FormValue("action_id")); id != "" {
+		return "action_id", id
+	}
+	if id := strings.TrimSpace(r.FormValue("conversation_id")); id != "" {
+		return "conversation_id", id
+	}
+	return "", ""
+}
+
+// HandleAttachments lists a parent's attachments on GET and uploads a new one on POST
+func (h *AttachmentHandler) HandleAttachments(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		field, parentID := attachmentParent(r)
+		if parentID == "" {
+			http.Error(w, "action_id or conversation_id is required", http.StatusBadRequest)
+			return
+		}
+		h.renderList(w, r, field, parentID)
+	case http.MethodPost:
+		h.upload(w, r)
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *AttachmentHandler) upload(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	managerID := auth.ManagerID(ctx)
+
+	// Leave room for the multipart envelope around the file itself
+	r.Body = http.MaxBytesReader(w, r.Body, maxAttachmentSize+1<<20)
+	if err := r.ParseMultipartForm(1 << 20); err != nil {
+		http.Error(w, "Attachments can be at most 10 MB", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	field, parentID := attachmentParent(r)
+	if parentID == "" {
+		http.Error(w, "action_id or conversation_id is required", http.StatusBadRequest)
+		return
+	}
+	if err := h.checkParent(ctx, managerID, field, parentID); err != nil {
+		if err == sql.ErrNoRows {
+			http.Error(w, "Action or conversation not found", http.StatusNotFound)
+			return
+		}
+		zap.L().Error("error checking attachment parent", zap.Error(err))
+		http.Error(w, "Failed to upload attachment", http.StatusInternalServerError)
+		return
+	}
+
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		http.Error(w, "Choose a file to attach", http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+	if header.Size > maxAttachmentSize {
+		http.Error(w, "Attachments can be at most 10 MB", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		http.Error(w, "Failed to read file", http.StatusBadRequest)
+		return
+	}
+	head = head[:n]
+	contentType := http.DetectContentType(head)
+	if mediaType, _, _ := mime.ParseMediaType(contentType); !allowedAttachmentTypes[mediaType] {
+		http.Error(w, "Only images, PDFs and text files can be attached", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	hash := sha256.New()
+	body := io.TeeReader(io.MultiReader(bytes.NewReader(head), file), hash)
+
+	// Blobs are stored under the attachment's own ID, so two uploads never share one
+	id := xid.New().String()
+	if err := h.blobs.Put(ctx, id, body); err != nil {
+		zap.L().Error("error storing attachment", zap.Error(err))
+		http.Error(w, "Failed to upload attachment", http.StatusInternalServerError)
+		return
+	}
+
+	params := db.CreateAttachmentParams{
+		ID:          id,
+		ManagerID:   managerID,
+		Filename:    attachmentFilename(header.Filename),
+		ContentType: contentType,
+		SizeBytes:   header.Size,
+		Sha256:      hex.EncodeToString(hash.Sum(nil)),
+		StorageKey:  id,
+	}
+	if field == "action_id" {
+		params.ActionID = sql.NullString{String: parentID, Valid: true}
+	} else {
+		params.ConversationID = sql.NullString{String: parentID, Valid: true}
+	}
+	if _, err := h.queries.CreateAttachment(ctx, params); err != nil {
+		zap.L().Error("error creating attachment", zap.Error(err))
+		if err := h.blobs.Delete(ctx, id); err != nil {
+			zap.L().Error("error removing orphaned attachment", zap.Error(err))
+		}
+		http.Error(w, "Failed to upload attachment", http.StatusInternalServerError)
+		return
+	}
+
+	h.renderList(w, r, field, parentID)
+}
+
+func (h *AttachmentHandler) checkParent(ctx context.Context, managerID, field, parentID string) error {
+	if field == "action_id" {
+		_, err := h.queries.GetActionByID(ctx, db.GetActionByIDParams{ID: parentID, ManagerID: managerID})
+		return err
+	}
+	_, err := h.queries.GetConversationByID(ctx, db.GetConversationByIDParams{ID: parentID, ManagerID: managerID})
+	return err
+}
+
+// attachmentFilename keeps only the base name the browser sent
+func attachmentFilename(name string) string {
+	name = strings.TrimSpace(filepath.Base(strings.ReplaceAll(name, `\`, "/")))
+	if name == "" || name == "." || name == "/" {
+		return "attachment"
+	}
+	return name
+}
+
+func (h *AttachmentHandler) renderList(w http.ResponseWriter, r *http.Request, field, parentID string) {
+	params := db.ListAttachmentsParams{ManagerID: auth.ManagerID(r.Context())}
+	if field == "action_id" {
+		params.ActionID = sql.NullString{String: parentID, Valid: true}
+	} else {
+		params.ConversationID = sql.NullString{String: parentID, Valid: true}
+	}
+
+	rows, err := h.queries.ListAttachments(r.Context(), params)
+	if err != nil {
+		zap.L().Error("error listing attachments", zap.Error(err))
+		http.Error(w, "Failed to load attachments", http.StatusInternalServerError)
+		return
+	}
+
+	attachments := make([]templates.Attachment, len(rows))
+	for i, row := range rows {
+		attachments[i] = templates.Attachment{
+			ID:          row.Attachment.ID.String(),
+			Filename:    row.Attachment.Filename,
+			ContentType: row.Attachment.ContentType,
+			SizeBytes:   row.Attachment.SizeBytes,
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	templates.AttachmentList(field, parentID, attachments).Render(r.Context(), w)
+}
+
+// HandleAttachment downloads an attachment on GET and removes it on DELETE
+func (h *AttachmentHandler) HandleAttachment(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/attachments/")
+	if id == "" || strings.Contains(id, "/") {
+		http.NotFound(w, r)
+		return
+	}
+
+	ctx := r.Context()
+	managerID := auth.ManagerID(ctx)
+
+	// Looking the row up by manager is what keeps one manager out of another's files
+	row, err := h.queries.GetAttachmentByID(ctx, db.GetAttachmentByIDParams{ID: id, ManagerID: managerID})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			http.NotFound(w, r)
+			return
+		}
+		zap.L().Error("error getting attachment", zap.Error(err))
+		http.Error(w, "Failed to load attachment", http.StatusInternalServerError)
+		return
+	}
+	a := row.Attachment
+
+	switch r.Method {
+	case http.MethodGet:
+		blob, err := h.blobs.Open(ctx, a.StorageKey)
+		if err != nil {
+			if errors.Is(err, blobstore.ErrNotFound) {
+				http.NotFound(w, r)
+				return
+			}
+			zap.L().Error("error opening attachment", zap.Error(err))
+			http.Error(w, "Failed to load attachment", http.StatusInternalServerError)
+			return
+		}
+		defer blob.Close()
+
+		// Images open in the browser; everything else downloads
+		disposition := "attachment"
+		if strings.HasPrefix(a.ContentType, "image/") {
+			disposition = "inline"
+		}
+		w.Header().Set("Content-Type", a.ContentType)
+		w.Header().Set("Content-Disposition", mime.FormatMediaType(disposition, map[string]string{"filename": a.Filename}))
+		w.Header().Set("ETag", `"`+a.Sha256+`"`)
+		if _, err := io.Copy(w, blob); err != nil {
+			zap.L().Error("error sending attachment", zap.Error(err))
+		}
+	case http.MethodDelete:
+		if _, err := h.queries.DeleteAttachment(ctx, db.DeleteAttachmentParams{ID: id, ManagerID: managerID}); err != nil {
+			zap.L().Error("error deleting attachment", zap.Error(err))
+			http.Error(w, "Failed to delete attachment", http.StatusInternalServerError)
+			return
+		}
+		removeBlobs(ctx, h.blobs, []string{a.StorageKey})
+
+		if !a.ActionID.IsNil() {
+			h.renderList(w, r, "action_id", a.ActionID.String())
+		} else {
+			h.renderList(w, r, "conversation_id", a.ConversationID.String())
+		}
+	default:
+		w.Header().Set("Allow", "GET, DELETE")
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// attachmentKeys returns the storage keys of a parent's attachments, so they can
+// be removed from the blob store once the database rows are gone
+func attachmentKeys(ctx context.Context, queries *db.Queries, params db.ListAttachmentsParams) ([]string, error) {
+	rows, err := queries.ListAttachments(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, len(rows))
+	for i, row := range rows {
+		keys[i] = row.Attachment.StorageKey
+	}
+	return keys, nil
+}
+
+// removeBlobs deletes blobs whose rows are already gone. Failures only leave
+// unreachable files behind, so they are logged rather than returned.
+func removeBlobs(ctx context.Context, blobs blobstore.Store, keys []string) {
+	for _, key := range keys {
+		if err := blobs.Delete(ctx, key); err != nil {
+			zap.L().Error("error removing attachment blob", zap.String("key", key), zap.Error(err))
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+
+	"go.uber.org/zap"
+
+	"pepo/internal/api"
+	"pepo/internal/auth"
+	"pepo/internal/db"
+)
+
+type AuditHandler struct {
+	queries *db.Queries
+}
+
+func NewAuditHandler(queries *db.Queries) *AuditHandler {
+	return &AuditHandler{queries: queries}
+}
+
+// ListAuditLog returns one page of the manager's audit log, newest first, with
+// the number of entries matching the filters
+func (h *AuditHandler) ListAuditLog(ctx context.Context, params api.ListAuditLogParams) (api.ListAuditLogRes, error) {
+	args := db.ListAuditLogParams{
+		ManagerID: auth.ManagerID(ctx),
+		Limit:     int32(params.Limit.Or(50)),
+		Offset:    int32(params.Offset.Or(0)),
+	}
+	if op, ok := params.Operation.Get(); ok && op != "" {
+		args.Operation = sql.NullString{String: op, Valid: true}
+	}
+	if via, ok := params.Via.Get(); ok {
+		args.AuthMethod = sql.NullString{String: string(via), Valid: true}
+	}
+	if id, ok := params.EntityID.Get(); ok {
+		args.EntityID = sql.NullString{String: id, Valid: true}
+	}
+	if id, ok := params.PersonID.Get(); ok {
+		args.PersonID = sql.NullString{String: id, Valid: true}
+	}
+	if from, ok := params.From.Get(); ok {
+		args.Since = sql.NullTime{Time: from, Valid: true}
+	}
+	// "to" names a whole day, so the cut-off is the start of the next one
+	if to, ok := params.To.Get(); ok {
+		args.Before = sql.NullTime{Time: to.AddDate(0, 0, 1), Valid: true}
+	}
+
+	rows, err := h.queries.ListAuditLog(ctx, args)
+	if err != nil {
+		zap.L().Error("error listing audit log", zap.Error(err))
+		return &api.Error{
+			Message: "Failed to list audit log",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+	total, err := h.queries.CountAuditLog(ctx, db.CountAuditLogParams{
+		ManagerID:  args.ManagerID,
+		Operation:  args.Operation,
+		AuthMethod: args.AuthMethod,
+		EntityID:   args.EntityID,
+		PersonID:   args.PersonID,
+		Since:      args.Since,
+		Before:     args.Before,
+	})
+	if err != nil {
+		zap.L().Error("error counting audit log", zap.Error(err))
+		return &api.Error{
+			Message: "Failed to list audit log",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	entries := make([]api.AuditEntry, len(rows))
+	for i, row := range rows {
+		entries[i] = api.AuditEntry{
+			ID:         row.ID,
+			Operation:  row.Operation,
+			EntityIds:  row.EntityIds,
+			Via:        api.AuditEntryVia(row.AuthMethod),
+			Method:     row.Method,
+			Path:       row.Path,
+			RemoteAddr: row.RemoteAddr,
+			UserAgent:  row.UserAgent,
+			Outcome:    row.Outcome,
+			CreatedAt:  row.CreatedAt,
+		}
+	}
+	return &api.ListAuditLogOKApplicationJSON{Entries: entries, Total: int(total)}, nil
+}
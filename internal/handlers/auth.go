@@ -0,0 +1,124 @@
+package handlers
+
+import (
+	"database/sql"
+	"net/http"
+	"strings"
+	"sync"
+
+	"pepo/internal/auth"
+	"pepo/internal/db"
+	"pepo/templates"
+
+	"go.uber.org/zap"
+)
+
+// dummyPasswordHash is verified against when the email is unknown so that
+// failed logins take the same time whether or not the account exists.
+var dummyPasswordHash = sync.OnceValue(func() string {
+	hash, _ := auth.HashPassword("pepo-dummy-password")
+	return hash
+})
+
+type AuthHandler struct {
+	queries  *db.Queries
+	sessions *auth.Sessions
+}
+
+func NewAuthHandler(queries *db.Queries, sessions *auth.Sessions) *AuthHandler {
+	return &AuthHandler{
+		queries:  queries,
+		sessions: sessions,
+	}
+}
+
+// HandleLogin renders the login form on GET and signs the manager in on POST
+func (h *AuthHandler) HandleLogin(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		next := safeRedirect(r.URL.Query().Get("next"))
+		if _, ok := h.sessions.Authenticate(r); ok {
+			http.Redirect(w, r, next, http.StatusSeeOther)
+			return
+		}
+		h.renderLogin(w, r, http.StatusOK, "", next, "")
+	case http.MethodPost:
+		if err := r.ParseForm(); err != nil {
+			h.renderLogin(w, r, http.StatusBadRequest, "", "/", "Invalid form data")
+			return
+		}
+
+		email := strings.TrimSpace(r.FormValue("email"))
+		password := r.FormValue("password")
+		next := safeRedirect(r.FormValue("next"))
+
+		row, err := h.queries.GetManagerByEmail(r.Context(), email)
+		if err != nil {
+			if err != sql.ErrNoRows {
+				zap.L().Error("error getting manager", zap.Error(err))
+				h.renderLogin(w, r, http.StatusInternalServerError, email, next, "Failed to sign in")
+				return
+			}
+			auth.VerifyPassword(password, dummyPasswordHash())
+			h.renderLogin(w, r, http.StatusUnauthorized, email, next, "Invalid email or password")
+			return
+		}
+
+		ok, err := auth.VerifyPassword(password, row.Manager.PasswordHash)
+		if err != nil {
+			zap.L().Error("error verifying password", zap.Error(err))
+		}
+		if !ok {
+			h.renderLogin(w, r, http.StatusUnauthorized, email, next, "Invalid email or password")
+			return
+		}
+
+		if err := h.sessions.Start(r.Context(), w, row.Manager.ID.String()); err != nil {
+			zap.L().Error("error starting session", zap.Error(err))
+			h.renderLogin(w, r, http.StatusInternalServerError, email, next, "Failed to sign in")
+			return
+		}
+
+		http.Redirect(w, r, next, http.StatusSeeOther)
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// HandleLogout ends the current session and returns to the login form
+func (h *AuthHandler) HandleLogout(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if err := h.sessions.End(w, r); err != nil {
+		zap.L().Error("error ending session", zap.Error(err))
+	}
+
+	if r.Header.Get("HX-Request") == "true" {
+		w.Header().Set("HX-Redirect", "/login")
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	http.Redirect(w, r, "/login", http.StatusSeeOther)
+}
+
+func (h *AuthHandler) renderLogin(w http.ResponseWriter, r *http.Request, status int, email, next, errorMessage string) {
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(status)
+	if err := templates.LoginPage(email, next, errorMessage).Render(r.Context(), w); err != nil {
+		zap.L().Error("error rendering login page", zap.Error(err))
+	}
+}
+
+// safeRedirect only allows redirects to local paths so the login form
+// can't be used to bounce a manager to another site.
+func safeRedirect(next string) string {
+	if next == "" || !strings.HasPrefix(next, "/") || strings.HasPrefix(next, "//") || strings.HasPrefix(next, "/\\") {
+		return "/"
+	}
+	return next
+}
@@ -12,14 +12,26 @@ type CombinedAPIHandler struct {
 	personHandler       *PersonHandler
 	actionHandler       *ActionHandler
 	conversationHandler *ConversationHandler
+	followUpHandler     *FollowUpHandler
+	themeHandler        *ThemeHandler
+	ladderHandler       *LadderHandler
+	searchHandler       *SearchHandler
+	trashHandler        *TrashHandler
+	auditHandler        *AuditHandler
 }
 
 // NewCombinedAPIHandler creates a new combined API handler
-func NewCombinedAPIHandler(personHandler *PersonHandler, actionHandler *ActionHandler, conversationHandler *ConversationHandler) *CombinedAPIHandler {
+func NewCombinedAPIHandler(personHandler *PersonHandler, actionHandler *ActionHandler, conversationHandler *ConversationHandler, followUpHandler *FollowUpHandler, themeHandler *ThemeHandler, ladderHandler *LadderHandler, searchHandler *SearchHandler, trashHandler *TrashHandler, auditHandler *AuditHandler) *CombinedAPIHandler {
 	return &CombinedAPIHandler{
 		personHandler:       personHandler,
 		actionHandler:       actionHandler,
 		conversationHandler: conversationHandler,
+		followUpHandler:     followUpHandler,
+		themeHandler:        themeHandler,
+		ladderHandler:       ladderHandler,
+		searchHandler:       searchHandler,
+		trashHandler:        trashHandler,
+		auditHandler:        auditHandler,
 	}
 }
 
@@ -44,6 +56,14 @@ func (h *CombinedAPIHandler) DeletePerson(ctx context.Context, params api.Delete
 	return h.personHandler.DeletePerson(ctx, params)
 }
 
+func (h *CombinedAPIHandler) GetOrgTree(ctx context.Context) (api.GetOrgTreeRes, error) {
+	return h.personHandler.GetOrgTree(ctx)
+}
+
+func (h *CombinedAPIHandler) GetDirectReports(ctx context.Context, personID string) ([]templates.PersonWithLastActivity, error) {
+	return h.personHandler.GetDirectReports(ctx, personID)
+}
+
 // Action API methods
 func (h *CombinedAPIHandler) CreateAction(ctx context.Context, req *api.CreateActionRequest) (api.CreateActionRes, error) {
 	return h.actionHandler.CreateAction(ctx, req)
@@ -65,6 +85,14 @@ func (h *CombinedAPIHandler) DeleteAction(ctx context.Context, params api.Delete
 	return h.actionHandler.DeleteAction(ctx, params)
 }
 
+func (h *CombinedAPIHandler) GetActionRevisions(ctx context.Context, params api.GetActionRevisionsParams) (api.GetActionRevisionsRes, error) {
+	return h.actionHandler.GetActionRevisions(ctx, params)
+}
+
+func (h *CombinedAPIHandler) RestoreActionRevision(ctx context.Context, params api.RestoreActionRevisionParams) (api.RestoreActionRevisionRes, error) {
+	return h.actionHandler.RestoreActionRevision(ctx, params)
+}
+
 func (h *CombinedAPIHandler) GetPersonActions(ctx context.Context, params api.GetPersonActionsParams) (api.GetPersonActionsRes, error) {
 	return h.actionHandler.GetPersonActions(ctx, params)
 }
@@ -73,6 +101,10 @@ func (h *CombinedAPIHandler) GetPersonTimeline(ctx context.Context, params api.G
 	return h.personHandler.GetPersonTimeline(ctx, params)
 }
 
+func (h *CombinedAPIHandler) GetPersonAgenda(ctx context.Context, params api.GetPersonAgendaParams) (api.GetPersonAgendaRes, error) {
+	return h.personHandler.GetPersonAgenda(ctx, params)
+}
+
 func (h *CombinedAPIHandler) GetPersonsWithLastActivity(ctx context.Context, params api.GetPersonsParams) ([]templates.PersonWithLastActivity, error) {
 	return h.personHandler.GetPersonsWithLastActivity(ctx, params)
 }
@@ -81,3 +113,109 @@ func (h *CombinedAPIHandler) GetPersonsWithLastActivity(ctx context.Context, par
 func (h *CombinedAPIHandler) CreateConversation(ctx context.Context, req *api.CreateConversationRequest) (api.CreateConversationRes, error) {
 	return h.conversationHandler.CreateConversation(ctx, req)
 }
+
+func (h *CombinedAPIHandler) GetConversations(ctx context.Context, params api.GetConversationsParams) (api.GetConversationsRes, error) {
+	return h.conversationHandler.GetConversations(ctx, params)
+}
+
+func (h *CombinedAPIHandler) GetConversationById(ctx context.Context, params api.GetConversationByIdParams) (api.GetConversationByIdRes, error) {
+	return h.conversationHandler.GetConversationById(ctx, params)
+}
+
+func (h *CombinedAPIHandler) UpdateConversation(ctx context.Context, req *api.UpdateConversationRequest, params api.UpdateConversationParams) (api.UpdateConversationRes, error) {
+	return h.conversationHandler.UpdateConversation(ctx, req, params)
+}
+
+func (h *CombinedAPIHandler) DeleteConversation(ctx context.Context, params api.DeleteConversationParams) (api.DeleteConversationRes, error) {
+	return h.conversationHandler.DeleteConversation(ctx, params)
+}
+
+func (h *CombinedAPIHandler) GetConversationRevisions(ctx context.Context, params api.GetConversationRevisionsParams) (api.GetConversationRevisionsRes, error) {
+	return h.conversationHandler.GetConversationRevisions(ctx, params)
+}
+
+func (h *CombinedAPIHandler) RestoreConversationRevision(ctx context.Context, params api.RestoreConversationRevisionParams) (api.RestoreConversationRevisionRes, error) {
+	return h.conversationHandler.RestoreConversationRevision(ctx, params)
+}
+
+// Follow-up API methods
+func (h *CombinedAPIHandler) GetFollowUps(ctx context.Context, params api.GetFollowUpsParams) (api.GetFollowUpsRes, error) {
+	return h.followUpHandler.GetFollowUps(ctx, params)
+}
+
+func (h *CombinedAPIHandler) CreateFollowUp(ctx context.Context, req *api.CreateFollowUpRequest) (api.CreateFollowUpRes, error) {
+	return h.followUpHandler.CreateFollowUp(ctx, req)
+}
+
+func (h *CombinedAPIHandler) CompleteFollowUp(ctx context.Context, params api.CompleteFollowUpParams) (api.CompleteFollowUpRes, error) {
+	return h.followUpHandler.CompleteFollowUp(ctx, params)
+}
+
+// Theme API methods
+func (h *CombinedAPIHandler) GetThemes(ctx context.Context, params api.GetThemesParams) (api.GetThemesRes, error) {
+	return h.themeHandler.GetThemes(ctx, params)
+}
+
+func (h *CombinedAPIHandler) GetPersonThemes(ctx context.Context, params api.GetPersonThemesParams) (api.GetPersonThemesRes, error) {
+	return h.themeHandler.GetPersonThemes(ctx, params)
+}
+
+func (h *CombinedAPIHandler) CreateTheme(ctx context.Context, req *api.CreateThemeRequest) (api.CreateThemeRes, error) {
+	return h.themeHandler.CreateTheme(ctx, req)
+}
+
+func (h *CombinedAPIHandler) GetThemeById(ctx context.Context, params api.GetThemeByIdParams) (api.GetThemeByIdRes, error) {
+	return h.themeHandler.GetThemeById(ctx, params)
+}
+
+func (h *CombinedAPIHandler) UpdateTheme(ctx context.Context, req *api.UpdateThemeRequest, params api.UpdateThemeParams) (api.UpdateThemeRes, error) {
+	return h.themeHandler.UpdateTheme(ctx, req, params)
+}
+
+func (h *CombinedAPIHandler) DeleteTheme(ctx context.Context, params api.DeleteThemeParams) (api.DeleteThemeRes, error) {
+	return h.themeHandler.DeleteTheme(ctx, params)
+}
+
+func (h *CombinedAPIHandler) MergeThemes(ctx context.Context, req *api.MergeThemesRequest, params api.MergeThemesParams) (api.MergeThemesRes, error) {
+	return h.themeHandler.MergeThemes(ctx, req, params)
+}
+
+func (h *CombinedAPIHandler) SplitTheme(ctx context.Context, req *api.SplitThemeRequest, params api.SplitThemeParams) (api.SplitThemeRes, error) {
+	return h.themeHandler.SplitTheme(ctx, req, params)
+}
+
+// Ladder API methods
+func (h *CombinedAPIHandler) GetLevels(ctx context.Context) (api.GetLevelsRes, error) {
+	return h.ladderHandler.GetLevels(ctx)
+}
+
+func (h *CombinedAPIHandler) GetPersonLadder(ctx context.Context, params api.GetPersonLadderParams) (api.GetPersonLadderRes, error) {
+	return h.ladderHandler.GetPersonLadder(ctx, params)
+}
+
+func (h *CombinedAPIHandler) SetPersonLevels(ctx context.Context, req *api.SetPersonLevelsRequest, params api.SetPersonLevelsParams) (api.SetPersonLevelsRes, error) {
+	return h.ladderHandler.SetPersonLevels(ctx, req, params)
+}
+
+// Search API methods
+func (h *CombinedAPIHandler) Search(ctx context.Context, params api.SearchParams) (api.SearchRes, error) {
+	return h.searchHandler.Search(ctx, params)
+}
+
+// Trash API methods
+func (h *CombinedAPIHandler) ListTrash(ctx context.Context, params api.ListTrashParams) (api.ListTrashRes, error) {
+	return h.trashHandler.ListTrash(ctx, params)
+}
+
+func (h *CombinedAPIHandler) PurgeTrashItem(ctx context.Context, params api.PurgeTrashItemParams) (api.PurgeTrashItemRes, error) {
+	return h.trashHandler.PurgeTrashItem(ctx, params)
+}
+
+func (h *CombinedAPIHandler) RestoreTrashItem(ctx context.Context, params api.RestoreTrashItemParams) (api.RestoreTrashItemRes, error) {
+	return h.trashHandler.RestoreTrashItem(ctx, params)
+}
+
+// Audit API methods
+func (h *CombinedAPIHandler) ListAuditLog(ctx context.Context, params api.ListAuditLogParams) (api.ListAuditLogRes, error) {
+	return h.auditHandler.ListAuditLog(ctx, params)
+}
@@ -3,14 +3,20 @@ package handlers
 import (
 	"bytes"
 	"context"
+	"encoding/csv"
+	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"strings"
+	"time"
 
 	"github.com/a-h/templ"
 
 	"pepo/internal/api"
+	"pepo/internal/cursor"
 	"pepo/internal/middleware"
+	"pepo/internal/period"
 	"pepo/templates"
 )
 
@@ -52,10 +58,11 @@ func (h *ContentNegotiatingHandler) getRequestFromContext(ctx context.Context) *
 	return nil
 }
 
-// renderTemplate renders a template to an io.Reader
-func renderTemplate(component templ.Component) io.Reader {
+// renderTemplate renders a template to an io.Reader using the request context,
+// so templates can see the signed-in manager
+func renderTemplate(ctx context.Context, component templ.Component) io.Reader {
 	var buf bytes.Buffer
-	component.Render(context.Background(), &buf)
+	component.Render(ctx, &buf)
 	return &buf
 }
 
@@ -88,7 +95,7 @@ func (h *ContentNegotiatingHandler) GetPersons(ctx context.Context, params api.G
 					}
 					// Render select options template
 					return &api.GetPersonsOKTextHTML{
-						Data: renderTemplate(templates.PersonSelectOptions(templatePersons)),
+						Data: renderTemplate(ctx, templates.PersonSelectOptions(templatePersons)),
 					}, nil
 				}
 			} else {
@@ -103,7 +110,7 @@ func (h *ContentNegotiatingHandler) GetPersons(ctx context.Context, params api.G
 
 				// Render person list with last action template
 				return &api.GetPersonsOKTextHTML{
-					Data: renderTemplate(templates.PersonWithLastActivityTable(templatePersons)),
+					Data: renderTemplate(ctx, templates.PersonWithLastActivityTable(templatePersons)),
 				}, nil
 			}
 		}
@@ -137,72 +144,53 @@ func (h *ContentNegotiatingHandler) GetPersonById(ctx context.Context, params ap
 			case *api.Person:
 				// Convert API person to template person
 				templatePerson := templates.Person{
-					ID:        jsonResult.ID,
-					Name:      jsonResult.Name,
-					CreatedAt: jsonResult.CreatedAt,
-					UpdatedAt: jsonResult.UpdatedAt,
+					ID:          jsonResult.ID,
+					Name:        jsonResult.Name,
+					ReportsTo:   jsonResult.ReportsTo.Or(""),
+					CadenceDays: jsonResult.CadenceDays.Or(0),
+					Overdue:     jsonResult.Overdue,
+					CreatedAt:   jsonResult.CreatedAt,
+					UpdatedAt:   jsonResult.UpdatedAt,
+				}
+				if nextDueAt, ok := jsonResult.NextDueAt.Get(); ok {
+					templatePerson.NextDueAt = &nextDueAt
 				}
 
-				// Fetch the person's timeline for the detail view
-				timelineParams := api.GetPersonTimelineParams{
-					ID:    params.ID,
-					Limit: api.OptInt{Value: 100, Set: true},
+				// Who this person reports to, and the skip-level view of their own reports
+				var reportsTo *templates.Person
+				if templatePerson.ReportsTo != "" {
+					managerResult, err := h.combinedHandler.GetPersonById(ctx, api.GetPersonByIdParams{ID: templatePerson.ReportsTo})
+					if err == nil {
+						if p, ok := managerResult.(*api.Person); ok {
+							reportsTo = &templates.Person{ID: p.ID, Name: p.Name}
+						}
+					}
 				}
+				reports, _ := h.combinedHandler.GetDirectReports(ctx, params.ID)
 
-				timelineResult, err := h.combinedHandler.GetPersonTimeline(ctx, timelineParams)
+				// The first page of the timeline; the rest loads as the list is scrolled
+				timelineResult, err := h.combinedHandler.GetPersonTimeline(ctx, api.GetPersonTimelineParams{
+					ID:    params.ID,
+					Limit: api.NewOptInt(templates.TimelinePageSize),
+				})
 				if err != nil {
 					return &api.GetPersonByIdOKTextHTML{
-						Data: renderTemplate(templates.PersonDetail(templatePerson, []templates.TimelineItem{})),
+						Data: renderTemplate(ctx, templates.PersonDetail(templatePerson, reportsTo, reports, []templates.TimelineItem{}, 0, "")),
 					}, nil
 				}
 
 				var templateItems []templates.TimelineItem
+				total, nextURL := 0, ""
 				if timelineJSON, ok := timelineResult.(*api.GetPersonTimelineOKApplicationJSON); ok {
-					templateItems = make([]templates.TimelineItem, len(timelineJSON.Items))
-					for i, item := range timelineJSON.Items {
-						switch item.Type {
-						case api.TimelineItemTypeAction:
-							tmplAction := &templates.Action{
-								ID:          item.ID,
-								PersonID:    item.PersonID,
-								OccurredAt:  item.OccurredAt,
-								Description: item.Description,
-								References:  item.References.Or(""),
-								Valence:     string(item.Valence.Or("")),
-								CreatedAt:   item.CreatedAt,
-								UpdatedAt:   item.UpdatedAt,
-							}
-							if len(item.Themes) > 0 {
-								tmplThemes := make([]templates.Theme, len(item.Themes))
-								for j, th := range item.Themes {
-									tmplThemes[j] = templates.Theme{ID: th.ID, Text: th.Text}
-								}
-								tmplAction.Themes = tmplThemes
-							}
-							templateItems[i] = templates.TimelineItem{Type: "action", Action: tmplAction}
-						case api.TimelineItemTypeConversation:
-							tmplConv := &templates.Conversation{
-								ID:          item.ID,
-								PersonID:    item.PersonID,
-								OccurredAt:  item.OccurredAt,
-								Description: item.Description,
-								CreatedAt:   item.CreatedAt,
-								UpdatedAt:   item.UpdatedAt,
-							}
-							if len(item.Themes) > 0 {
-								tmplThemes := make([]templates.Theme, len(item.Themes))
-								for j, th := range item.Themes {
-									tmplThemes[j] = templates.Theme{ID: th.ID, Text: th.Text}
-								}
-								tmplConv.Themes = tmplThemes
-							}
-							templateItems[i] = templates.TimelineItem{Type: "conversation", Conversation: tmplConv}
-						}
+					templateItems = toTemplateTimelineItems(timelineJSON.Items)
+					total = timelineJSON.Total
+					if next, ok := timelineJSON.NextCursor.Get(); ok {
+						nextURL = fmt.Sprintf("/api/v1/people/%s/timeline?limit=%d&cursor=%s", params.ID, templates.TimelinePageSize, url.QueryEscape(next))
 					}
 				}
 
 				return &api.GetPersonByIdOKTextHTML{
-					Data: renderTemplate(templates.PersonDetail(templatePerson, templateItems)),
+					Data: renderTemplate(ctx, templates.PersonDetail(templatePerson, reportsTo, reports, templateItems, total, nextURL)),
 				}, nil
 			}
 		}
@@ -212,6 +200,95 @@ func (h *ContentNegotiatingHandler) GetPersonById(ctx context.Context, params ap
 	return result, nil
 }
 
+// GetPersonAgenda handles both JSON and HTML requests for a person's 1:1 agenda
+func (h *ContentNegotiatingHandler) GetPersonAgenda(ctx context.Context, params api.GetPersonAgendaParams) (api.GetPersonAgendaRes, error) {
+	result, err := h.combinedHandler.GetPersonAgenda(ctx, params)
+	if err != nil {
+		return result, err
+	}
+
+	if httpReq := h.getRequestFromContext(ctx); httpReq != nil {
+		if h.determineResponseType(httpReq) == "text/html" {
+			switch agenda := result.(type) {
+			case *api.Agenda:
+				templatePerson := templates.Person{ID: agenda.PersonID}
+				if res, err := h.combinedHandler.GetPersonById(ctx, api.GetPersonByIdParams{ID: agenda.PersonID}); err == nil {
+					if p, ok := res.(*api.Person); ok {
+						templatePerson.Name = p.Name
+					}
+				}
+
+				var since *time.Time
+				if t, ok := agenda.Since.Get(); ok {
+					since = &t
+				}
+
+				groups := make([]templates.AgendaGroup, len(agenda.Groups))
+				for i, g := range agenda.Groups {
+					actions := make([]templates.Action, len(g.Actions))
+					for j, a := range g.Actions {
+						actions[j] = templates.Action{
+							ID:          a.ID,
+							PersonID:    a.PersonID,
+							OccurredAt:  a.OccurredAt,
+							Description: a.Description,
+							References:  ToTemplateReferences(a.References),
+							Valence:     string(a.Valence),
+							Impact:      string(a.Impact.Value),
+							CreatedAt:   a.CreatedAt,
+							UpdatedAt:   a.UpdatedAt,
+						}
+					}
+					groups[i] = templates.AgendaGroup{
+						ThemeText: g.Theme.Value.Text,
+						Valence:   string(g.Valence),
+						Actions:   actions,
+					}
+				}
+
+				return &api.GetPersonAgendaOKTextHTML{
+					Data: renderTemplate(ctx, templates.AgendaPage(templatePerson, since, groups)),
+				}, nil
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// GetOrgTree handles both JSON and HTML requests for the reporting hierarchy
+func (h *ContentNegotiatingHandler) GetOrgTree(ctx context.Context) (api.GetOrgTreeRes, error) {
+	result, err := h.combinedHandler.GetOrgTree(ctx)
+	if err != nil {
+		return result, err
+	}
+
+	if req := h.getRequestFromContext(ctx); req != nil {
+		if h.determineResponseType(req) == "text/html" {
+			if tree, ok := result.(*api.GetOrgTreeOKApplicationJSON); ok {
+				return &api.GetOrgTreeOKTextHTML{
+					Data: renderTemplate(ctx, templates.OrgTreePage(toTemplateOrgNodes(tree.Roots))),
+				}, nil
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// toTemplateOrgNodes converts API org nodes, and their reports, to template org nodes
+func toTemplateOrgNodes(nodes []api.OrgNode) []templates.OrgNode {
+	out := make([]templates.OrgNode, len(nodes))
+	for i, node := range nodes {
+		out[i] = templates.OrgNode{
+			ID:      node.ID,
+			Name:    node.Name,
+			Reports: toTemplateOrgNodes(node.Reports),
+		}
+	}
+	return out
+}
+
 // CreatePerson handles both JSON and HTML requests for creating a person
 func (h *ContentNegotiatingHandler) CreatePerson(ctx context.Context, req *api.CreatePersonRequest) (api.CreatePersonRes, error) {
 	// Call the business logic
@@ -238,7 +315,7 @@ func (h *ContentNegotiatingHandler) CreatePerson(ctx context.Context, req *api.C
 
 				// Render template and return HTML response
 				return &api.CreatePersonCreatedTextHTML{
-					Data: renderTemplate(templates.PersonItem(templatePerson)),
+					Data: renderTemplate(ctx, templates.PersonItem(templatePerson)),
 				}, nil
 			}
 		}
@@ -274,7 +351,7 @@ func (h *ContentNegotiatingHandler) UpdatePerson(ctx context.Context, req *api.U
 
 				// Render template and return HTML response
 				return &api.UpdatePersonOKTextHTML{
-					Data: renderTemplate(templates.PersonItem(templatePerson)),
+					Data: renderTemplate(ctx, templates.PersonItem(templatePerson)),
 				}, nil
 			}
 		}
@@ -313,17 +390,27 @@ func (h *ContentNegotiatingHandler) GetActions(ctx context.Context, params api.G
 						PersonID:    action.PersonID,
 						OccurredAt:  action.OccurredAt,
 						Description: action.Description,
-						References:  action.References.Or(""),
+						References:  ToTemplateReferences(action.References),
 						Valence:     string(action.Valence),
+						Impact:      string(action.Impact.Value),
 						CreatedAt:   action.CreatedAt,
 						UpdatedAt:   action.UpdatedAt,
 						PersonName:  action.PersonName.Value,
 					}
 				}
 
-				// Render template and return HTML response
+				// A cursor request is a later page being appended, so it skips the
+				// empty-state message
+				nextURL := ""
+				if next, ok := jsonResult.NextCursor.Get(); ok {
+					nextURL = nextPageURL(req, next)
+				}
+				component := templates.ActionList(templateActions, nextURL)
+				if params.Cursor.IsSet() {
+					component = templates.ActionPage(templateActions, nextURL)
+				}
 				return &api.GetActionsOKTextHTML{
-					Data: renderTemplate(templates.ActionList(templateActions)),
+					Data: renderTemplate(ctx, component),
 				}, nil
 			}
 		}
@@ -356,8 +443,9 @@ func (h *ContentNegotiatingHandler) GetActionById(ctx context.Context, params ap
 					PersonID:    jsonResult.PersonID,
 					OccurredAt:  jsonResult.OccurredAt,
 					Description: jsonResult.Description,
-					References:  jsonResult.References.Or(""),
+					References:  ToTemplateReferences(jsonResult.References),
 					Valence:     string(jsonResult.Valence),
+					Impact:      string(jsonResult.Impact.Value),
 					CreatedAt:   jsonResult.CreatedAt,
 					UpdatedAt:   jsonResult.UpdatedAt,
 					PersonName:  jsonResult.PersonName.Value,
@@ -365,13 +453,13 @@ func (h *ContentNegotiatingHandler) GetActionById(ctx context.Context, params ap
 
 				if format == "edit" {
 					return &api.GetActionByIdOKTextHTML{
-						Data: renderTemplate(templates.EditActionForm(templateAction)),
+						Data: renderTemplate(ctx, templates.EditActionForm(templateAction)),
 					}, nil
 				}
 
 				// Render template and return HTML response
 				return &api.GetActionByIdOKTextHTML{
-					Data: renderTemplate(templates.ActionItem(templateAction)),
+					Data: renderTemplate(ctx, templates.ActionItem(templateAction)),
 				}, nil
 			}
 		}
@@ -403,15 +491,16 @@ func (h *ContentNegotiatingHandler) CreateAction(ctx context.Context, req *api.C
 					PersonID:    jsonResult.PersonID,
 					OccurredAt:  jsonResult.OccurredAt,
 					Description: jsonResult.Description,
-					References:  jsonResult.References.Or(""),
+					References:  ToTemplateReferences(jsonResult.References),
 					Valence:     string(jsonResult.Valence),
+					Impact:      string(jsonResult.Impact.Value),
 					CreatedAt:   jsonResult.CreatedAt,
 					UpdatedAt:   jsonResult.UpdatedAt,
 				}
 
 				// Render template and return HTML response
 				return &api.CreateActionCreatedTextHTML{
-					Data: renderTemplate(templates.ActionItem(templateAction)),
+					Data: renderTemplate(ctx, templates.ActionItem(templateAction)),
 				}, nil
 			}
 		}
@@ -443,15 +532,16 @@ func (h *ContentNegotiatingHandler) UpdateAction(ctx context.Context, req *api.U
 					PersonID:    jsonResult.PersonID,
 					OccurredAt:  jsonResult.OccurredAt,
 					Description: jsonResult.Description,
-					References:  jsonResult.References.Or(""),
+					References:  ToTemplateReferences(jsonResult.References),
 					Valence:     string(jsonResult.Valence),
+					Impact:      string(jsonResult.Impact.Value),
 					CreatedAt:   jsonResult.CreatedAt,
 					UpdatedAt:   jsonResult.UpdatedAt,
 				}
 
 				// Render template and return HTML response
 				return &api.UpdateActionOKTextHTML{
-					Data: renderTemplate(templates.ActionItem(templateAction)),
+					Data: renderTemplate(ctx, templates.ActionItem(templateAction)),
 				}, nil
 			}
 		}
@@ -466,6 +556,41 @@ func (h *ContentNegotiatingHandler) DeleteAction(ctx context.Context, params api
 	return h.combinedHandler.DeleteAction(ctx, params)
 }
 
+// GetActionRevisions renders the history panel on the edit page for HTMX requests
+func (h *ContentNegotiatingHandler) GetActionRevisions(ctx context.Context, params api.GetActionRevisionsParams) (api.GetActionRevisionsRes, error) {
+	result, err := h.combinedHandler.GetActionRevisions(ctx, params)
+	if err != nil {
+		return result, err
+	}
+
+	req := h.getRequestFromContext(ctx)
+	if req == nil || h.determineResponseType(req) != "text/html" {
+		return result, nil
+	}
+	list, ok := result.(*api.GetActionRevisionsOKApplicationJSON)
+	if !ok {
+		return result, nil
+	}
+
+	revisions := make([]templates.Revision, len(list.Revisions))
+	for i, r := range list.Revisions {
+		revisions[i] = templates.Revision{
+			ID:        r.ID,
+			Number:    r.Number,
+			CreatedAt: r.CreatedAt,
+			Changes:   toTemplateRevisionChanges(r.Changes),
+		}
+	}
+
+	return &api.GetActionRevisionsOKTextHTML{
+		Data: renderTemplate(ctx, templates.RevisionHistory("/api/v1/actions/"+params.ID+"/revisions/", revisions)),
+	}, nil
+}
+
+func (h *ContentNegotiatingHandler) RestoreActionRevision(ctx context.Context, params api.RestoreActionRevisionParams) (api.RestoreActionRevisionRes, error) {
+	return h.combinedHandler.RestoreActionRevision(ctx, params)
+}
+
 // GetPersonActions handles both JSON and HTML requests for getting a person's actions
 func (h *ContentNegotiatingHandler) GetPersonActions(ctx context.Context, params api.GetPersonActionsParams) (api.GetPersonActionsRes, error) {
 	// Call the business logic
@@ -490,16 +615,26 @@ func (h *ContentNegotiatingHandler) GetPersonActions(ctx context.Context, params
 						PersonID:    action.PersonID,
 						OccurredAt:  action.OccurredAt,
 						Description: action.Description,
-						References:  action.References.Or(""),
+						References:  ToTemplateReferences(action.References),
 						Valence:     string(action.Valence),
+						Impact:      string(action.Impact.Value),
 						CreatedAt:   action.CreatedAt,
 						UpdatedAt:   action.UpdatedAt,
 					}
 				}
 
-				// Render template and return HTML response
+				// A cursor request is a later page being appended, so it skips the
+				// empty-state message
+				nextURL := ""
+				if next, ok := jsonResult.NextCursor.Get(); ok {
+					nextURL = nextPageURL(req, next)
+				}
+				component := templates.ActionList(templateActions, nextURL)
+				if params.Cursor.IsSet() {
+					component = templates.ActionPage(templateActions, nextURL)
+				}
 				return &api.GetPersonActionsOKTextHTML{
-					Data: renderTemplate(templates.ActionList(templateActions)),
+					Data: renderTemplate(ctx, component),
 				}, nil
 			}
 		}
@@ -520,16 +655,8 @@ func (h *ContentNegotiatingHandler) CreateConversation(ctx context.Context, req
 		if h.determineResponseType(httpReq) == "text/html" {
 			switch conv := result.(type) {
 			case *api.Conversation:
-				tmplConv := templates.Conversation{
-					ID:          conv.ID,
-					PersonID:    conv.PersonID,
-					OccurredAt:  conv.OccurredAt,
-					Description: conv.Description,
-					CreatedAt:   conv.CreatedAt,
-					UpdatedAt:   conv.UpdatedAt,
-				}
 				return &api.CreateConversationCreatedTextHTML{
-					Data: renderTemplate(templates.ConversationItem(tmplConv)),
+					Data: renderTemplate(ctx, templates.ConversationItem(toTemplateConversation(*conv))),
 				}, nil
 			}
 		}
@@ -538,6 +665,149 @@ func (h *ContentNegotiatingHandler) CreateConversation(ctx context.Context, req
 	return result, nil
 }
 
+// GetConversations handles both JSON and HTML requests for listing conversations
+func (h *ContentNegotiatingHandler) GetConversations(ctx context.Context, params api.GetConversationsParams) (api.GetConversationsRes, error) {
+	result, err := h.combinedHandler.GetConversations(ctx, params)
+	if err != nil {
+		return result, err
+	}
+
+	if httpReq := h.getRequestFromContext(ctx); httpReq != nil {
+		if h.determineResponseType(httpReq) == "text/html" {
+			switch list := result.(type) {
+			case *api.GetConversationsOKApplicationJSON:
+				tmplConvs := make([]templates.Conversation, len(list.Conversations))
+				for i, conv := range list.Conversations {
+					tmplConvs[i] = toTemplateConversation(conv)
+				}
+				return &api.GetConversationsOKTextHTML{
+					Data: renderTemplate(ctx, templates.ConversationList(tmplConvs)),
+				}, nil
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// GetConversationById handles both JSON and HTML requests for getting a conversation by ID
+func (h *ContentNegotiatingHandler) GetConversationById(ctx context.Context, params api.GetConversationByIdParams) (api.GetConversationByIdRes, error) {
+	result, err := h.combinedHandler.GetConversationById(ctx, params)
+	if err != nil {
+		return result, err
+	}
+
+	if httpReq := h.getRequestFromContext(ctx); httpReq != nil {
+		if h.determineResponseType(httpReq) == "text/html" {
+			switch conv := result.(type) {
+			case *api.Conversation:
+				if httpReq.URL.Query().Get("format") == "edit" {
+					return &api.GetConversationByIdOKTextHTML{
+						Data: renderTemplate(ctx, templates.EditConversationForm(toTemplateConversation(*conv))),
+					}, nil
+				}
+				return &api.GetConversationByIdOKTextHTML{
+					Data: renderTemplate(ctx, templates.ConversationItem(toTemplateConversation(*conv))),
+				}, nil
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// UpdateConversation handles both JSON and HTML requests for updating a conversation
+func (h *ContentNegotiatingHandler) UpdateConversation(ctx context.Context, req *api.UpdateConversationRequest, params api.UpdateConversationParams) (api.UpdateConversationRes, error) {
+	result, err := h.combinedHandler.UpdateConversation(ctx, req, params)
+	if err != nil {
+		return result, err
+	}
+
+	if httpReq := h.getRequestFromContext(ctx); httpReq != nil {
+		if h.determineResponseType(httpReq) == "text/html" {
+			switch conv := result.(type) {
+			case *api.Conversation:
+				return &api.UpdateConversationOKTextHTML{
+					Data: renderTemplate(ctx, templates.ConversationItem(toTemplateConversation(*conv))),
+				}, nil
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// DeleteConversation handles delete requests (no content negotiation needed for 204 responses)
+func (h *ContentNegotiatingHandler) DeleteConversation(ctx context.Context, params api.DeleteConversationParams) (api.DeleteConversationRes, error) {
+	return h.combinedHandler.DeleteConversation(ctx, params)
+}
+
+// GetConversationRevisions renders the history panel on the edit page for HTMX requests
+func (h *ContentNegotiatingHandler) GetConversationRevisions(ctx context.Context, params api.GetConversationRevisionsParams) (api.GetConversationRevisionsRes, error) {
+	result, err := h.combinedHandler.GetConversationRevisions(ctx, params)
+	if err != nil {
+		return result, err
+	}
+
+	req := h.getRequestFromContext(ctx)
+	if req == nil || h.determineResponseType(req) != "text/html" {
+		return result, nil
+	}
+	list, ok := result.(*api.GetConversationRevisionsOKApplicationJSON)
+	if !ok {
+		return result, nil
+	}
+
+	revisions := make([]templates.Revision, len(list.Revisions))
+	for i, r := range list.Revisions {
+		revisions[i] = templates.Revision{
+			ID:        r.ID,
+			Number:    r.Number,
+			CreatedAt: r.CreatedAt,
+			Changes:   toTemplateRevisionChanges(r.Changes),
+		}
+	}
+
+	return &api.GetConversationRevisionsOKTextHTML{
+		Data: renderTemplate(ctx, templates.RevisionHistory("/api/v1/conversations/"+params.ID+"/revisions/", revisions)),
+	}, nil
+}
+
+func (h *ContentNegotiatingHandler) RestoreConversationRevision(ctx context.Context, params api.RestoreConversationRevisionParams) (api.RestoreConversationRevisionRes, error) {
+	return h.combinedHandler.RestoreConversationRevision(ctx, params)
+}
+
+// toTemplateConversation converts an API conversation, with its themes, to a template conversation
+// ToTemplateReferences converts API references for rendering
+func ToTemplateReferences(refs []api.Reference) []templates.Reference {
+	out := make([]templates.Reference, len(refs))
+	for i, r := range refs {
+		out[i] = templates.Reference{
+			Kind:  string(r.Kind),
+			Value: r.Value,
+			Title: r.Title.Or(""),
+			Href:  r.URL.Or(""),
+		}
+	}
+	return out
+}
+
+func toTemplateConversation(conv api.Conversation) templates.Conversation {
+	themes := make([]templates.Theme, len(conv.Themes))
+	for i, t := range conv.Themes {
+		themes[i] = templates.Theme{ID: t.ID, Text: t.Text}
+	}
+	return templates.Conversation{
+		ID:          conv.ID,
+		PersonID:    conv.PersonID,
+		OccurredAt:  conv.OccurredAt,
+		Description: conv.Description,
+		CreatedAt:   conv.CreatedAt,
+		UpdatedAt:   conv.UpdatedAt,
+		Themes:      themes,
+	}
+}
+
 // GetPersonTimeline handles both JSON and HTML requests for a person's timeline
 func (h *ContentNegotiatingHandler) GetPersonTimeline(ctx context.Context, params api.GetPersonTimelineParams) (api.GetPersonTimelineRes, error) {
 	result, err := h.combinedHandler.GetPersonTimeline(ctx, params)
@@ -548,49 +818,165 @@ func (h *ContentNegotiatingHandler) GetPersonTimeline(ctx context.Context, param
 	if req := h.getRequestFromContext(ctx); req != nil {
 		if h.determineResponseType(req) == "text/html" {
 			if jsonResult, ok := result.(*api.GetPersonTimelineOKApplicationJSON); ok {
-				templateItems := make([]templates.TimelineItem, len(jsonResult.Items))
-				for i, item := range jsonResult.Items {
-					switch item.Type {
-					case api.TimelineItemTypeAction:
-						tmplAction := &templates.Action{
-							ID:          item.ID,
-							PersonID:    item.PersonID,
-							OccurredAt:  item.OccurredAt,
-							Description: item.Description,
-							References:  item.References.Or(""),
-							Valence:     string(item.Valence.Or("")),
-							CreatedAt:   item.CreatedAt,
-							UpdatedAt:   item.UpdatedAt,
-						}
-						if len(item.Themes) > 0 {
-							tmplThemes := make([]templates.Theme, len(item.Themes))
-							for j, th := range item.Themes {
-								tmplThemes[j] = templates.Theme{ID: th.ID, Text: th.Text}
-							}
-							tmplAction.Themes = tmplThemes
-						}
-						templateItems[i] = templates.TimelineItem{Type: "action", Action: tmplAction}
-					case api.TimelineItemTypeConversation:
-						tmplConv := &templates.Conversation{
-							ID:          item.ID,
-							PersonID:    item.PersonID,
-							OccurredAt:  item.OccurredAt,
-							Description: item.Description,
-							CreatedAt:   item.CreatedAt,
-							UpdatedAt:   item.UpdatedAt,
-						}
-						if len(item.Themes) > 0 {
-							tmplThemes := make([]templates.Theme, len(item.Themes))
-							for j, th := range item.Themes {
-								tmplThemes[j] = templates.Theme{ID: th.ID, Text: th.Text}
-							}
-							tmplConv.Themes = tmplThemes
-						}
-						templateItems[i] = templates.TimelineItem{Type: "conversation", Conversation: tmplConv}
+				page := toTemplateTimelineItems(jsonResult.Items)
+				if groupBy, ok := params.GroupBy.Get(); ok {
+					// The business logic has already rejected a cursor that
+					// does not decode
+					var after time.Time
+					if c, ok := params.Cursor.Get(); ok {
+						after, _, _ = cursor.Decode(c)
 					}
+					addGroupHeaders(page, jsonResult.Items, jsonResult.Groups, period.Unit(groupBy), after)
+				}
+				nextURL := ""
+				if next, ok := jsonResult.NextCursor.Get(); ok {
+					nextURL = nextPageURL(req, next)
+				}
+				component := templates.TimelineList(page, nextURL)
+				if params.Cursor.IsSet() {
+					component = templates.TimelinePage(page, nextURL)
 				}
 				return &api.GetPersonTimelineOKTextHTML{
-					Data: renderTemplate(templates.TimelineList(templateItems)),
+					Data: renderTemplate(ctx, component),
+				}, nil
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func toTemplateRevisionChanges(changes []api.RevisionChange) []templates.RevisionChange {
+	result := make([]templates.RevisionChange, len(changes))
+	for i, c := range changes {
+		result[i] = templates.RevisionChange{
+			Field:   string(c.Field),
+			From:    c.From.Or(""),
+			To:      c.To.Or(""),
+			Added:   c.Added,
+			Removed: c.Removed,
+		}
+	}
+	return result
+}
+
+// toTemplateTimelineItems converts a page of timeline entries for rendering
+func toTemplateTimelineItems(items []api.TimelineItem) []templates.TimelineItem {
+	templateItems := make([]templates.TimelineItem, len(items))
+	for i, item := range items {
+		switch item.Type {
+		case api.TimelineItemTypeAction:
+			tmplAction := &templates.Action{
+				ID:          item.ID,
+				PersonID:    item.PersonID,
+				OccurredAt:  item.OccurredAt,
+				Description: item.Description,
+				References:  ToTemplateReferences(item.References),
+				Valence:     string(item.Valence.Or("")),
+				CreatedAt:   item.CreatedAt,
+				UpdatedAt:   item.UpdatedAt,
+			}
+			if len(item.Themes) > 0 {
+				tmplThemes := make([]templates.Theme, len(item.Themes))
+				for j, th := range item.Themes {
+					tmplThemes[j] = templates.Theme{ID: th.ID, Text: th.Text}
+				}
+				tmplAction.Themes = tmplThemes
+			}
+			templateItems[i] = templates.TimelineItem{Type: "action", Action: tmplAction}
+		case api.TimelineItemTypeConversation:
+			tmplConv := &templates.Conversation{
+				ID:          item.ID,
+				PersonID:    item.PersonID,
+				OccurredAt:  item.OccurredAt,
+				Description: item.Description,
+				CreatedAt:   item.CreatedAt,
+				UpdatedAt:   item.UpdatedAt,
+			}
+			if len(item.Themes) > 0 {
+				tmplThemes := make([]templates.Theme, len(item.Themes))
+				for j, th := range item.Themes {
+					tmplThemes[j] = templates.Theme{ID: th.ID, Text: th.Text}
+				}
+				tmplConv.Themes = tmplThemes
+			}
+			if len(item.Actions) > 0 {
+				tmplActions := make([]templates.Action, len(item.Actions))
+				for j, a := range item.Actions {
+					tmplActions[j] = templates.Action{
+						ID:          a.ID,
+						PersonID:    a.PersonID,
+						OccurredAt:  a.OccurredAt,
+						Description: a.Description,
+						Valence:     string(a.Valence),
+						Impact:      string(a.Impact.Value),
+					}
+				}
+				tmplConv.Actions = tmplActions
+			}
+			templateItems[i] = templates.TimelineItem{Type: "conversation", Conversation: tmplConv}
+		}
+	}
+	return templateItems
+}
+
+// addGroupHeaders marks the first item of each period on the page with that
+// period's summary. A page continuing from after skips the header of the period
+// after falls in, since the previous page already showed it.
+func addGroupHeaders(page []templates.TimelineItem, items []api.TimelineItem, groups []api.TimelineGroup, unit period.Unit, after time.Time) {
+	summaries := make(map[string]templates.TimelineGroup, len(groups))
+	for _, g := range groups {
+		summaries[g.PeriodStart.Format("2006-01-02")] = templates.TimelineGroup{
+			Label:    g.Label,
+			Total:    g.Total,
+			Positive: g.Positive,
+			Negative: g.Negative,
+		}
+	}
+
+	var current string
+	if !after.IsZero() {
+		current = period.Start(unit, after).Format("2006-01-02")
+	}
+	for i, item := range items {
+		start := period.Start(unit, item.OccurredAt).Format("2006-01-02")
+		if start == current {
+			continue
+		}
+		current = start
+		if g, ok := summaries[start]; ok {
+			page[i].Group = &g
+		}
+	}
+}
+
+// nextPageURL repeats the request with the next cursor in place of any offset
+func nextPageURL(req *http.Request, next string) string {
+	query := req.URL.Query()
+	query.Del("offset")
+	query.Set("cursor", next)
+	return req.URL.Path + "?" + query.Encode()
+}
+
+// GetFollowUps handles both JSON and HTML requests for listing follow-ups
+func (h *ContentNegotiatingHandler) GetFollowUps(ctx context.Context, params api.GetFollowUpsParams) (api.GetFollowUpsRes, error) {
+	result, err := h.combinedHandler.GetFollowUps(ctx, params)
+	if err != nil {
+		return result, err
+	}
+
+	if httpReq := h.getRequestFromContext(ctx); httpReq != nil {
+		if h.determineResponseType(httpReq) == "text/html" {
+			switch list := result.(type) {
+			case *api.GetFollowUpsOKApplicationJSON:
+				followUps := make([]templates.FollowUp, len(list.FollowUps))
+				for i, f := range list.FollowUps {
+					followUps[i] = toTemplateFollowUp(f)
+				}
+				// Name the person only when listing across reports
+				showPerson := !params.PersonID.IsSet() && !params.ConversationID.IsSet()
+				return &api.GetFollowUpsOKTextHTML{
+					Data: renderTemplate(ctx, templates.FollowUpList(followUps, showPerson)),
 				}, nil
 			}
 		}
@@ -598,3 +984,449 @@ func (h *ContentNegotiatingHandler) GetPersonTimeline(ctx context.Context, param
 
 	return result, nil
 }
+
+// CreateFollowUp handles both JSON and HTML requests for creating a follow-up
+func (h *ContentNegotiatingHandler) CreateFollowUp(ctx context.Context, req *api.CreateFollowUpRequest) (api.CreateFollowUpRes, error) {
+	result, err := h.combinedHandler.CreateFollowUp(ctx, req)
+	if err != nil {
+		return result, err
+	}
+
+	if httpReq := h.getRequestFromContext(ctx); httpReq != nil {
+		if h.determineResponseType(httpReq) == "text/html" {
+			switch f := result.(type) {
+			case *api.FollowUp:
+				return &api.CreateFollowUpCreatedTextHTML{
+					Data: renderTemplate(ctx, templates.FollowUpItem(toTemplateFollowUp(*f), false)),
+				}, nil
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// CompleteFollowUp handles both JSON and HTML requests for completing a follow-up
+func (h *ContentNegotiatingHandler) CompleteFollowUp(ctx context.Context, params api.CompleteFollowUpParams) (api.CompleteFollowUpRes, error) {
+	result, err := h.combinedHandler.CompleteFollowUp(ctx, params)
+	if err != nil {
+		return result, err
+	}
+
+	if httpReq := h.getRequestFromContext(ctx); httpReq != nil {
+		if h.determineResponseType(httpReq) == "text/html" {
+			switch f := result.(type) {
+			case *api.FollowUp:
+				return &api.CompleteFollowUpOKTextHTML{
+					Data: renderTemplate(ctx, templates.FollowUpItem(toTemplateFollowUp(*f), false)),
+				}, nil
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// Themes are otherwise served as JSON only, so these pass straight through
+func (h *ContentNegotiatingHandler) GetThemes(ctx context.Context, params api.GetThemesParams) (api.GetThemesRes, error) {
+	return h.combinedHandler.GetThemes(ctx, params)
+}
+
+// GetPersonThemes handles both JSON and HTML requests for a person's themes; the HTML
+// page manages every theme, archived ones included
+func (h *ContentNegotiatingHandler) GetPersonThemes(ctx context.Context, params api.GetPersonThemesParams) (api.GetPersonThemesRes, error) {
+	httpReq := h.getRequestFromContext(ctx)
+	html := httpReq != nil && h.determineResponseType(httpReq) == "text/html"
+	if html {
+		params.IncludeArchived = api.NewOptBool(true)
+		params.Limit = api.NewOptInt(100)
+	}
+
+	result, err := h.combinedHandler.GetPersonThemes(ctx, params)
+	if err != nil {
+		return result, err
+	}
+
+	if html {
+		switch list := result.(type) {
+		case *api.GetPersonThemesOKApplicationJSON:
+			templatePerson := templates.Person{ID: params.ID}
+			if res, err := h.combinedHandler.GetPersonById(ctx, api.GetPersonByIdParams{ID: params.ID}); err == nil {
+				if p, ok := res.(*api.Person); ok {
+					templatePerson.Name = p.Name
+				}
+			}
+
+			themes := make([]templates.ThemeSummary, len(list.Themes))
+			for i, t := range list.Themes {
+				themes[i] = templates.ThemeSummary{
+					ID:                t.ID,
+					Text:              t.Text,
+					Archived:          t.Archived,
+					ActionCount:       t.ActionCount,
+					ConversationCount: t.ConversationCount,
+				}
+			}
+
+			return &api.GetPersonThemesOKTextHTML{
+				Data: renderTemplate(ctx, templates.ThemesPage(templatePerson, themes)),
+			}, nil
+		}
+	}
+
+	return result, nil
+}
+
+func (h *ContentNegotiatingHandler) CreateTheme(ctx context.Context, req *api.CreateThemeRequest) (api.CreateThemeRes, error) {
+	return h.combinedHandler.CreateTheme(ctx, req)
+}
+
+func (h *ContentNegotiatingHandler) GetThemeById(ctx context.Context, params api.GetThemeByIdParams) (api.GetThemeByIdRes, error) {
+	return h.combinedHandler.GetThemeById(ctx, params)
+}
+
+func (h *ContentNegotiatingHandler) UpdateTheme(ctx context.Context, req *api.UpdateThemeRequest, params api.UpdateThemeParams) (api.UpdateThemeRes, error) {
+	return h.combinedHandler.UpdateTheme(ctx, req, params)
+}
+
+func (h *ContentNegotiatingHandler) DeleteTheme(ctx context.Context, params api.DeleteThemeParams) (api.DeleteThemeRes, error) {
+	return h.combinedHandler.DeleteTheme(ctx, params)
+}
+
+func (h *ContentNegotiatingHandler) MergeThemes(ctx context.Context, req *api.MergeThemesRequest, params api.MergeThemesParams) (api.MergeThemesRes, error) {
+	return h.combinedHandler.MergeThemes(ctx, req, params)
+}
+
+func (h *ContentNegotiatingHandler) SplitTheme(ctx context.Context, req *api.SplitThemeRequest, params api.SplitThemeParams) (api.SplitThemeRes, error) {
+	return h.combinedHandler.SplitTheme(ctx, req, params)
+}
+
+// Levels and level changes are served as JSON only
+func (h *ContentNegotiatingHandler) GetLevels(ctx context.Context) (api.GetLevelsRes, error) {
+	return h.combinedHandler.GetLevels(ctx)
+}
+
+func (h *ContentNegotiatingHandler) SetPersonLevels(ctx context.Context, req *api.SetPersonLevelsRequest, params api.SetPersonLevelsParams) (api.SetPersonLevelsRes, error) {
+	return h.combinedHandler.SetPersonLevels(ctx, req, params)
+}
+
+// GetPersonLadder handles both JSON and HTML requests for a person's career ladder gap
+func (h *ContentNegotiatingHandler) GetPersonLadder(ctx context.Context, params api.GetPersonLadderParams) (api.GetPersonLadderRes, error) {
+	result, err := h.combinedHandler.GetPersonLadder(ctx, params)
+	if err != nil {
+		return result, err
+	}
+
+	if httpReq := h.getRequestFromContext(ctx); httpReq != nil {
+		if h.determineResponseType(httpReq) == "text/html" {
+			switch gap := result.(type) {
+			case *api.LadderGap:
+				templatePerson := templates.Person{ID: gap.PersonID}
+				if res, err := h.combinedHandler.GetPersonById(ctx, api.GetPersonByIdParams{ID: gap.PersonID}); err == nil {
+					if p, ok := res.(*api.Person); ok {
+						templatePerson.Name = p.Name
+					}
+				}
+
+				var levels []api.LadderLevel
+				if res, err := h.combinedHandler.GetLevels(ctx); err == nil {
+					if l, ok := res.(*api.GetLevelsOK); ok {
+						levels = l.Levels
+					}
+				}
+				current := make([]templates.LadderLevel, len(levels))
+				target := make([]templates.LadderLevel, len(levels))
+				for i, l := range levels {
+					current[i] = templates.LadderLevel{ID: l.ID, Name: l.Name, Selected: gap.CurrentLevel.Value.ID == l.ID}
+					target[i] = templates.LadderLevel{ID: l.ID, Name: l.Name, Selected: gap.TargetLevel.Value.ID == l.ID}
+				}
+
+				levelName := gap.CurrentLevel.Value.Name
+				if t, ok := gap.TargetLevel.Get(); ok {
+					levelName = t.Name
+				}
+
+				toActions := func(actions []api.Action) []templates.Action {
+					out := make([]templates.Action, len(actions))
+					for i, a := range actions {
+						out[i] = templates.Action{
+							ID:          a.ID,
+							PersonID:    a.PersonID,
+							OccurredAt:  a.OccurredAt,
+							Description: a.Description,
+							Valence:     string(a.Valence),
+							Impact:      string(a.Impact.Value),
+						}
+					}
+					return out
+				}
+				expectations := make([]templates.GapExpectation, len(gap.Expectations))
+				for i, e := range gap.Expectations {
+					expectations[i] = templates.GapExpectation{
+						ThemeText:   e.Expectation.Theme.Text,
+						Description: e.Expectation.Description,
+						Positive:    toActions(e.Positive),
+						Negative:    toActions(e.Negative),
+						Neutral:     toActions(e.Neutral),
+					}
+				}
+
+				return &api.GetPersonLadderOKTextHTML{
+					Data: renderTemplate(ctx, templates.LadderPage(templatePerson, current, target, levelName, expectations)),
+				}, nil
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// toTemplateFollowUp converts an API follow-up to a template follow-up
+func toTemplateFollowUp(f api.FollowUp) templates.FollowUp {
+	followUp := templates.FollowUp{
+		ID:             f.ID,
+		ConversationID: f.ConversationID,
+		PersonID:       f.PersonID,
+		PersonName:     f.PersonName,
+		Description:    f.Description,
+		Owner:          string(f.Owner),
+		Done:           f.Status == api.FollowUpStatusDone,
+	}
+	if dueOn, ok := f.DueOn.Get(); ok {
+		followUp.DueOn = &dueOn
+	}
+	return followUp
+}
+
+// Search renders a results fragment for HTMX requests and the full search page otherwise
+func (h *ContentNegotiatingHandler) Search(ctx context.Context, params api.SearchParams) (api.SearchRes, error) {
+	result, err := h.combinedHandler.Search(ctx, params)
+	if err != nil {
+		return result, err
+	}
+
+	req := h.getRequestFromContext(ctx)
+	if req == nil || h.determineResponseType(req) != "text/html" {
+		return result, nil
+	}
+	found, ok := result.(*api.SearchOKApplicationJSON)
+	if !ok {
+		return result, nil
+	}
+
+	results := make([]templates.SearchResult, len(found.Results))
+	for i, r := range found.Results {
+		results[i] = templates.SearchResult{
+			Type:       string(r.Type),
+			ID:         r.ID,
+			PersonID:   r.PersonID,
+			PersonName: r.PersonName,
+			OccurredAt: r.OccurredAt,
+			Snippet:    r.Snippet,
+		}
+	}
+
+	if req.Header.Get("HX-Request") == "true" {
+		return &api.SearchOKTextHTML{
+			Data: renderTemplate(ctx, templates.SearchResults(found.Query, results)),
+		}, nil
+	}
+
+	filters := templates.SearchFilters{
+		Query:    found.Query,
+		Type:     string(params.Type.Or("")),
+		PersonID: params.PersonID.Or(""),
+	}
+	if from, ok := params.From.Get(); ok {
+		filters.From = from.Format("2006-01-02")
+	}
+	if to, ok := params.To.Get(); ok {
+		filters.To = to.Format("2006-01-02")
+	}
+
+	var people []templates.Person
+	if res, err := h.combinedHandler.GetPersons(ctx, api.GetPersonsParams{
+		Limit: api.NewOptInt(100),
+	}); err == nil {
+		if list, ok := res.(*api.GetPersonsOKApplicationJSON); ok {
+			for _, p := range list.Persons {
+				people = append(people, templates.Person{ID: p.ID, Name: p.Name})
+			}
+		}
+	}
+
+	return &api.SearchOKTextHTML{
+		Data: renderTemplate(ctx, templates.SearchPage(filters, people, results)),
+	}, nil
+}
+
+// ListTrash renders the trash page for browsers
+func (h *ContentNegotiatingHandler) ListTrash(ctx context.Context, params api.ListTrashParams) (api.ListTrashRes, error) {
+	result, err := h.combinedHandler.ListTrash(ctx, params)
+	if err != nil {
+		return result, err
+	}
+
+	req := h.getRequestFromContext(ctx)
+	if req == nil || h.determineResponseType(req) != "text/html" {
+		return result, nil
+	}
+	list, ok := result.(*api.ListTrashOKApplicationJSON)
+	if !ok {
+		return result, nil
+	}
+
+	items := make([]templates.TrashItem, len(list.Items))
+	for i, item := range list.Items {
+		items[i] = templates.TrashItem{
+			Type:       string(item.Type),
+			ID:         item.ID,
+			Label:      item.Label,
+			PersonName: item.PersonName,
+			DeletedAt:  item.DeletedAt,
+		}
+	}
+
+	return &api.ListTrashOKTextHTML{
+		Data: renderTemplate(ctx, templates.TrashPage(items)),
+	}, nil
+}
+
+func (h *ContentNegotiatingHandler) PurgeTrashItem(ctx context.Context, params api.PurgeTrashItemParams) (api.PurgeTrashItemRes, error) {
+	return h.combinedHandler.PurgeTrashItem(ctx, params)
+}
+
+func (h *ContentNegotiatingHandler) RestoreTrashItem(ctx context.Context, params api.RestoreTrashItemParams) (api.RestoreTrashItemRes, error) {
+	return h.combinedHandler.RestoreTrashItem(ctx, params)
+}
+
+// ListAuditLog renders the audit log page, or every matching entry as CSV when
+// the client asks for text/csv or passes format=csv
+func (h *ContentNegotiatingHandler) ListAuditLog(ctx context.Context, params api.ListAuditLogParams) (api.ListAuditLogRes, error) {
+	req := h.getRequestFromContext(ctx)
+	if params.Format.IsSet() || (req != nil && strings.Contains(req.Header.Get("Accept"), "text/csv")) {
+		return h.exportAuditLog(ctx, params)
+	}
+
+	result, err := h.combinedHandler.ListAuditLog(ctx, params)
+	if err != nil {
+		return result, err
+	}
+	if req == nil || h.determineResponseType(req) != "text/html" {
+		return result, nil
+	}
+	list, ok := result.(*api.ListAuditLogOKApplicationJSON)
+	if !ok {
+		return result, nil
+	}
+
+	entries := make([]templates.AuditEntry, len(list.Entries))
+	for i, e := range list.Entries {
+		entries[i] = templates.AuditEntry{
+			ID:         e.ID,
+			Operation:  e.Operation,
+			EntityIDs:  e.EntityIds,
+			Via:        string(e.Via),
+			Method:     e.Method,
+			Path:       e.Path,
+			RemoteAddr: e.RemoteAddr,
+			UserAgent:  e.UserAgent,
+			Outcome:    e.Outcome,
+			CreatedAt:  e.CreatedAt,
+		}
+	}
+
+	filters := templates.AuditFilters{
+		Operation: params.Operation.Or(""),
+		PersonID:  params.PersonID.Or(""),
+		EntityID:  params.EntityID.Or(""),
+		Via:       string(params.Via.Or("")),
+		Limit:     params.Limit.Or(50),
+		Offset:    params.Offset.Or(0),
+	}
+	if from, ok := params.From.Get(); ok {
+		filters.From = from.Format("2006-01-02")
+	}
+	if to, ok := params.To.Get(); ok {
+		filters.To = to.Format("2006-01-02")
+	}
+
+	if req.Header.Get("HX-Request") == "true" {
+		return &api.ListAuditLogOKTextHTML{
+			Data: renderTemplate(ctx, templates.AuditResults(filters, entries, list.Total)),
+		}, nil
+	}
+
+	var people []templates.Person
+	if res, err := h.combinedHandler.GetPersons(ctx, api.GetPersonsParams{
+		Limit: api.NewOptInt(100),
+	}); err == nil {
+		if list, ok := res.(*api.GetPersonsOKApplicationJSON); ok {
+			for _, p := range list.Persons {
+				people = append(people, templates.Person{ID: p.ID, Name: p.Name})
+			}
+		}
+	}
+
+	return &api.ListAuditLogOKTextHTML{
+		Data: renderTemplate(ctx, templates.AuditPage(filters, people, entries, list.Total)),
+	}, nil
+}
+
+// exportAuditLog pages through every entry matching the filters. Entries logged
+// during the export push older ones onto the next page, so ids already written
+// are skipped rather than repeated.
+func (h *ContentNegotiatingHandler) exportAuditLog(ctx context.Context, params api.ListAuditLogParams) (api.ListAuditLogRes, error) {
+	const pageSize = 100
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"created_at", "operation", "entity_ids", "via", "method", "path", "remote_addr", "user_agent", "outcome", "id"})
+
+	seen := map[string]bool{}
+	params.Limit = api.NewOptInt(pageSize)
+	for offset := 0; ; offset += pageSize {
+		params.Offset = api.NewOptInt(offset)
+		result, err := h.combinedHandler.ListAuditLog(ctx, params)
+		if err != nil {
+			return result, err
+		}
+		list, ok := result.(*api.ListAuditLogOKApplicationJSON)
+		if !ok {
+			return result, nil
+		}
+		for _, e := range list.Entries {
+			if seen[e.ID] {
+				continue
+			}
+			seen[e.ID] = true
+			w.Write([]string{
+				e.CreatedAt.Format(time.RFC3339),
+				csvCell(e.Operation),
+				strings.Join(e.EntityIds, " "),
+				string(e.Via),
+				e.Method,
+				csvCell(e.Path),
+				csvCell(e.RemoteAddr),
+				csvCell(e.UserAgent),
+				csvCell(e.Outcome),
+				e.ID,
+			})
+		}
+		if len(list.Entries) < pageSize {
+			break
+		}
+	}
+	w.Flush()
+
+	return &api.ListAuditLogOKTextCsv{Data: &buf}, nil
+}
+
+// csvCell keeps a client-supplied value such as a user agent from being read as
+// a formula when the export is opened in a spreadsheet
+func csvCell(s string) string {
+	if s != "" && strings.ContainsRune("=+-@\t\r", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
@@ -3,20 +3,40 @@ package handlers
 import (
 	"context"
 	"database/sql"
+	"net/http"
 
 	"github.com/rs/xid"
 	"go.uber.org/zap"
 
 	"pepo/internal/api"
+	"pepo/internal/auth"
 	"pepo/internal/db"
+	"pepo/templates"
 )
 
 type ConversationHandler struct {
+	conn    *sql.DB
 	queries *db.Queries
 }
 
-func NewConversationHandler(queries *db.Queries) *ConversationHandler {
-	return &ConversationHandler{queries: queries}
+func NewConversationHandler(conn *sql.DB, queries *db.Queries) *ConversationHandler {
+	return &ConversationHandler{
+		conn:    conn,
+		queries: queries,
+	}
+}
+
+func convertToAPIConversation(conv db.Conversation) api.Conversation {
+	convID, _ := xid.FromBytes(conv.ID)
+	personID, _ := xid.FromBytes(conv.PersonID)
+	return api.Conversation{
+		ID:          convID.String(),
+		PersonID:    personID.String(),
+		OccurredAt:  conv.OccurredAt,
+		Description: conv.Description,
+		CreatedAt:   conv.CreatedAt,
+		UpdatedAt:   conv.UpdatedAt,
+	}
 }
 
 // CreateConversation handles creating a conversation
@@ -34,13 +54,58 @@ func (h *ConversationHandler) CreateConversation(ctx context.Context, req *api.C
 		}, nil
 	}
 
+	managerID := auth.ManagerID(ctx)
+
+	// The person must belong to the signed-in manager
+	if _, err := h.queries.GetPersonByID(ctx, db.GetPersonByIDParams{
+		ID:        req.PersonID,
+		ManagerID: managerID,
+	}); err != nil {
+		if err == sql.ErrNoRows {
+			return &api.CreateConversationBadRequest{
+				Message: "Person not found",
+				Code:    "INVALID_PERSON",
+			}, nil
+		}
+		zap.L().Error("error getting person", zap.Error(err))
+		return &api.CreateConversationInternalServerError{
+			Message: "Failed to create conversation",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	if msg, code, err := h.checkConversationLinks(ctx, managerID, req.Actions, req.Themes); err != nil {
+		zap.L().Error("error checking conversation links", zap.Error(err))
+		return &api.CreateConversationInternalServerError{
+			Message: "Failed to create conversation",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	} else if msg != "" {
+		return &api.CreateConversationBadRequest{
+			Message: msg,
+			Code:    code,
+		}, nil
+	}
+
+	tx, err := h.conn.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return &api.CreateConversationInternalServerError{
+			Message: "Failed to create conversation",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+	defer tx.Rollback()
+	qtx := h.queries.WithTx(tx)
+
 	id := xid.New().String()
 
-	row, err := h.queries.CreateConversation(ctx, db.CreateConversationParams{
+	row, err := qtx.CreateConversation(ctx, db.CreateConversationParams{
 		ID:          id,
 		PersonID:    req.PersonID,
 		Description: req.Description,
 		OccurredAt:  req.OccurredAt,
+		ManagerID:   managerID,
 	})
 	if err != nil {
 		zap.L().Error("error creating conversation", zap.Error(err))
@@ -52,66 +117,505 @@ func (h *ConversationHandler) CreateConversation(ctx context.Context, req *api.C
 
 	conv := row.Conversation
 
-	// Associate provided actions with the new conversation
-	for _, aID := range req.Actions {
-		if _, err := h.queries.GetActionByID(ctx, aID); err != nil {
-			if err == sql.ErrNoRows {
-				return &api.CreateConversationBadRequest{
-					Message: "Action not found",
-					Code:    "INVALID_ACTION",
-				}, nil
-			}
-			zap.L().Error("error getting action", zap.Error(err))
-			return &api.CreateConversationInternalServerError{
-				Message: "Failed to associate action",
-				Code:    "INTERNAL_ERROR",
+	if err := syncConversationLinks(ctx, qtx, managerID, id, req.Actions, req.Themes); err != nil {
+		zap.L().Error("error associating conversation links", zap.Error(err))
+		return &api.CreateConversationInternalServerError{
+			Message: "Failed to associate actions and themes",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	if err := recordConversationRevision(ctx, qtx, managerID, id); err != nil {
+		zap.L().Error("error recording conversation revision", zap.Error(err))
+		return &api.CreateConversationInternalServerError{
+			Message: "Failed to save revision",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		zap.L().Error("error committing conversation", zap.Error(err))
+		return &api.CreateConversationInternalServerError{
+			Message: "Failed to create conversation",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	apiConv := convertToAPIConversation(conv)
+	h.loadConversationLinks(ctx, managerID, &apiConv)
+	return &apiConv, nil
+}
+
+// GetConversations lists conversations, optionally filtered by person, theme and date range
+func (h *ConversationHandler) GetConversations(ctx context.Context, params api.GetConversationsParams) (api.GetConversationsRes, error) {
+	limit := int32(10)
+	if params.Limit.IsSet() {
+		limit = int32(params.Limit.Value)
+	}
+
+	offset := int32(0)
+	if params.Offset.IsSet() {
+		offset = int32(params.Offset.Value)
+	}
+
+	managerID := auth.ManagerID(ctx)
+	personID := sql.NullString{String: params.PersonID.Value, Valid: params.PersonID.IsSet()}
+	themeID := sql.NullString{String: params.ThemeID.Value, Valid: params.ThemeID.IsSet()}
+	from := sql.NullTime{Time: params.From.Value, Valid: params.From.IsSet()}
+	// "to" includes the whole day
+	before := sql.NullTime{Time: params.To.Value.AddDate(0, 0, 1), Valid: params.To.IsSet()}
+
+	rows, err := h.queries.ListConversations(ctx, db.ListConversationsParams{
+		ManagerID:      managerID,
+		PersonID:       personID,
+		ThemeID:        themeID,
+		OccurredFrom:   from,
+		OccurredBefore: before,
+		Offset:         offset,
+		Limit:          limit,
+	})
+	if err != nil {
+		zap.L().Error("error listing conversations", zap.Error(err))
+		return &api.Error{
+			Message: "Failed to list conversations",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	total, err := h.queries.CountConversations(ctx, db.CountConversationsParams{
+		ManagerID:      managerID,
+		PersonID:       personID,
+		ThemeID:        themeID,
+		OccurredFrom:   from,
+		OccurredBefore: before,
+	})
+	if err != nil {
+		zap.L().Error("error counting conversations", zap.Error(err))
+		return &api.Error{
+			Message: "Failed to list conversations",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	conversations := make([]api.Conversation, len(rows))
+	for i, row := range rows {
+		conversations[i] = convertToAPIConversation(row.Conversation)
+	}
+	if err := h.loadConversationsLinks(ctx, managerID, conversations); err != nil {
+		zap.L().Error("error loading conversation links", zap.Error(err))
+		return &api.Error{
+			Message: "Failed to list conversations",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	return &api.GetConversationsOKApplicationJSON{
+		Conversations: conversations,
+		Total:         int(total),
+	}, nil
+}
+
+// GetConversationById returns a single conversation with its themes and actions
+func (h *ConversationHandler) GetConversationById(ctx context.Context, params api.GetConversationByIdParams) (api.GetConversationByIdRes, error) {
+	managerID := auth.ManagerID(ctx)
+
+	row, err := h.queries.GetConversationByID(ctx, db.GetConversationByIDParams{
+		ID:        params.ID,
+		ManagerID: managerID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &api.GetConversationByIdNotFound{
+				Message: "Conversation not found",
+				Code:    "NOT_FOUND",
 			}, nil
 		}
-		if err := h.queries.AddActionToConversation(ctx, db.AddActionToConversationParams{
-			ActionID:       aID,
-			ConversationID: id,
-		}); err != nil {
-			zap.L().Error("error adding action to conversation", zap.Error(err))
-			return &api.CreateConversationInternalServerError{
-				Message: "Failed to associate action",
-				Code:    "INTERNAL_ERROR",
+		zap.L().Error("error getting conversation", zap.Error(err))
+		return &api.GetConversationByIdInternalServerError{
+			Message: "Failed to get conversation",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	apiConv := convertToAPIConversation(row.Conversation)
+	h.loadConversationLinks(ctx, managerID, &apiConv)
+	return &apiConv, nil
+}
+
+// UpdateConversation updates a conversation and replaces its linked actions and themes
+func (h *ConversationHandler) UpdateConversation(ctx context.Context, req *api.UpdateConversationRequest, params api.UpdateConversationParams) (api.UpdateConversationRes, error) {
+	if req.PersonID == "" {
+		return &api.UpdateConversationBadRequest{
+			Message: "Person ID is required",
+			Code:    "VALIDATION_ERROR",
+		}, nil
+	}
+	if req.Description == "" {
+		return &api.UpdateConversationBadRequest{
+			Message: "Description is required",
+			Code:    "VALIDATION_ERROR",
+		}, nil
+	}
+
+	managerID := auth.ManagerID(ctx)
+
+	// The person must belong to the signed-in manager
+	if _, err := h.queries.GetPersonByID(ctx, db.GetPersonByIDParams{
+		ID:        req.PersonID,
+		ManagerID: managerID,
+	}); err != nil {
+		if err == sql.ErrNoRows {
+			return &api.UpdateConversationBadRequest{
+				Message: "Person not found",
+				Code:    "INVALID_PERSON",
 			}, nil
 		}
+		zap.L().Error("error getting person", zap.Error(err))
+		return &api.UpdateConversationInternalServerError{
+			Message: "Failed to update conversation",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	if msg, code, err := h.checkConversationLinks(ctx, managerID, req.Actions, req.Themes); err != nil {
+		zap.L().Error("error checking conversation links", zap.Error(err))
+		return &api.UpdateConversationInternalServerError{
+			Message: "Failed to update conversation",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	} else if msg != "" {
+		return &api.UpdateConversationBadRequest{
+			Message: msg,
+			Code:    code,
+		}, nil
 	}
 
-	// Associate provided themes with the new conversation
-	for _, tID := range req.Themes {
-		if _, err := h.queries.GetThemeByID(ctx, tID); err != nil {
+	tx, err := h.conn.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return &api.UpdateConversationInternalServerError{
+			Message: "Failed to update conversation",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+	defer tx.Rollback()
+	qtx := h.queries.WithTx(tx)
+
+	row, err := qtx.UpdateConversation(ctx, db.UpdateConversationParams{
+		ID:          params.ID,
+		PersonID:    req.PersonID,
+		Description: req.Description,
+		OccurredAt:  req.OccurredAt,
+		ManagerID:   managerID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &api.UpdateConversationNotFound{
+				Message: "Conversation not found",
+				Code:    "NOT_FOUND",
+			}, nil
+		}
+		zap.L().Error("error updating conversation", zap.Error(err))
+		return &api.UpdateConversationInternalServerError{
+			Message: "Failed to update conversation",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	if err := syncConversationLinks(ctx, qtx, managerID, params.ID, req.Actions, req.Themes); err != nil {
+		zap.L().Error("error updating conversation links", zap.Error(err))
+		return &api.UpdateConversationInternalServerError{
+			Message: "Failed to update actions and themes",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	if err := recordConversationRevision(ctx, qtx, managerID, params.ID); err != nil {
+		zap.L().Error("error recording conversation revision", zap.Error(err))
+		return &api.UpdateConversationInternalServerError{
+			Message: "Failed to save revision",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		zap.L().Error("error committing conversation update", zap.Error(err))
+		return &api.UpdateConversationInternalServerError{
+			Message: "Failed to update conversation",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	apiConv := convertToAPIConversation(row.Conversation)
+	h.loadConversationLinks(ctx, managerID, &apiConv)
+	return &apiConv, nil
+}
+
+// DeleteConversation moves the conversation to the trash; its links, follow-ups
+// and attachments stay until it is purged
+func (h *ConversationHandler) DeleteConversation(ctx context.Context, params api.DeleteConversationParams) (api.DeleteConversationRes, error) {
+	trashed, err := h.queries.TrashConversation(ctx, db.TrashConversationParams{
+		ID:        params.ID,
+		ManagerID: auth.ManagerID(ctx),
+	})
+	if err != nil {
+		zap.L().Error("error deleting conversation", zap.Error(err))
+		return &api.DeleteConversationInternalServerError{
+			Message: "Failed to delete conversation",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+	if trashed == 0 {
+		return &api.DeleteConversationNotFound{
+			Message: "Conversation not found",
+			Code:    "NOT_FOUND",
+		}, nil
+	}
+
+	return &api.DeleteConversationNoContent{}, nil
+}
+
+// checkConversationLinks verifies that every action and theme belongs to the
+// manager. A non-empty message describes the first one that does not.
+func (h *ConversationHandler) checkConversationLinks(ctx context.Context, managerID string, actionIDs, themeIDs []string) (string, string, error) {
+	for _, aID := range actionIDs {
+		if _, err := h.queries.GetActionByID(ctx, db.GetActionByIDParams{
+			ID:        aID,
+			ManagerID: managerID,
+		}); err != nil {
 			if err == sql.ErrNoRows {
-				return &api.CreateConversationBadRequest{
-					Message: "Theme not found",
-					Code:    "INVALID_THEME",
-				}, nil
+				return "Action not found", "INVALID_ACTION", nil
 			}
-			zap.L().Error("error getting theme", zap.Error(err))
-			return &api.CreateConversationInternalServerError{
-				Message: "Failed to associate theme",
-				Code:    "INTERNAL_ERROR",
-			}, nil
+			return "", "", err
 		}
-		if err := h.queries.AddThemeToConversation(ctx, db.AddThemeToConversationParams{
-			ConversationID: id,
-			ThemeID:        tID,
+	}
+	for _, tID := range themeIDs {
+		if _, err := h.queries.GetThemeByID(ctx, db.GetThemeByIDParams{
+			ID:        tID,
+			ManagerID: managerID,
 		}); err != nil {
-			zap.L().Error("error adding theme to conversation", zap.Error(err))
-			return &api.CreateConversationInternalServerError{
-				Message: "Failed to associate theme",
-				Code:    "INTERNAL_ERROR",
-			}, nil
+			if err == sql.ErrNoRows {
+				return "Theme not found", "INVALID_THEME", nil
+			}
+			return "", "", err
 		}
 	}
+	return "", "", nil
+}
 
-	return &api.Conversation{
-		ID:          id,
-		PersonID:    req.PersonID,
-		OccurredAt:  conv.OccurredAt,
-		Description: conv.Description,
-		CreatedAt:   conv.CreatedAt,
-		UpdatedAt:   conv.UpdatedAt,
-	}, nil
+// syncConversationLinks makes the conversation's actions and themes match the
+// given IDs, adding missing links and removing the rest.
+func syncConversationLinks(ctx context.Context, q *db.Queries, managerID, conversationID string, actionIDs, themeIDs []string) error {
+	actionRows, err := q.ListActionsByConversationID(ctx, db.ListActionsByConversationIDParams{
+		ConversationID: conversationID,
+		ManagerID:      managerID,
+		Offset:         0,
+		Limit:          100,
+	})
+	if err != nil {
+		return err
+	}
+	selectedActions := map[string]bool{}
+	for _, id := range actionIDs {
+		selectedActions[id] = true
+	}
+	existingActions := map[string]bool{}
+	for _, row := range actionRows {
+		id := row.Action.ID.String()
+		existingActions[id] = true
+		if !selectedActions[id] {
+			if err := q.RemoveActionFromConversation(ctx, db.RemoveActionFromConversationParams{
+				ConversationID: conversationID,
+				ActionID:       id,
+				ManagerID:      managerID,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	for id := range selectedActions {
+		if !existingActions[id] {
+			if err := q.AddActionToConversation(ctx, db.AddActionToConversationParams{
+				ActionID:       id,
+				ConversationID: conversationID,
+				ManagerID:      managerID,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return syncConversationThemes(ctx, q, managerID, conversationID, themeIDs)
+}
+
+// syncConversationThemes makes the conversation's themes match the given IDs
+func syncConversationThemes(ctx context.Context, q *db.Queries, managerID, conversationID string, themeIDs []string) error {
+	themeRows, err := q.ListThemesByConversationID(ctx, db.ListThemesByConversationIDParams{
+		ConversationID: conversationID,
+		ManagerID:      managerID,
+		Offset:         0,
+		Limit:          100,
+	})
+	if err != nil {
+		return err
+	}
+	selectedThemes := map[string]bool{}
+	for _, id := range themeIDs {
+		selectedThemes[id] = true
+	}
+	existingThemes := map[string]bool{}
+	for _, row := range themeRows {
+		id := row.Theme.ID.String()
+		existingThemes[id] = true
+		if !selectedThemes[id] {
+			if err := q.RemoveThemeFromConversation(ctx, db.RemoveThemeFromConversationParams{
+				ConversationID: conversationID,
+				ThemeID:        id,
+				ManagerID:      managerID,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+	for id := range selectedThemes {
+		if !existingThemes[id] {
+			if err := q.AddThemeToConversation(ctx, db.AddThemeToConversationParams{
+				ConversationID: conversationID,
+				ThemeID:        id,
+				ManagerID:      managerID,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// loadConversationLinks fills in the conversation's themes and action IDs
+func (h *ConversationHandler) loadConversationLinks(ctx context.Context, managerID string, conv *api.Conversation) {
+	if themeRows, err := h.queries.ListThemesByConversationID(ctx, db.ListThemesByConversationIDParams{
+		ConversationID: conv.ID,
+		ManagerID:      managerID,
+		Offset:         0,
+		Limit:          100,
+	}); err == nil {
+		conv.Themes = make([]api.Theme, len(themeRows))
+		for i, row := range themeRows {
+			conv.Themes[i] = api.Theme{ID: row.Theme.ID.String(), Text: row.Theme.Text}
+		}
+	} else {
+		zap.L().Error("error listing conversation themes", zap.Error(err))
+	}
+
+	if actionRows, err := h.queries.ListActionsByConversationID(ctx, db.ListActionsByConversationIDParams{
+		ConversationID: conv.ID,
+		ManagerID:      managerID,
+		Offset:         0,
+		Limit:          100,
+	}); err == nil {
+		conv.Actions = make([]string, len(actionRows))
+		for i, row := range actionRows {
+			conv.Actions[i] = row.Action.ID.String()
+		}
+	} else {
+		zap.L().Error("error listing conversation actions", zap.Error(err))
+	}
+}
+
+// loadConversationsLinks fills in the themes and action IDs of a page of
+// conversations with one query for each, rather than two per conversation
+func (h *ConversationHandler) loadConversationsLinks(ctx context.Context, managerID string, convs []api.Conversation) error {
+	if len(convs) == 0 {
+		return nil
+	}
+	ids := make([]string, len(convs))
+	for i, conv := range convs {
+		ids[i] = conv.ID
+	}
+
+	themeRows, err := h.queries.ListThemesByConversationIDs(ctx, db.ListThemesByConversationIDsParams{
+		ConversationIds: ids,
+		ManagerID:       managerID,
+	})
+	if err != nil {
+		return err
+	}
+	themes := map[string][]api.Theme{}
+	for _, row := range themeRows {
+		themes[row.ConversationID] = append(themes[row.ConversationID], api.Theme{ID: row.Theme.ID.String(), Text: row.Theme.Text})
+	}
+
+	actionRows, err := h.queries.ListActionsByConversationIDs(ctx, db.ListActionsByConversationIDsParams{
+		ConversationIds: ids,
+		ManagerID:       managerID,
+	})
+	if err != nil {
+		return err
+	}
+	actions := map[string][]string{}
+	for _, row := range actionRows {
+		actions[row.ConversationID] = append(actions[row.ConversationID], row.Action.ID.String())
+	}
+
+	for i := range convs {
+		convs[i].Themes = append([]api.Theme{}, themes[convs[i].ID]...)
+		convs[i].Actions = append([]string{}, actions[convs[i].ID]...)
+	}
+	return nil
+}
+
+// HandleGetConversationsForSelect renders the person's conversations as options,
+// selecting the ones the given action is already linked to
+func (h *ConversationHandler) HandleGetConversationsForSelect(w http.ResponseWriter, r *http.Request) {
+	personID := r.URL.Query().Get("person_id")
+	if personID == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		templates.ConversationSelectError().Render(r.Context(), w)
+		return
+	}
+
+	managerID := auth.ManagerID(r.Context())
+
+	selected := map[string]bool{}
+	if actionID := r.URL.Query().Get("action_id"); actionID != "" {
+		rows, err := h.queries.ListConversationsByActionID(r.Context(), db.ListConversationsByActionIDParams{
+			ActionID:  actionID,
+			ManagerID: managerID,
+			Offset:    0,
+			Limit:     100,
+		})
+		if err == nil {
+			for _, row := range rows {
+				id, _ := xid.FromBytes(row.Conversation.ID)
+				selected[id.String()] = true
+			}
+		}
+	}
+
+	rows, err := h.queries.ListConversationsByPersonID(r.Context(), db.ListConversationsByPersonIDParams{
+		PersonID:  personID,
+		ManagerID: managerID,
+		Offset:    0,
+		Limit:     100,
+	})
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		templates.ConversationSelectError().Render(r.Context(), w)
+		return
+	}
+
+	conversations := make([]templates.ConversationOption, len(rows))
+	for i, row := range rows {
+		c := convertToAPIConversation(row.Conversation)
+		conversations[i] = templates.ConversationOption{
+			ID:          c.ID,
+			OccurredAt:  c.OccurredAt,
+			Description: c.Description,
+			Selected:    selected[c.ID],
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	templates.ConversationSelectOptions(conversations).Render(r.Context(), w)
 }
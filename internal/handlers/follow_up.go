@@ -0,0 +1,197 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/rs/xid"
+	"go.uber.org/zap"
+
+	"pepo/internal/api"
+	"pepo/internal/auth"
+	"pepo/internal/db"
+)
+
+type FollowUpHandler struct {
+	queries *db.Queries
+}
+
+func NewFollowUpHandler(queries *db.Queries) *FollowUpHandler {
+	return &FollowUpHandler{queries: queries}
+}
+
+func convertToAPIFollowUp(f db.FollowUp, personID, personName string) api.FollowUp {
+	followUp := api.FollowUp{
+		ID:             f.ID.String(),
+		ConversationID: f.ConversationID.String(),
+		PersonID:       personID,
+		PersonName:     personName,
+		Description:    f.Description,
+		Owner:          api.FollowUpOwner(f.Owner),
+		DueOn:          api.OptNilDate{Set: true, Null: true},
+		Status:         api.FollowUpStatusOpen,
+		CompletedAt:    api.OptNilDateTime{Set: true, Null: true},
+		CreatedAt:      f.CreatedAt,
+		UpdatedAt:      f.UpdatedAt,
+	}
+	if f.DueOn.Valid {
+		followUp.DueOn = api.NewOptNilDate(f.DueOn.Time)
+	}
+	if f.CompletedAt.Valid {
+		followUp.Status = api.FollowUpStatusDone
+		followUp.CompletedAt = api.NewOptNilDateTime(f.CompletedAt.Time)
+	}
+	return followUp
+}
+
+// GetFollowUps lists follow-ups across all of the manager's reports, open ones first
+func (h *FollowUpHandler) GetFollowUps(ctx context.Context, params api.GetFollowUpsParams) (api.GetFollowUpsRes, error) {
+	limit := int32(10)
+	if params.Limit.IsSet() {
+		limit = int32(params.Limit.Value)
+	}
+
+	offset := int32(0)
+	if params.Offset.IsSet() {
+		offset = int32(params.Offset.Value)
+	}
+
+	managerID := auth.ManagerID(ctx)
+	personID := sql.NullString{String: params.PersonID.Value, Valid: params.PersonID.IsSet()}
+	conversationID := sql.NullString{String: params.ConversationID.Value, Valid: params.ConversationID.IsSet()}
+	done := sql.NullBool{Bool: params.Status.Value == api.GetFollowUpsStatusDone, Valid: params.Status.IsSet()}
+
+	rows, err := h.queries.ListFollowUps(ctx, db.ListFollowUpsParams{
+		ManagerID:      managerID,
+		PersonID:       personID,
+		ConversationID: conversationID,
+		Done:           done,
+		Offset:         offset,
+		Limit:          limit,
+	})
+	if err != nil {
+		zap.L().Error("error listing follow-ups", zap.Error(err))
+		return &api.Error{
+			Message: "Failed to list follow-ups",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	total, err := h.queries.CountFollowUps(ctx, db.CountFollowUpsParams{
+		ManagerID:      managerID,
+		PersonID:       personID,
+		ConversationID: conversationID,
+		Done:           done,
+	})
+	if err != nil {
+		zap.L().Error("error counting follow-ups", zap.Error(err))
+		return &api.Error{
+			Message: "Failed to list follow-ups",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	followUps := make([]api.FollowUp, len(rows))
+	for i, row := range rows {
+		followUps[i] = convertToAPIFollowUp(row.FollowUp, row.PersonID, row.PersonName)
+	}
+
+	return &api.GetFollowUpsOKApplicationJSON{
+		FollowUps: followUps,
+		Total:     int(total),
+	}, nil
+}
+
+// CreateFollowUp records a commitment that came out of a conversation
+func (h *FollowUpHandler) CreateFollowUp(ctx context.Context, req *api.CreateFollowUpRequest) (api.CreateFollowUpRes, error) {
+	if strings.TrimSpace(req.Description) == "" {
+		return &api.CreateFollowUpBadRequest{
+			Message: "Description is required",
+			Code:    "VALIDATION_ERROR",
+		}, nil
+	}
+
+	managerID := auth.ManagerID(ctx)
+
+	dueOn := sql.NullTime{}
+	if d, ok := req.DueOn.Get(); ok {
+		dueOn = sql.NullTime{Time: d, Valid: true}
+	}
+
+	// The insert selects from the conversation, so it only succeeds for the manager's own
+	row, err := h.queries.CreateFollowUp(ctx, db.CreateFollowUpParams{
+		ID:             xid.New().String(),
+		Description:    req.Description,
+		Owner:          db.FollowUpOwner(req.Owner),
+		DueOn:          dueOn,
+		ConversationID: req.ConversationID,
+		ManagerID:      managerID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &api.CreateFollowUpBadRequest{
+				Message: "Conversation not found",
+				Code:    "INVALID_CONVERSATION",
+			}, nil
+		}
+		zap.L().Error("error creating follow-up", zap.Error(err))
+		return &api.CreateFollowUpInternalServerError{
+			Message: "Failed to create follow-up",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	created, err := h.queries.GetFollowUpByID(ctx, db.GetFollowUpByIDParams{
+		ID:        row.FollowUp.ID.String(),
+		ManagerID: managerID,
+	})
+	if err != nil {
+		zap.L().Error("error getting follow-up", zap.Error(err))
+		return &api.CreateFollowUpInternalServerError{
+			Message: "Failed to create follow-up",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	followUp := convertToAPIFollowUp(created.FollowUp, created.PersonID, created.PersonName)
+	return &followUp, nil
+}
+
+// CompleteFollowUp marks a follow-up as done; completing it again keeps the original time
+func (h *FollowUpHandler) CompleteFollowUp(ctx context.Context, params api.CompleteFollowUpParams) (api.CompleteFollowUpRes, error) {
+	managerID := auth.ManagerID(ctx)
+
+	updated, err := h.queries.CompleteFollowUp(ctx, db.CompleteFollowUpParams{
+		ID:        params.ID,
+		ManagerID: managerID,
+	})
+	if err != nil {
+		zap.L().Error("error completing follow-up", zap.Error(err))
+		return &api.CompleteFollowUpInternalServerError{
+			Message: "Failed to complete follow-up",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+	if updated == 0 {
+		return &api.CompleteFollowUpNotFound{
+			Message: "Follow-up not found",
+			Code:    "NOT_FOUND",
+		}, nil
+	}
+
+	row, err := h.queries.GetFollowUpByID(ctx, db.GetFollowUpByIDParams{
+		ID:        params.ID,
+		ManagerID: managerID,
+	})
+	if err != nil {
+		zap.L().Error("error getting follow-up", zap.Error(err))
+		return &api.CompleteFollowUpInternalServerError{
+			Message: "Failed to complete follow-up",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	followUp := convertToAPIFollowUp(row.FollowUp, row.PersonID, row.PersonName)
+	return &followUp, nil
+}
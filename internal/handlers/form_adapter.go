@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"pepo/internal/api"
+	"pepo/internal/references"
 )
 
 // FormAdapter handles conversion between HTML form data and API request structures
@@ -91,9 +92,6 @@ func (f *FormAdapter) ParseCreateActionRequest(r *http.Request) (*api.CreateActi
 		occurredAt = time.Now()
 	}
 
-	// Optional references field
-	references := strings.TrimSpace(r.FormValue("references"))
-
 	req := &api.CreateActionRequest{
 		PersonID:    personID,
 		OccurredAt:  occurredAt,
@@ -101,9 +99,8 @@ func (f *FormAdapter) ParseCreateActionRequest(r *http.Request) (*api.CreateActi
 		Valence:     valence,
 	}
 
-	if references != "" {
-		req.References = api.OptNilString{Value: references, Set: true}
-	}
+	// Optional references, one per line
+	req.References = referenceInputs(r.FormValue("references"))
 
 	// Parse existing theme IDs
 	if themes := r.Form["themes"]; len(themes) > 0 {
@@ -164,6 +161,8 @@ func (f *FormAdapter) ParseUpdateActionRequest(r *http.Request) (*api.UpdateActi
 		valence = api.UpdateActionRequestValencePositive
 	case "negative":
 		valence = api.UpdateActionRequestValenceNegative
+	case "neutral":
+		valence = api.UpdateActionRequestValenceNeutral
 	default:
 		return nil, &ValidationError{Field: "valence", Message: "Invalid valence. Must be positive, negative, or neutral"}
 	}
@@ -188,9 +187,6 @@ func (f *FormAdapter) ParseUpdateActionRequest(r *http.Request) (*api.UpdateActi
 		}
 	}
 
-	// Optional references field
-	references := strings.TrimSpace(r.FormValue("references"))
-
 	req := &api.UpdateActionRequest{
 		PersonID:    personID,
 		OccurredAt:  occurredAt,
@@ -198,13 +194,28 @@ func (f *FormAdapter) ParseUpdateActionRequest(r *http.Request) (*api.UpdateActi
 		Valence:     valence,
 	}
 
-	if references != "" {
-		req.References = api.OptNilString{Value: references, Set: true}
-	}
+	// Optional references, one per line
+	req.References = referenceInputs(r.FormValue("references"))
 
 	return req, nil
 }
 
+// referenceInputs turns the references textarea into typed entries
+func referenceInputs(text string) []api.ReferenceInput {
+	var inputs []api.ReferenceInput
+	for _, e := range references.ParseLines(text) {
+		input := api.ReferenceInput{
+			Kind:  api.NewOptReferenceInputKind(api.ReferenceInputKind(e.Kind)),
+			Value: e.Value,
+		}
+		if e.Title != "" {
+			input.Title = api.NewOptNilString(e.Title)
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs
+}
+
 // IsFormData checks if the request contains form data
 func (f *FormAdapter) IsFormData(r *http.Request) bool {
 	contentType := r.Header.Get("Content-Type")
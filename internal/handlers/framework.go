@@ -0,0 +1,100 @@
+package handlers
+
+import (
+	"database/sql"
+	"fmt"
+	"io"
+	"net/http"
+
+	"pepo/internal/auth"
+	"pepo/internal/db"
+	"pepo/internal/framework"
+	"pepo/templates"
+
+	"go.uber.org/zap"
+)
+
+// maxFrameworkFileSize caps uploads; a framework is a short list of competencies
+const maxFrameworkFileSize = 1 << 20
+
+type FrameworkHandler struct {
+	conn    *sql.DB
+	queries *db.Queries
+}
+
+func NewFrameworkHandler(conn *sql.DB, queries *db.Queries) *FrameworkHandler {
+	return &FrameworkHandler{conn: conn, queries: queries}
+}
+
+// HandleFramework lists the framework themes on GET and imports an uploaded framework file on POST
+func (h *FrameworkHandler) HandleFramework(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		h.renderFramework(w, r, http.StatusOK, "", "")
+	case http.MethodPost:
+		r.Body = http.MaxBytesReader(w, r.Body, maxFrameworkFileSize)
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			h.renderFramework(w, r, http.StatusBadRequest, "", "Choose a YAML or JSON framework file")
+			return
+		}
+		defer file.Close()
+
+		data, err := io.ReadAll(file)
+		if err != nil {
+			h.renderFramework(w, r, http.StatusBadRequest, "", "Failed to read framework file")
+			return
+		}
+
+		f, err := framework.Parse(data)
+		if err != nil {
+			h.renderFramework(w, r, http.StatusBadRequest, "", err.Error())
+			return
+		}
+
+		n, err := framework.Import(r.Context(), h.conn, h.queries, auth.ManagerID(r.Context()), f)
+		if err != nil {
+			zap.L().Error("error importing framework", zap.Error(err))
+			h.renderFramework(w, r, http.StatusInternalServerError, "", "Failed to import framework")
+			return
+		}
+
+		h.renderFramework(w, r, http.StatusOK, fmt.Sprintf("Imported %d competencies", n), "")
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *FrameworkHandler) renderFramework(w http.ResponseWriter, r *http.Request, status int, notice, errorMessage string) {
+	rows, err := h.queries.ListThemesWithCounts(r.Context(), db.ListThemesWithCountsParams{
+		ManagerID:       auth.ManagerID(r.Context()),
+		IncludeArchived: true,
+		Framework:       sql.NullBool{Bool: true, Valid: true},
+		Offset:          0,
+		Limit:           100,
+	})
+	if err != nil {
+		zap.L().Error("error listing framework themes", zap.Error(err))
+		status = http.StatusInternalServerError
+		errorMessage = "Failed to load framework"
+	}
+
+	themes := make([]templates.ThemeSummary, len(rows))
+	for i, row := range rows {
+		themes[i] = templates.ThemeSummary{
+			ID:                row.Theme.ID.String(),
+			Text:              row.Theme.Text,
+			Description:       row.Theme.Description.String,
+			Archived:          row.Theme.ArchivedAt.Valid,
+			ActionCount:       int(row.ActionCount),
+			ConversationCount: int(row.ConversationCount),
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(status)
+	if err := templates.FrameworkPage(themes, notice, errorMessage).Render(r.Context(), w); err != nil {
+		zap.L().Error("error rendering framework page", zap.Error(err))
+	}
+}
@@ -0,0 +1,257 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+
+	xidb "github.com/rs/xid/b"
+	"go.uber.org/zap"
+
+	"pepo/internal/api"
+	"pepo/internal/auth"
+	"pepo/internal/db"
+)
+
+type LadderHandler struct {
+	queries *db.Queries
+}
+
+func NewLadderHandler(queries *db.Queries) *LadderHandler {
+	return &LadderHandler{queries: queries}
+}
+
+// loadLevels returns the manager's ladder from most junior to most senior, with each
+// level's expectations filled in
+func (h *LadderHandler) loadLevels(ctx context.Context, managerID string) ([]api.LadderLevel, error) {
+	rows, err := h.queries.ListLadderLevels(ctx, managerID)
+	if err != nil {
+		return nil, err
+	}
+
+	expectations, err := h.queries.ListLevelExpectations(ctx, db.ListLevelExpectationsParams{
+		ManagerID: managerID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	byLevel := map[string][]api.LevelExpectation{}
+	for _, row := range expectations {
+		e := row.LevelExpectation
+		levelID := e.LevelID.String()
+		byLevel[levelID] = append(byLevel[levelID], api.LevelExpectation{
+			ID:          e.ID.String(),
+			Theme:       api.Theme{ID: e.ThemeID.String(), Text: row.ThemeText},
+			Description: e.Description,
+		})
+	}
+
+	levels := make([]api.LadderLevel, len(rows))
+	for i, row := range rows {
+		l := row.LadderLevel
+		level := api.LadderLevel{
+			ID:           l.ID.String(),
+			Name:         l.Name,
+			Rank:         int(l.Rank),
+			Description:  api.NilString{Null: true},
+			Expectations: byLevel[l.ID.String()],
+		}
+		if level.Expectations == nil {
+			level.Expectations = []api.LevelExpectation{}
+		}
+		if l.Description.Valid {
+			level.Description = api.NewNilString(l.Description.String)
+		}
+		levels[i] = level
+	}
+	return levels, nil
+}
+
+// GetLevels lists the manager's career ladder
+func (h *LadderHandler) GetLevels(ctx context.Context) (api.GetLevelsRes, error) {
+	levels, err := h.loadLevels(ctx, auth.ManagerID(ctx))
+	if err != nil {
+		zap.L().Error("error listing ladder levels", zap.Error(err))
+		return &api.Error{
+			Message: "Failed to list levels",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	return &api.GetLevelsOK{Levels: levels}, nil
+}
+
+// buildGap measures the person against their target level, or their current level when
+// no target is set. Evidence is every action of theirs tagged with the expectation's
+// competency; expectations without any are flagged.
+func (h *LadderHandler) buildGap(ctx context.Context, managerID, personID string, current, target string) (*api.LadderGap, error) {
+	levels, err := h.loadLevels(ctx, managerID)
+	if err != nil {
+		return nil, err
+	}
+
+	gap := &api.LadderGap{
+		PersonID:     personID,
+		Expectations: []api.GapExpectation{},
+	}
+	var focus *api.LadderLevel
+	for i := range levels {
+		// Current and target can be the same level, so both checks run
+		if levels[i].ID == current {
+			gap.CurrentLevel = api.NewOptLadderLevel(levels[i])
+			if focus == nil {
+				focus = &levels[i]
+			}
+		}
+		if levels[i].ID == target {
+			gap.TargetLevel = api.NewOptLadderLevel(levels[i])
+			focus = &levels[i]
+		}
+	}
+	if focus == nil {
+		return gap, nil
+	}
+
+	for _, e := range focus.Expectations {
+		rows, err := h.queries.ListActionsByThemeID(ctx, db.ListActionsByThemeIDParams{
+			ThemeID:   e.Theme.ID,
+			ManagerID: managerID,
+			PersonID:  sql.NullString{String: personID, Valid: true},
+			Offset:    0,
+			Limit:     100,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		item := api.GapExpectation{
+			Expectation: e,
+			Positive:    []api.Action{},
+			Negative:    []api.Action{},
+			Neutral:     []api.Action{},
+			HasEvidence: len(rows) > 0,
+		}
+		for _, row := range rows {
+			action := convertToAPIAction(row.Action)
+			switch action.Valence {
+			case api.ActionValencePositive:
+				item.Positive = append(item.Positive, action)
+			case api.ActionValenceNegative:
+				item.Negative = append(item.Negative, action)
+			default:
+				item.Neutral = append(item.Neutral, action)
+			}
+		}
+		gap.Expectations = append(gap.Expectations, item)
+	}
+	return gap, nil
+}
+
+// idOrEmpty turns a nullable level column into the ID string buildGap compares against
+func idOrEmpty(id xidb.ID) string {
+	if id.IsNil() {
+		return ""
+	}
+	return id.String()
+}
+
+func (h *LadderHandler) GetPersonLadder(ctx context.Context, params api.GetPersonLadderParams) (api.GetPersonLadderRes, error) {
+	managerID := auth.ManagerID(ctx)
+
+	row, err := h.queries.GetPersonLevels(ctx, db.GetPersonLevelsParams{
+		ID:        params.ID,
+		ManagerID: managerID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &api.GetPersonLadderNotFound{
+				Message: "Person not found",
+				Code:    "NOT_FOUND",
+			}, nil
+		}
+		zap.L().Error("error getting person levels", zap.Error(err))
+		return &api.GetPersonLadderInternalServerError{
+			Message: "Failed to build ladder gap",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	gap, err := h.buildGap(ctx, managerID, params.ID, idOrEmpty(row.CurrentLevelID), idOrEmpty(row.TargetLevelID))
+	if err != nil {
+		zap.L().Error("error building ladder gap", zap.Error(err))
+		return &api.GetPersonLadderInternalServerError{
+			Message: "Failed to build ladder gap",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	return gap, nil
+}
+
+// SetPersonLevels replaces both levels; leaving one out clears it
+func (h *LadderHandler) SetPersonLevels(ctx context.Context, req *api.SetPersonLevelsRequest, params api.SetPersonLevelsParams) (api.SetPersonLevelsRes, error) {
+	managerID := auth.ManagerID(ctx)
+
+	current := sql.NullString{}
+	if id, ok := req.CurrentLevelID.Get(); ok {
+		current = sql.NullString{String: id, Valid: true}
+	}
+	target := sql.NullString{}
+	if id, ok := req.TargetLevelID.Get(); ok {
+		target = sql.NullString{String: id, Valid: true}
+	}
+
+	// Both levels must be on the manager's own ladder
+	for _, levelID := range []sql.NullString{current, target} {
+		if !levelID.Valid {
+			continue
+		}
+		if _, err := h.queries.GetLadderLevelByID(ctx, db.GetLadderLevelByIDParams{
+			ID:        levelID.String,
+			ManagerID: managerID,
+		}); err != nil {
+			if err == sql.ErrNoRows {
+				return &api.SetPersonLevelsBadRequest{
+					Message: "Level not found",
+					Code:    "INVALID_LEVEL",
+				}, nil
+			}
+			zap.L().Error("error getting ladder level", zap.Error(err))
+			return &api.SetPersonLevelsInternalServerError{
+				Message: "Failed to set levels",
+				Code:    "INTERNAL_ERROR",
+			}, nil
+		}
+	}
+
+	updated, err := h.queries.SetPersonLevels(ctx, db.SetPersonLevelsParams{
+		CurrentLevelID: current,
+		TargetLevelID:  target,
+		ID:             params.ID,
+		ManagerID:      managerID,
+	})
+	if err != nil {
+		zap.L().Error("error setting person levels", zap.Error(err))
+		return &api.SetPersonLevelsInternalServerError{
+			Message: "Failed to set levels",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+	if updated == 0 {
+		return &api.SetPersonLevelsNotFound{
+			Message: "Person not found",
+			Code:    "NOT_FOUND",
+		}, nil
+	}
+
+	gap, err := h.buildGap(ctx, managerID, params.ID, current.String, target.String)
+	if err != nil {
+		zap.L().Error("error building ladder gap", zap.Error(err))
+		return &api.SetPersonLevelsInternalServerError{
+			Message: "Failed to set levels",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	return gap, nil
+}
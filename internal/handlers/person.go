@@ -5,11 +5,15 @@ import (
 	"database/sql"
 	"net/http"
 	"sort"
+	"time"
 
 	"github.com/rs/xid"
 
 	"pepo/internal/api"
+	"pepo/internal/auth"
+	"pepo/internal/cursor"
 	"pepo/internal/db"
+	"pepo/internal/period"
 	"pepo/templates"
 
 	"go.uber.org/zap"
@@ -36,13 +40,36 @@ func (h *PersonHandler) CreatePerson(ctx context.Context, req *api.CreatePersonR
 		}, nil
 	}
 
+	managerID := auth.ManagerID(ctx)
+
 	// Generate new xid for the person
 	personID := xid.New().String()
 
+	reportsTo := reportsToParam(req.ReportsTo)
+	if reportsTo.Valid {
+		msg, err := h.checkReportsTo(ctx, managerID, personID, reportsTo.String)
+		if err != nil {
+			zap.L().Error("error checking reporting line", zap.Error(err))
+			return &api.CreatePersonInternalServerError{
+				Message: "Failed to create person",
+				Code:    "INTERNAL_ERROR",
+			}, nil
+		}
+		if msg != "" {
+			return &api.CreatePersonBadRequest{
+				Message: msg,
+				Code:    "INVALID_PERSON",
+			}, nil
+		}
+	}
+
 	// Create person in database
 	person, err := h.queries.CreatePerson(ctx, db.CreatePersonParams{
-		ID:   personID,
-		Name: req.Name,
+		ID:          personID,
+		Name:        req.Name,
+		ManagerID:   managerID,
+		ReportsTo:   reportsTo,
+		CadenceDays: cadenceParam(req.CadenceDays),
 	})
 	if err != nil {
 		zap.L().Error("error creating person", zap.Error(err))
@@ -53,16 +80,22 @@ func (h *PersonHandler) CreatePerson(ctx context.Context, req *api.CreatePersonR
 	}
 
 	// Convert to API response
-	return &api.Person{
+	apiPerson := &api.Person{
 		ID:        person.ID,
 		Name:      person.Name,
+		ReportsTo: optReportsTo(person.ReportsTo),
 		CreatedAt: person.CreatedAt,
 		UpdatedAt: person.UpdatedAt,
-	}, nil
+	}
+	setCadence(apiPerson, person.CadenceDays, person.NextDueAt)
+	return apiPerson, nil
 }
 
 func (h *PersonHandler) GetPersonById(ctx context.Context, params api.GetPersonByIdParams) (api.GetPersonByIdRes, error) {
-	person, err := h.queries.GetPersonByID(ctx, params.ID)
+	person, err := h.queries.GetPersonByID(ctx, db.GetPersonByIDParams{
+		ID:        params.ID,
+		ManagerID: auth.ManagerID(ctx),
+	})
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return &api.GetPersonByIdNotFound{
@@ -77,12 +110,15 @@ func (h *PersonHandler) GetPersonById(ctx context.Context, params api.GetPersonB
 		}, nil
 	}
 
-	return &api.Person{
+	apiPerson := &api.Person{
 		ID:        person.ID,
 		Name:      person.Name,
+		ReportsTo: optReportsTo(person.ReportsTo),
 		CreatedAt: person.CreatedAt,
 		UpdatedAt: person.UpdatedAt,
-	}, nil
+	}
+	setCadence(apiPerson, person.CadenceDays, person.NextDueAt)
+	return apiPerson, nil
 }
 
 func (h *PersonHandler) GetPersons(ctx context.Context, params api.GetPersonsParams) (api.GetPersonsRes, error) {
@@ -96,8 +132,14 @@ func (h *PersonHandler) GetPersons(ctx context.Context, params api.GetPersonsPar
 		offset = int32(params.Offset.Value)
 	}
 
+	managerID := auth.ManagerID(ctx)
+	overdue := sql.NullBool{Bool: params.Overdue.Value, Valid: params.Overdue.IsSet()}
+
 	// Get total count
-	total, err := h.queries.CountPersons(ctx)
+	total, err := h.queries.CountPersons(ctx, db.CountPersonsParams{
+		ManagerID: managerID,
+		Overdue:   overdue,
+	})
 	if err != nil {
 		zap.L().Error("error counting persons", zap.Error(err))
 		return &api.Error{
@@ -108,8 +150,10 @@ func (h *PersonHandler) GetPersons(ctx context.Context, params api.GetPersonsPar
 
 	// Get persons
 	persons, err := h.queries.ListPersons(ctx, db.ListPersonsParams{
-		Offset: offset,
-		Limit:  limit,
+		ManagerID: managerID,
+		Overdue:   overdue,
+		Offset:    offset,
+		Limit:     limit,
 	})
 	if err != nil {
 		zap.L().Error("error listing persons", zap.Error(err))
@@ -125,9 +169,11 @@ func (h *PersonHandler) GetPersons(ctx context.Context, params api.GetPersonsPar
 		apiPersons[i] = api.Person{
 			ID:        person.ID,
 			Name:      person.Name,
+			ReportsTo: optReportsTo(person.ReportsTo),
 			CreatedAt: person.CreatedAt,
 			UpdatedAt: person.UpdatedAt,
 		}
+		setCadence(&apiPersons[i], person.CadenceDays, person.NextDueAt)
 	}
 
 	return &api.GetPersonsOKApplicationJSON{
@@ -147,9 +193,22 @@ func (h *PersonHandler) GetPersonsWithLastActivity(ctx context.Context, params a
 		offset = int32(params.Offset.Value)
 	}
 
+	overdue := sql.NullBool{Bool: params.Overdue.Value, Valid: params.Overdue.IsSet()}
+	return h.listPersonsWithLastActivity(ctx, sql.NullString{}, overdue, limit, offset)
+}
+
+// GetDirectReports lists the people who report to personID, with each one's last activity
+func (h *PersonHandler) GetDirectReports(ctx context.Context, personID string) ([]templates.PersonWithLastActivity, error) {
+	return h.listPersonsWithLastActivity(ctx, sql.NullString{String: personID, Valid: true}, sql.NullBool{}, 100, 0)
+}
+
+func (h *PersonHandler) listPersonsWithLastActivity(ctx context.Context, reportsTo sql.NullString, overdue sql.NullBool, limit, offset int32) ([]templates.PersonWithLastActivity, error) {
 	persons, err := h.queries.ListPersonsWithLastActivity(ctx, db.ListPersonsWithLastActivityParams{
-		Limit:  limit,
-		Offset: offset,
+		ManagerID: auth.ManagerID(ctx),
+		ReportsTo: reportsTo,
+		Overdue:   overdue,
+		Limit:     limit,
+		Offset:    offset,
 	})
 	if err != nil {
 		zap.L().Error("error listing persons with last activity", zap.Error(err))
@@ -178,6 +237,11 @@ func (h *PersonHandler) GetPersonsWithLastActivity(ctx context.Context, params a
 			t := person.LastConversationAt
 			tmpl.LastConversationAt = &t
 		}
+		if person.CadenceDays.Valid && person.NextDueAt.Valid {
+			t := person.NextDueAt.Time
+			tmpl.CadenceDays = int(person.CadenceDays.Int32)
+			tmpl.NextDueAt = &t
+		}
 		templatePersons[i] = tmpl
 	}
 
@@ -185,8 +249,13 @@ func (h *PersonHandler) GetPersonsWithLastActivity(ctx context.Context, params a
 }
 
 func (h *PersonHandler) GetPersonTimeline(ctx context.Context, params api.GetPersonTimelineParams) (api.GetPersonTimelineRes, error) {
+	managerID := auth.ManagerID(ctx)
+
 	// Verify person exists
-	if _, err := h.queries.GetPersonByID(ctx, params.ID); err != nil {
+	if _, err := h.queries.GetPersonByID(ctx, db.GetPersonByIDParams{
+		ID:        params.ID,
+		ManagerID: managerID,
+	}); err != nil {
 		if err == sql.ErrNoRows {
 			return &api.GetPersonTimelineNotFound{
 				Message: "Person not found",
@@ -205,114 +274,321 @@ func (h *PersonHandler) GetPersonTimeline(ctx context.Context, params api.GetPer
 		limit = int32(params.Limit.Value)
 	}
 
-	offset := int32(0)
-	if params.Offset.IsSet() {
-		offset = int32(params.Offset.Value)
+	offset := int32(params.Offset.Or(0))
+
+	// A cursor takes precedence over the offset
+	var cursorAt sql.NullTime
+	var cursorID sql.NullString
+	if c, ok := params.Cursor.Get(); ok {
+		at, id, err := cursor.Decode(c)
+		if err != nil {
+			return &api.GetPersonTimelineBadRequest{
+				Message: "Invalid cursor",
+				Code:    "VALIDATION_ERROR",
+			}, nil
+		}
+		cursorAt = sql.NullTime{Time: at, Valid: true}
+		cursorID = sql.NullString{String: id, Valid: true}
+		offset = 0
 	}
 
-	fetchLimit := limit + offset
-
-	actions, err := h.queries.ListActionsByPersonID(ctx, db.ListActionsByPersonIDParams{
-		PersonID: params.ID,
-		Offset:   0,
-		Limit:    fetchLimit,
+	filter := timelineFilter(managerID, params)
+
+	// One row past the page tells us whether the timeline continues
+	rows, err := h.queries.ListTimelineByPersonID(ctx, db.ListTimelineByPersonIDParams{
+		PersonID:  filter.PersonID,
+		ManagerID: filter.ManagerID,
+		Kind:      filter.Kind,
+		Valence:   filter.Valence,
+		ThemeID:   filter.ThemeID,
+		Since:     filter.Since,
+		Before:    filter.Before,
+		CursorAt:  cursorAt,
+		CursorID:  cursorID,
+		Limit:     limit + 1,
+		Offset:    offset,
 	})
 	if err != nil {
-		zap.L().Error("error listing actions for timeline", zap.Error(err))
+		zap.L().Error("error listing timeline", zap.Error(err))
 		return &api.GetPersonTimelineInternalServerError{
-			Message: "Failed to get actions",
+			Message: "Failed to get timeline",
 			Code:    "INTERNAL_ERROR",
 		}, nil
 	}
 
-	conversations, err := h.queries.ListConversationsByPersonID(ctx, db.ListConversationsByPersonIDParams{
-		PersonID: params.ID,
-		Offset:   0,
-		Limit:    fetchLimit,
-	})
+	var next string
+	if len(rows) > int(limit) {
+		rows = rows[:limit]
+		last := rows[len(rows)-1]
+		next = cursor.Encode(last.OccurredAt, last.ID)
+	}
+
+	items, err := h.timelineItems(ctx, managerID, params.ID, rows)
 	if err != nil {
-		zap.L().Error("error listing conversations for timeline", zap.Error(err))
+		zap.L().Error("error loading timeline details", zap.Error(err))
 		return &api.GetPersonTimelineInternalServerError{
-			Message: "Failed to get conversations",
+			Message: "Failed to get timeline",
 			Code:    "INTERNAL_ERROR",
 		}, nil
 	}
 
-	var items []api.TimelineItem
-	for _, a := range actions {
-		act := a.Action
-		item := api.TimelineItem{
-			Type:        api.TimelineItemTypeAction,
-			ID:          act.ID.String(),
-			PersonID:    act.PersonID.String(),
-			OccurredAt:  act.OccurredAt,
-			Description: act.Description,
-			CreatedAt:   act.CreatedAt,
-			UpdatedAt:   act.UpdatedAt,
-		}
-		if act.References.Valid {
-			item.References = api.OptNilString{Value: act.References.String, Set: true}
-		}
-		item.Valence = api.OptNilTimelineItemValence{Value: api.TimelineItemValence(act.Valence), Set: true}
-		if themeRows, err := h.queries.ListThemesByActionID(ctx, db.ListThemesByActionIDParams{
-			ActionID: act.ID.String(),
-			Offset:   0,
-			Limit:    100,
-		}); err == nil {
-			item.Themes = make([]api.Theme, len(themeRows))
-			for i, row := range themeRows {
-				item.Themes[i] = api.Theme{ID: row.Theme.ID.String(), Text: row.Theme.Text}
+	total, err := h.queries.CountTimelineByPersonID(ctx, filter)
+	if err != nil {
+		zap.L().Error("error counting timeline", zap.Error(err))
+		return &api.GetPersonTimelineInternalServerError{
+			Message: "Failed to get timeline",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	res := &api.GetPersonTimelineOKApplicationJSON{
+		Items: items,
+		Total: int(total),
+	}
+	if next != "" {
+		res.NextCursor = api.NewOptString(next)
+	}
+
+	if groupBy, ok := params.GroupBy.Get(); ok {
+		unit := period.Unit(groupBy)
+		groupRows, err := h.queries.ListTimelineGroups(ctx, db.ListTimelineGroupsParams{
+			PersonID:  filter.PersonID,
+			ManagerID: filter.ManagerID,
+			Kind:      filter.Kind,
+			Valence:   filter.Valence,
+			ThemeID:   filter.ThemeID,
+			Since:     filter.Since,
+			Before:    filter.Before,
+			Period:    string(unit),
+		})
+		if err != nil {
+			zap.L().Error("error grouping timeline", zap.Error(err))
+			return &api.GetPersonTimelineInternalServerError{
+				Message: "Failed to get timeline",
+				Code:    "INTERNAL_ERROR",
+			}, nil
+		}
+		res.Groups = make([]api.TimelineGroup, len(groupRows))
+		for i, row := range groupRows {
+			res.Groups[i] = api.TimelineGroup{
+				PeriodStart: row.PeriodStart,
+				Label:       period.Label(unit, row.PeriodStart),
+				Total:       int(row.Total),
+				Positive:    int(row.Positive),
+				Negative:    int(row.Negative),
 			}
 		}
-		items = append(items, item)
+	}
+	return res, nil
+}
+
+// timelineFilter gathers the timeline query parameters shared by the page,
+// count and group queries
+func timelineFilter(managerID string, params api.GetPersonTimelineParams) db.CountTimelineByPersonIDParams {
+	filter := db.CountTimelineByPersonIDParams{
+		PersonID:  params.ID,
+		ManagerID: managerID,
+	}
+	if t, ok := params.Type.Get(); ok {
+		filter.Kind = sql.NullString{String: string(t), Valid: true}
+	}
+	if v, ok := params.Valence.Get(); ok {
+		filter.Valence = sql.NullString{String: string(v), Valid: true}
+	}
+	if id, ok := params.ThemeID.Get(); ok {
+		filter.ThemeID = sql.NullString{String: id, Valid: true}
+	}
+	if from, ok := params.From.Get(); ok {
+		filter.Since = sql.NullTime{Time: from, Valid: true}
+	}
+	// "to" is inclusive of the whole day
+	if to, ok := params.To.Get(); ok {
+		filter.Before = sql.NullTime{Time: to.AddDate(0, 0, 1), Valid: true}
+	}
+	return filter
+}
+
+// timelineItems builds the API entries for a page of timeline rows, fetching
+// the references of its actions and the actions linked to its conversations in
+// one query each.
+func (h *PersonHandler) timelineItems(ctx context.Context, managerID, personID string, rows []db.ListTimelineByPersonIDRow) ([]api.TimelineItem, error) {
+	var actionIDs, conversationIDs []string
+	for _, row := range rows {
+		if row.Kind == string(api.TimelineItemTypeAction) {
+			actionIDs = append(actionIDs, row.ID)
+		} else {
+			conversationIDs = append(conversationIDs, row.ID)
+		}
+	}
+
+	refs, err := listReferences(ctx, h.queries, managerID, actionIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	linked := map[string][]api.Action{}
+	if len(conversationIDs) > 0 {
+		linkRows, err := h.queries.ListActionsByConversationIDs(ctx, db.ListActionsByConversationIDsParams{
+			ConversationIds: conversationIDs,
+			ManagerID:       managerID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range linkRows {
+			linked[row.ConversationID] = append(linked[row.ConversationID], convertToAPIAction(row.Action))
+		}
 	}
 
-	for _, c := range conversations {
-		convID, _ := xid.FromBytes(c.Conversation.ID)
+	items := make([]api.TimelineItem, len(rows))
+	for i, row := range rows {
 		item := api.TimelineItem{
-			Type:        api.TimelineItemTypeConversation,
-			ID:          convID.String(),
-			PersonID:    params.ID,
-			OccurredAt:  c.Conversation.OccurredAt,
-			Description: c.Conversation.Description,
-			CreatedAt:   c.Conversation.CreatedAt,
-			UpdatedAt:   c.Conversation.UpdatedAt,
-		}
-		if themeRows, err := h.queries.ListThemesByConversationID(ctx, db.ListThemesByConversationIDParams{
-			ConversationID: convID.String(),
-			Offset:         0,
-			Limit:          100,
-		}); err == nil {
-			item.Themes = make([]api.Theme, len(themeRows))
-			for i, row := range themeRows {
-				item.Themes[i] = api.Theme{ID: row.Theme.ID.String(), Text: row.Theme.Text}
-			}
+			Type:        api.TimelineItemType(row.Kind),
+			ID:          row.ID,
+			PersonID:    personID,
+			OccurredAt:  row.OccurredAt,
+			Description: row.Description,
+			Themes:      make([]api.Theme, len(row.ThemeIds)),
+			CreatedAt:   row.CreatedAt,
+			UpdatedAt:   row.UpdatedAt,
+		}
+		for j, id := range row.ThemeIds {
+			item.Themes[j] = api.Theme{ID: id, Text: row.ThemeTexts[j]}
+		}
+		if item.Type == api.TimelineItemTypeAction {
+			item.Valence = api.NewOptNilTimelineItemValence(api.TimelineItemValence(row.Valence))
+			item.References = refs[row.ID]
+		} else {
+			item.Actions = linked[row.ID]
 		}
-		items = append(items, item)
+		items[i] = item
+	}
+	return items, nil
+}
+
+// GetPersonAgenda drafts a 1:1 agenda from the actions recorded since the last
+// conversation that have not been linked to one, grouped by theme and valence.
+// An action with several themes appears under each of them.
+func (h *PersonHandler) GetPersonAgenda(ctx context.Context, params api.GetPersonAgendaParams) (api.GetPersonAgendaRes, error) {
+	managerID := auth.ManagerID(ctx)
+
+	if _, err := h.queries.GetPersonByID(ctx, db.GetPersonByIDParams{
+		ID:        params.ID,
+		ManagerID: managerID,
+	}); err != nil {
+		if err == sql.ErrNoRows {
+			return &api.GetPersonAgendaNotFound{
+				Message: "Person not found",
+				Code:    "NOT_FOUND",
+			}, nil
+		}
+		zap.L().Error("error getting person", zap.Error(err))
+		return &api.GetPersonAgendaInternalServerError{
+			Message: "Failed to get agenda",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	agenda := &api.Agenda{
+		PersonID: params.ID,
+		Since:    api.OptNilDateTime{Set: true, Null: true},
+		Groups:   []api.AgendaGroup{},
 	}
 
-	sort.Slice(items, func(i, j int) bool {
-		return items[i].OccurredAt.After(items[j].OccurredAt)
+	latest, err := h.queries.ListConversationsByPersonID(ctx, db.ListConversationsByPersonIDParams{
+		PersonID:  params.ID,
+		ManagerID: managerID,
+		Offset:    0,
+		Limit:     1,
 	})
+	if err != nil {
+		zap.L().Error("error getting latest conversation", zap.Error(err))
+		return &api.GetPersonAgendaInternalServerError{
+			Message: "Failed to get agenda",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+	if len(latest) > 0 {
+		agenda.Since = api.NewOptNilDateTime(latest[0].Conversation.OccurredAt)
+	}
 
-	totalActions, _ := h.queries.CountActionsByPersonID(ctx, params.ID)
-	totalConversations, _ := h.queries.CountConversationsByPersonID(ctx, params.ID)
-	total := int(totalActions + totalConversations)
+	rows, err := h.queries.ListAgendaActions(ctx, db.ListAgendaActionsParams{
+		PersonID:  params.ID,
+		ManagerID: managerID,
+	})
+	if err != nil {
+		zap.L().Error("error listing agenda actions", zap.Error(err))
+		return &api.GetPersonAgendaInternalServerError{
+			Message: "Failed to get agenda",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
 
-	start := int(offset)
-	if start > len(items) {
-		start = len(items)
+	type groupKey struct {
+		themeID string
+		valence api.AgendaGroupValence
 	}
-	end := start + int(limit)
-	if end > len(items) {
-		end = len(items)
+	groups := map[groupKey]*api.AgendaGroup{}
+	var order []groupKey
+	addTo := func(key groupKey, theme api.OptTheme, action api.Action) {
+		g, ok := groups[key]
+		if !ok {
+			g = &api.AgendaGroup{Theme: theme, Valence: key.valence}
+			groups[key] = g
+			order = append(order, key)
+		}
+		g.Actions = append(g.Actions, action)
 	}
-	page := items[start:end]
 
-	return &api.GetPersonTimelineOKApplicationJSON{
-		Items: page,
-		Total: total,
-	}, nil
+	actionIDs := make([]string, len(rows))
+	for i, row := range rows {
+		actionIDs[i] = row.Action.ID.String()
+	}
+	refs, err := listReferences(ctx, h.queries, managerID, actionIDs)
+	if err != nil {
+		zap.L().Error("error listing agenda references", zap.Error(err))
+		return &api.GetPersonAgendaInternalServerError{
+			Message: "Failed to get agenda",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	for _, row := range rows {
+		action := convertToAPIAction(row.Action)
+		action.References = refs[action.ID]
+		valence := api.AgendaGroupValence(row.Action.Valence)
+
+		if len(row.ThemeIds) == 0 {
+			addTo(groupKey{valence: valence}, api.OptTheme{}, action)
+			continue
+		}
+		for j, id := range row.ThemeIds {
+			theme := api.Theme{ID: id, Text: row.ThemeTexts[j]}
+			addTo(groupKey{themeID: theme.ID, valence: valence}, api.NewOptTheme(theme), action)
+		}
+	}
+
+	// Themed groups alphabetically, then unthemed; positive, negative, then neutral within a theme
+	valenceOrder := map[api.AgendaGroupValence]int{
+		api.AgendaGroupValencePositive: 0,
+		api.AgendaGroupValenceNegative: 1,
+		api.AgendaGroupValenceNeutral:  2,
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := groups[order[i]], groups[order[j]]
+		if a.Theme.Set != b.Theme.Set {
+			return a.Theme.Set
+		}
+		if a.Theme.Value.Text != b.Theme.Value.Text {
+			return a.Theme.Value.Text < b.Theme.Value.Text
+		}
+		return valenceOrder[a.Valence] < valenceOrder[b.Valence]
+	})
+	for _, key := range order {
+		agenda.Groups = append(agenda.Groups, *groups[key])
+	}
+
+	return agenda, nil
 }
 
 func (h *PersonHandler) UpdatePerson(ctx context.Context, req *api.UpdatePersonRequest, params api.UpdatePersonParams) (api.UpdatePersonRes, error) {
@@ -324,9 +600,32 @@ func (h *PersonHandler) UpdatePerson(ctx context.Context, req *api.UpdatePersonR
 		}, nil
 	}
 
+	managerID := auth.ManagerID(ctx)
+
+	reportsTo := reportsToParam(req.ReportsTo)
+	if reportsTo.Valid {
+		msg, err := h.checkReportsTo(ctx, managerID, params.ID, reportsTo.String)
+		if err != nil {
+			zap.L().Error("error checking reporting line", zap.Error(err))
+			return &api.UpdatePersonInternalServerError{
+				Message: "Failed to update person",
+				Code:    "INTERNAL_ERROR",
+			}, nil
+		}
+		if msg != "" {
+			return &api.UpdatePersonBadRequest{
+				Message: msg,
+				Code:    "INVALID_PERSON",
+			}, nil
+		}
+	}
+
 	person, err := h.queries.UpdatePerson(ctx, db.UpdatePersonParams{
-		ID:   params.ID,
-		Name: req.Name,
+		ID:          params.ID,
+		Name:        req.Name,
+		ReportsTo:   reportsTo,
+		CadenceDays: cadenceParam(req.CadenceDays),
+		ManagerID:   managerID,
 	})
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -342,16 +641,24 @@ func (h *PersonHandler) UpdatePerson(ctx context.Context, req *api.UpdatePersonR
 		}, nil
 	}
 
-	return &api.Person{
+	apiPerson := &api.Person{
 		ID:        person.ID,
 		Name:      person.Name,
+		ReportsTo: optReportsTo(person.ReportsTo),
 		CreatedAt: person.CreatedAt,
 		UpdatedAt: person.UpdatedAt,
-	}, nil
+	}
+	setCadence(apiPerson, person.CadenceDays, person.NextDueAt)
+	return apiPerson, nil
 }
 
+// DeletePerson moves the person to the trash along with their actions,
+// conversations and themes
 func (h *PersonHandler) DeletePerson(ctx context.Context, params api.DeletePersonParams) (api.DeletePersonRes, error) {
-	err := h.queries.DeletePerson(ctx, params.ID)
+	trashed, err := h.queries.TrashPerson(ctx, db.TrashPersonParams{
+		ID:        params.ID,
+		ManagerID: auth.ManagerID(ctx),
+	})
 	if err != nil {
 		zap.L().Error("error deleting person", zap.Error(err))
 		return &api.DeletePersonInternalServerError{
@@ -359,10 +666,127 @@ func (h *PersonHandler) DeletePerson(ctx context.Context, params api.DeletePerso
 			Code:    "INTERNAL_ERROR",
 		}, nil
 	}
+	if trashed == 0 {
+		return &api.DeletePersonNotFound{
+			Message: "Person not found",
+			Code:    "NOT_FOUND",
+		}, nil
+	}
 
 	return &api.DeletePersonNoContent{}, nil
 }
 
+// GetOrgTree arranges every person under the person they report to
+func (h *PersonHandler) GetOrgTree(ctx context.Context) (api.GetOrgTreeRes, error) {
+	rows, err := h.queries.ListOrgChart(ctx, auth.ManagerID(ctx))
+	if err != nil {
+		zap.L().Error("error listing org chart", zap.Error(err))
+		return &api.Error{
+			Message: "Failed to get org tree",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	known := make(map[string]bool, len(rows))
+	for _, row := range rows {
+		known[row.ID] = true
+	}
+
+	// Rows are sorted by name, so each person's reports come out in name order
+	children := map[string][]db.ListOrgChartRow{}
+	var roots []db.ListOrgChartRow
+	for _, row := range rows {
+		if row.ReportsTo == "" || !known[row.ReportsTo] {
+			roots = append(roots, row)
+			continue
+		}
+		children[row.ReportsTo] = append(children[row.ReportsTo], row)
+	}
+
+	var build func(row db.ListOrgChartRow) api.OrgNode
+	build = func(row db.ListOrgChartRow) api.OrgNode {
+		node := api.OrgNode{ID: row.ID, Name: row.Name, Reports: []api.OrgNode{}}
+		for _, child := range children[row.ID] {
+			node.Reports = append(node.Reports, build(child))
+		}
+		return node
+	}
+
+	tree := make([]api.OrgNode, len(roots))
+	for i, root := range roots {
+		tree[i] = build(root)
+	}
+
+	return &api.GetOrgTreeOKApplicationJSON{Roots: tree}, nil
+}
+
+// checkReportsTo verifies that reportsTo is one of the manager's people and that
+// making personID report to them would not create a loop in the reporting line.
+// It returns a message describing the problem, or "" if the change is allowed.
+func (h *PersonHandler) checkReportsTo(ctx context.Context, managerID, personID, reportsTo string) (string, error) {
+	if reportsTo == personID {
+		return "A person can't report to themselves", nil
+	}
+
+	rows, err := h.queries.ListOrgChart(ctx, managerID)
+	if err != nil {
+		return "", err
+	}
+
+	parent := make(map[string]string, len(rows))
+	for _, row := range rows {
+		parent[row.ID] = row.ReportsTo
+	}
+	if _, ok := parent[reportsTo]; !ok {
+		return "Reports-to person not found", nil
+	}
+
+	seen := map[string]bool{}
+	for id := reportsTo; id != "" && !seen[id]; id = parent[id] {
+		if id == personID {
+			return "A person can't report to someone in their own reporting line", nil
+		}
+		seen[id] = true
+	}
+	return "", nil
+}
+
+// reportsToParam converts the optional reports_to field to a query argument
+func reportsToParam(reportsTo api.OptNilString) sql.NullString {
+	if !reportsTo.IsSet() || reportsTo.IsNull() || reportsTo.Value == "" {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: reportsTo.Value, Valid: true}
+}
+
+// optReportsTo converts a reports_to column, empty for top-level people, to the API field
+func optReportsTo(reportsTo string) api.OptNilString {
+	if reportsTo == "" {
+		return api.OptNilString{Set: true, Null: true}
+	}
+	return api.NewOptNilString(reportsTo)
+}
+
+// cadenceParam converts the optional cadence from a request to a query parameter
+func cadenceParam(cadence api.OptNilInt) sql.NullInt32 {
+	if !cadence.IsSet() || cadence.IsNull() {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: int32(cadence.Value), Valid: true}
+}
+
+// setCadence fills in a person's cadence, next due date and overdue flag
+func setCadence(person *api.Person, cadence sql.NullInt32, nextDueAt sql.NullTime) {
+	if !cadence.Valid || !nextDueAt.Valid {
+		person.CadenceDays = api.OptNilInt{Set: true, Null: true}
+		person.NextDueAt = api.OptNilDateTime{Set: true, Null: true}
+		return
+	}
+	person.CadenceDays = api.NewOptNilInt(int(cadence.Int32))
+	person.NextDueAt = api.NewOptNilDateTime(nextDueAt.Time)
+	person.Overdue = nextDueAt.Time.Before(time.Now())
+}
+
 func (h *PersonHandler) HandleGetPersonsForSelect(w http.ResponseWriter, r *http.Request) {
 	// Call the API handler internally to get persons for the select dropdown
 	params := api.GetPersonsParams{
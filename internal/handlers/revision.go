@@ -0,0 +1,515 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/rs/xid"
+	"go.uber.org/zap"
+
+	"pepo/internal/api"
+	"pepo/internal/auth"
+	"pepo/internal/db"
+	"pepo/internal/references"
+	"pepo/internal/revisions"
+)
+
+// recordActionRevision snapshots the action's saved content into its history
+func recordActionRevision(ctx context.Context, q *db.Queries, managerID, actionID string) error {
+	return q.RecordActionRevision(ctx, db.RecordActionRevisionParams{
+		ID:        xid.New().String(),
+		ActionID:  actionID,
+		ManagerID: managerID,
+	})
+}
+
+// recordConversationRevision snapshots the conversation's saved content into its history
+func recordConversationRevision(ctx context.Context, q *db.Queries, managerID, conversationID string) error {
+	return q.RecordConversationRevision(ctx, db.RecordConversationRevisionParams{
+		ID:             xid.New().String(),
+		ConversationID: conversationID,
+		ManagerID:      managerID,
+	})
+}
+
+// GetActionRevisions lists the action's history newest first, each revision
+// carrying what changed since the one before it
+func (h *ActionHandler) GetActionRevisions(ctx context.Context, params api.GetActionRevisionsParams) (api.GetActionRevisionsRes, error) {
+	managerID := auth.ManagerID(ctx)
+
+	if _, err := h.queries.GetActionByID(ctx, db.GetActionByIDParams{
+		ID:        params.ID,
+		ManagerID: managerID,
+	}); err != nil {
+		if err == sql.ErrNoRows {
+			return &api.GetActionRevisionsNotFound{
+				Message: "Action not found",
+				Code:    "NOT_FOUND",
+			}, nil
+		}
+		zap.L().Error("error getting action", zap.Error(err))
+		return &api.GetActionRevisionsInternalServerError{
+			Message: "Failed to get revisions",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	rows, err := h.queries.ListActionRevisions(ctx, db.ListActionRevisionsParams{
+		ActionID:  params.ID,
+		ManagerID: managerID,
+	})
+	if err != nil {
+		zap.L().Error("error listing action revisions", zap.Error(err))
+		return &api.GetActionRevisionsInternalServerError{
+			Message: "Failed to get revisions",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	result := make([]api.ActionRevision, len(rows))
+	var prevRefs []references.Entry
+	for i, row := range rows {
+		refs, err := revisionReferences(row.References)
+		if err != nil {
+			zap.L().Error("error decoding revision references", zap.String("revision", row.ID), zap.Error(err))
+			return &api.GetActionRevisionsInternalServerError{
+				Message: "Failed to get revisions",
+				Code:    "INTERNAL_ERROR",
+			}, nil
+		}
+
+		rev := api.ActionRevision{
+			ID:          row.ID,
+			Number:      i + 1,
+			Description: row.Description,
+			Valence:     api.ActionRevisionValence(row.Valence),
+			OccurredAt:  row.OccurredAt,
+			References:  make([]api.Reference, len(refs)),
+			Themes:      revisionThemes(row.ThemeIds, row.ThemeTexts),
+			Changes:     []api.RevisionChange{},
+			CreatedAt:   row.CreatedAt,
+		}
+		if row.Impact.Valid {
+			rev.Impact = api.NewOptNilActionRevisionImpact(api.ActionRevisionImpact(row.Impact.ImpactLevel))
+		}
+		for j, e := range refs {
+			rev.References[j] = apiReference(e)
+		}
+
+		if i > 0 {
+			prev := rows[i-1]
+			var d revisions.Diff
+			d.Value("description", prev.Description, row.Description)
+			d.Value("valence", string(prev.Valence), string(row.Valence))
+			d.Value("impact", impactValue(prev.Impact), impactValue(row.Impact))
+			d.Value("occurred_at", timeValue(prev.OccurredAt), timeValue(row.OccurredAt))
+			d.List("references", referenceItems(prevRefs), referenceItems(refs))
+			d.List("themes", themeItems(prev.ThemeIds, prev.ThemeTexts), themeItems(row.ThemeIds, row.ThemeTexts))
+			rev.Changes = apiChanges(d.Changes)
+		}
+
+		prevRefs = refs
+		// Newest first
+		result[len(rows)-1-i] = rev
+	}
+
+	return &api.GetActionRevisionsOKApplicationJSON{Revisions: result}, nil
+}
+
+// RestoreActionRevision puts an earlier revision's content back on the action.
+// Conversation links are not part of a revision and are left alone.
+func (h *ActionHandler) RestoreActionRevision(ctx context.Context, params api.RestoreActionRevisionParams) (api.RestoreActionRevisionRes, error) {
+	managerID := auth.ManagerID(ctx)
+
+	current, err := h.queries.GetActionByID(ctx, db.GetActionByIDParams{
+		ID:        params.ID,
+		ManagerID: managerID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &api.RestoreActionRevisionNotFound{
+				Message: "Action not found",
+				Code:    "NOT_FOUND",
+			}, nil
+		}
+		zap.L().Error("error getting action", zap.Error(err))
+		return &api.RestoreActionRevisionInternalServerError{
+			Message: "Failed to restore revision",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	rev, err := h.queries.GetActionRevision(ctx, db.GetActionRevisionParams{
+		ID:        params.RevisionID,
+		ActionID:  params.ID,
+		ManagerID: managerID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &api.RestoreActionRevisionNotFound{
+				Message: "Revision not found",
+				Code:    "NOT_FOUND",
+			}, nil
+		}
+		zap.L().Error("error getting action revision", zap.Error(err))
+		return &api.RestoreActionRevisionInternalServerError{
+			Message: "Failed to restore revision",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	entries, err := revisionReferences(rev.References)
+	if err != nil {
+		zap.L().Error("error decoding revision references", zap.String("revision", rev.ID), zap.Error(err))
+		return &api.RestoreActionRevisionInternalServerError{
+			Message: "Failed to restore revision",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	themeIDs, err := liveThemeIDs(ctx, h.queries, managerID, rev.ThemeIds)
+	if err != nil {
+		zap.L().Error("error checking revision themes", zap.Error(err))
+		return &api.RestoreActionRevisionInternalServerError{
+			Message: "Failed to restore revision",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	tx, err := h.conn.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return &api.RestoreActionRevisionInternalServerError{
+			Message: "Failed to restore revision",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+	defer tx.Rollback()
+	qtx := h.queries.WithTx(tx)
+
+	row, err := qtx.UpdateAction(ctx, db.UpdateActionParams{
+		ID:          params.ID,
+		PersonID:    current.Action.PersonID.String(),
+		OccurredAt:  rev.OccurredAt,
+		Description: rev.Description,
+		Valence:     rev.Valence,
+		Impact:      rev.Impact,
+		ManagerID:   managerID,
+	})
+	if err != nil {
+		zap.L().Error("error restoring action", zap.Error(err))
+		return &api.RestoreActionRevisionInternalServerError{
+			Message: "Failed to restore revision",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	if err := syncActionThemes(ctx, qtx, managerID, params.ID, themeIDs); err != nil {
+		zap.L().Error("error restoring action themes", zap.Error(err))
+		return &api.RestoreActionRevisionInternalServerError{
+			Message: "Failed to restore themes",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	if err := saveReferences(ctx, qtx, params.ID, entries); err != nil {
+		zap.L().Error("error restoring action references", zap.Error(err))
+		return &api.RestoreActionRevisionInternalServerError{
+			Message: "Failed to restore references",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	if err := recordActionRevision(ctx, qtx, managerID, params.ID); err != nil {
+		zap.L().Error("error recording action revision", zap.Error(err))
+		return &api.RestoreActionRevisionInternalServerError{
+			Message: "Failed to save revision",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		zap.L().Error("error committing action restore", zap.Error(err))
+		return &api.RestoreActionRevisionInternalServerError{
+			Message: "Failed to restore revision",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	apiAction := convertToAPIAction(row.Action)
+	h.loadActionLinks(ctx, managerID, &apiAction)
+	return &apiAction, nil
+}
+
+// GetConversationRevisions lists the conversation's history newest first, each
+// revision carrying what changed since the one before it
+func (h *ConversationHandler) GetConversationRevisions(ctx context.Context, params api.GetConversationRevisionsParams) (api.GetConversationRevisionsRes, error) {
+	managerID := auth.ManagerID(ctx)
+
+	if _, err := h.queries.GetConversationByID(ctx, db.GetConversationByIDParams{
+		ID:        params.ID,
+		ManagerID: managerID,
+	}); err != nil {
+		if err == sql.ErrNoRows {
+			return &api.GetConversationRevisionsNotFound{
+				Message: "Conversation not found",
+				Code:    "NOT_FOUND",
+			}, nil
+		}
+		zap.L().Error("error getting conversation", zap.Error(err))
+		return &api.GetConversationRevisionsInternalServerError{
+			Message: "Failed to get revisions",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	rows, err := h.queries.ListConversationRevisions(ctx, db.ListConversationRevisionsParams{
+		ConversationID: params.ID,
+		ManagerID:      managerID,
+	})
+	if err != nil {
+		zap.L().Error("error listing conversation revisions", zap.Error(err))
+		return &api.GetConversationRevisionsInternalServerError{
+			Message: "Failed to get revisions",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	result := make([]api.ConversationRevision, len(rows))
+	for i, row := range rows {
+		rev := api.ConversationRevision{
+			ID:          row.ID,
+			Number:      i + 1,
+			Description: row.Description,
+			OccurredAt:  row.OccurredAt,
+			Themes:      revisionThemes(row.ThemeIds, row.ThemeTexts),
+			Changes:     []api.RevisionChange{},
+			CreatedAt:   row.CreatedAt,
+		}
+
+		if i > 0 {
+			prev := rows[i-1]
+			var d revisions.Diff
+			d.Value("description", prev.Description, row.Description)
+			d.Value("occurred_at", timeValue(prev.OccurredAt), timeValue(row.OccurredAt))
+			d.List("themes", themeItems(prev.ThemeIds, prev.ThemeTexts), themeItems(row.ThemeIds, row.ThemeTexts))
+			rev.Changes = apiChanges(d.Changes)
+		}
+
+		result[len(rows)-1-i] = rev
+	}
+
+	return &api.GetConversationRevisionsOKApplicationJSON{Revisions: result}, nil
+}
+
+// RestoreConversationRevision puts an earlier revision's content back on the
+// conversation. Linked actions are not part of a revision and are left alone.
+func (h *ConversationHandler) RestoreConversationRevision(ctx context.Context, params api.RestoreConversationRevisionParams) (api.RestoreConversationRevisionRes, error) {
+	managerID := auth.ManagerID(ctx)
+
+	current, err := h.queries.GetConversationByID(ctx, db.GetConversationByIDParams{
+		ID:        params.ID,
+		ManagerID: managerID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &api.RestoreConversationRevisionNotFound{
+				Message: "Conversation not found",
+				Code:    "NOT_FOUND",
+			}, nil
+		}
+		zap.L().Error("error getting conversation", zap.Error(err))
+		return &api.RestoreConversationRevisionInternalServerError{
+			Message: "Failed to restore revision",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	rev, err := h.queries.GetConversationRevision(ctx, db.GetConversationRevisionParams{
+		ID:             params.RevisionID,
+		ConversationID: params.ID,
+		ManagerID:      managerID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &api.RestoreConversationRevisionNotFound{
+				Message: "Revision not found",
+				Code:    "NOT_FOUND",
+			}, nil
+		}
+		zap.L().Error("error getting conversation revision", zap.Error(err))
+		return &api.RestoreConversationRevisionInternalServerError{
+			Message: "Failed to restore revision",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	themeIDs, err := liveThemeIDs(ctx, h.queries, managerID, rev.ThemeIds)
+	if err != nil {
+		zap.L().Error("error checking revision themes", zap.Error(err))
+		return &api.RestoreConversationRevisionInternalServerError{
+			Message: "Failed to restore revision",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	tx, err := h.conn.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return &api.RestoreConversationRevisionInternalServerError{
+			Message: "Failed to restore revision",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+	defer tx.Rollback()
+	qtx := h.queries.WithTx(tx)
+
+	personID, _ := xid.FromBytes(current.Conversation.PersonID)
+	row, err := qtx.UpdateConversation(ctx, db.UpdateConversationParams{
+		ID:          params.ID,
+		PersonID:    personID.String(),
+		Description: rev.Description,
+		OccurredAt:  rev.OccurredAt,
+		ManagerID:   managerID,
+	})
+	if err != nil {
+		zap.L().Error("error restoring conversation", zap.Error(err))
+		return &api.RestoreConversationRevisionInternalServerError{
+			Message: "Failed to restore revision",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	if err := syncConversationThemes(ctx, qtx, managerID, params.ID, themeIDs); err != nil {
+		zap.L().Error("error restoring conversation themes", zap.Error(err))
+		return &api.RestoreConversationRevisionInternalServerError{
+			Message: "Failed to restore themes",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	if err := recordConversationRevision(ctx, qtx, managerID, params.ID); err != nil {
+		zap.L().Error("error recording conversation revision", zap.Error(err))
+		return &api.RestoreConversationRevisionInternalServerError{
+			Message: "Failed to save revision",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	if err := tx.Commit(); err != nil {
+		zap.L().Error("error committing conversation restore", zap.Error(err))
+		return &api.RestoreConversationRevisionInternalServerError{
+			Message: "Failed to restore revision",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	apiConv := convertToAPIConversation(row.Conversation)
+	h.loadConversationLinks(ctx, managerID, &apiConv)
+	return &apiConv, nil
+}
+
+// revisionReferences decodes the JSON array of references a revision keeps
+func revisionReferences(raw json.RawMessage) ([]references.Entry, error) {
+	var stored []struct {
+		Kind  string  `json:"kind"`
+		Value string  `json:"value"`
+		Title *string `json:"title"`
+	}
+	if err := json.Unmarshal(raw, &stored); err != nil {
+		return nil, err
+	}
+	entries := make([]references.Entry, len(stored))
+	for i, s := range stored {
+		entries[i] = references.Entry{Kind: references.Kind(s.Kind), Value: s.Value}
+		if s.Title != nil {
+			entries[i].Title = *s.Title
+		}
+	}
+	return entries, nil
+}
+
+// liveThemeIDs drops the themes that have been deleted since a revision was
+// saved, so restoring it never fails over a theme that is gone
+func liveThemeIDs(ctx context.Context, q *db.Queries, managerID string, ids []string) ([]string, error) {
+	live := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if _, err := q.GetThemeByID(ctx, db.GetThemeByIDParams{ID: id, ManagerID: managerID}); err != nil {
+			if err == sql.ErrNoRows {
+				continue
+			}
+			return nil, err
+		}
+		live = append(live, id)
+	}
+	return live, nil
+}
+
+func revisionThemes(ids, texts []string) []api.Theme {
+	themes := make([]api.Theme, len(ids))
+	for i := range ids {
+		themes[i] = api.Theme{ID: ids[i], Text: texts[i]}
+	}
+	return themes
+}
+
+// themeItems keys themes by ID and labels them with the text they had when the
+// revision was saved
+func themeItems(ids, texts []string) []revisions.Item {
+	items := make([]revisions.Item, len(ids))
+	for i := range ids {
+		items[i] = revisions.Item{Key: ids[i], Label: texts[i]}
+	}
+	return items
+}
+
+// referenceItems labels references the way they are typed into the edit form
+func referenceItems(entries []references.Entry) []revisions.Item {
+	items := make([]revisions.Item, len(entries))
+	for i, e := range entries {
+		label := e.Value
+		if e.Title != "" {
+			label = e.Title + " | " + e.Value
+		}
+		items[i] = revisions.Item{Key: string(e.Kind) + "\x00" + label, Label: label}
+	}
+	return items
+}
+
+func impactValue(impact db.NullImpactLevel) string {
+	if !impact.Valid {
+		return ""
+	}
+	return string(impact.ImpactLevel)
+}
+
+func timeValue(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// apiChanges converts diff output, leaving empty values out so an unset impact
+// reads as null
+func apiChanges(changes []revisions.Change) []api.RevisionChange {
+	result := make([]api.RevisionChange, len(changes))
+	for i, c := range changes {
+		change := api.RevisionChange{
+			Field:   api.RevisionChangeField(c.Field),
+			Added:   c.Added,
+			Removed: c.Removed,
+		}
+		if c.From != "" {
+			change.From = api.NewOptNilString(c.From)
+		} else if c.To != "" {
+			change.From.SetToNull()
+		}
+		if c.To != "" {
+			change.To = api.NewOptNilString(c.To)
+		} else if c.From != "" {
+			change.To.SetToNull()
+		}
+		result[i] = change
+	}
+	return result
+}
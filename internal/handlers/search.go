@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"html"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"pepo/internal/api"
+	"pepo/internal/auth"
+	"pepo/internal/db"
+)
+
+type SearchHandler struct {
+	queries *db.Queries
+}
+
+func NewSearchHandler(queries *db.Queries) *SearchHandler {
+	return &SearchHandler{queries: queries}
+}
+
+func (h *SearchHandler) Search(ctx context.Context, params api.SearchParams) (api.SearchRes, error) {
+	query := strings.TrimSpace(params.Q.Or(""))
+	if query == "" {
+		return &api.SearchOKApplicationJSON{Query: query, Results: []api.SearchResult{}}, nil
+	}
+
+	args := db.SearchParams{
+		Query:     query,
+		ManagerID: auth.ManagerID(ctx),
+		Limit:     int32(params.Limit.Or(20)),
+	}
+	if t, ok := params.Type.Get(); ok {
+		args.Kind = sql.NullString{String: string(t), Valid: true}
+	}
+	if id, ok := params.PersonID.Get(); ok {
+		args.PersonID = sql.NullString{String: id, Valid: true}
+	}
+	if from, ok := params.From.Get(); ok {
+		args.Since = sql.NullTime{Time: from, Valid: true}
+	}
+	// "to" names a whole day, so the cut-off is the start of the next one
+	if to, ok := params.To.Get(); ok {
+		args.Before = sql.NullTime{Time: to.AddDate(0, 0, 1), Valid: true}
+	}
+
+	rows, err := h.queries.Search(ctx, args)
+	if err != nil {
+		zap.L().Error("error searching", zap.Error(err))
+		return &api.Error{
+			Message: "Failed to search",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	results := make([]api.SearchResult, len(rows))
+	for i, row := range rows {
+		results[i] = api.SearchResult{
+			Type:       api.SearchResultType(row.Kind),
+			ID:         row.ID,
+			PersonID:   row.PersonID,
+			PersonName: row.PersonName,
+			OccurredAt: row.OccurredAt,
+			Rank:       row.Rank,
+			Snippet:    highlightSnippet(row.Snippet),
+		}
+	}
+
+	return &api.SearchOKApplicationJSON{Query: query, Results: results}, nil
+}
+
+// highlightSnippet escapes a ts_headline excerpt and turns the \x02 and \x03
+// markers the Search query puts around matches into <mark> tags
+func highlightSnippet(snippet string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(snippet, "\x02") {
+		match, rest, found := strings.Cut(part, "\x03")
+		if !found {
+			b.WriteString(html.EscapeString(part))
+			continue
+		}
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(match))
+		b.WriteString("</mark>")
+		b.WriteString(html.EscapeString(rest))
+	}
+	return b.String()
+}
@@ -0,0 +1,584 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/lib/pq"
+	"github.com/rs/xid"
+	"go.uber.org/zap"
+
+	"pepo/internal/api"
+	"pepo/internal/auth"
+	"pepo/internal/db"
+)
+
+type ThemeHandler struct {
+	conn    *sql.DB
+	queries *db.Queries
+}
+
+func NewThemeHandler(conn *sql.DB, queries *db.Queries) *ThemeHandler {
+	return &ThemeHandler{conn: conn, queries: queries}
+}
+
+func convertToAPIThemeDetail(t db.Theme, actionCount, conversationCount int64) api.ThemeDetail {
+	theme := api.ThemeDetail{
+		ID:                t.ID.String(),
+		PersonID:          api.NilString{Null: true},
+		Framework:         t.PersonID.IsNil(),
+		Text:              t.Text,
+		Description:       api.NilString{Null: true},
+		ArchivedAt:        api.NilDateTime{Null: true},
+		ActionCount:       int(actionCount),
+		ConversationCount: int(conversationCount),
+		CreatedAt:         t.CreatedAt,
+		UpdatedAt:         t.UpdatedAt,
+	}
+	if !t.PersonID.IsNil() {
+		theme.PersonID = api.NewNilString(t.PersonID.String())
+	}
+	if t.Description.Valid {
+		theme.Description = api.NewNilString(t.Description.String)
+	}
+	if t.ArchivedAt.Valid {
+		theme.Archived = true
+		theme.ArchivedAt = api.NewNilDateTime(t.ArchivedAt.Time)
+	}
+	return theme
+}
+
+// isUniqueViolation reports whether err came from a unique index, such as two framework
+// themes with the same text
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505"
+}
+
+// listThemes returns one page of themes with their usage counts plus the total matching count
+func (h *ThemeHandler) listThemes(ctx context.Context, personID sql.NullString, framework sql.NullBool, includeArchived bool, limit, offset int32) ([]api.ThemeDetail, int64, error) {
+	managerID := auth.ManagerID(ctx)
+
+	rows, err := h.queries.ListThemesWithCounts(ctx, db.ListThemesWithCountsParams{
+		ManagerID:       managerID,
+		PersonID:        personID,
+		IncludeArchived: includeArchived,
+		Framework:       framework,
+		Offset:          offset,
+		Limit:           limit,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := h.queries.CountThemes(ctx, db.CountThemesParams{
+		ManagerID:       managerID,
+		PersonID:        personID,
+		IncludeArchived: includeArchived,
+		Framework:       framework,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	themes := make([]api.ThemeDetail, len(rows))
+	for i, row := range rows {
+		themes[i] = convertToAPIThemeDetail(row.Theme, row.ActionCount, row.ConversationCount)
+	}
+	return themes, total, nil
+}
+
+// GetThemes lists themes across all of the manager's reports, alphabetically
+func (h *ThemeHandler) GetThemes(ctx context.Context, params api.GetThemesParams) (api.GetThemesRes, error) {
+	limit := int32(10)
+	if params.Limit.IsSet() {
+		limit = int32(params.Limit.Value)
+	}
+
+	offset := int32(0)
+	if params.Offset.IsSet() {
+		offset = int32(params.Offset.Value)
+	}
+
+	personID := sql.NullString{String: params.PersonID.Value, Valid: params.PersonID.IsSet()}
+	framework := sql.NullBool{Bool: params.Framework.Value, Valid: params.Framework.IsSet()}
+
+	themes, total, err := h.listThemes(ctx, personID, framework, params.IncludeArchived.Value, limit, offset)
+	if err != nil {
+		zap.L().Error("error listing themes", zap.Error(err))
+		return &api.Error{
+			Message: "Failed to list themes",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	return &api.GetThemesOK{
+		Themes: themes,
+		Total:  int(total),
+	}, nil
+}
+
+// GetPersonThemes lists one person's themes, answering 404 for people the manager doesn't have
+func (h *ThemeHandler) GetPersonThemes(ctx context.Context, params api.GetPersonThemesParams) (api.GetPersonThemesRes, error) {
+	limit := int32(10)
+	if params.Limit.IsSet() {
+		limit = int32(params.Limit.Value)
+	}
+
+	offset := int32(0)
+	if params.Offset.IsSet() {
+		offset = int32(params.Offset.Value)
+	}
+
+	if _, err := h.queries.GetPersonByID(ctx, db.GetPersonByIDParams{
+		ID:        params.ID,
+		ManagerID: auth.ManagerID(ctx),
+	}); err != nil {
+		if err == sql.ErrNoRows {
+			return &api.GetPersonThemesNotFound{
+				Message: "Person not found",
+				Code:    "NOT_FOUND",
+			}, nil
+		}
+		zap.L().Error("error getting person", zap.Error(err))
+		return &api.GetPersonThemesInternalServerError{
+			Message: "Failed to list themes",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	personID := sql.NullString{String: params.ID, Valid: true}
+
+	themes, total, err := h.listThemes(ctx, personID, sql.NullBool{}, params.IncludeArchived.Value, limit, offset)
+	if err != nil {
+		zap.L().Error("error listing person themes", zap.Error(err))
+		return &api.GetPersonThemesInternalServerError{
+			Message: "Failed to list themes",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	return &api.GetPersonThemesOKApplicationJSON{
+		Themes: themes,
+		Total:  int(total),
+	}, nil
+}
+
+func (h *ThemeHandler) CreateTheme(ctx context.Context, req *api.CreateThemeRequest) (api.CreateThemeRes, error) {
+	text := strings.TrimSpace(req.Text)
+	if text == "" {
+		return &api.CreateThemeBadRequest{
+			Message: "Text is required",
+			Code:    "VALIDATION_ERROR",
+		}, nil
+	}
+
+	managerID := auth.ManagerID(ctx)
+
+	// Without a person the theme joins the framework; with one, the person must
+	// belong to the signed-in manager
+	personID := sql.NullString{String: req.PersonID.Value, Valid: req.PersonID.IsSet()}
+	if personID.Valid {
+		if _, err := h.queries.GetPersonByID(ctx, db.GetPersonByIDParams{
+			ID:        personID.String,
+			ManagerID: managerID,
+		}); err != nil {
+			if err == sql.ErrNoRows {
+				return &api.CreateThemeBadRequest{
+					Message: "Person not found",
+					Code:    "INVALID_PERSON",
+				}, nil
+			}
+			zap.L().Error("error getting person", zap.Error(err))
+			return &api.CreateThemeInternalServerError{
+				Message: "Failed to create theme",
+				Code:    "INTERNAL_ERROR",
+			}, nil
+		}
+	}
+
+	description := sql.NullString{}
+	if d := strings.TrimSpace(req.Description.Value); d != "" {
+		description = sql.NullString{String: d, Valid: true}
+	}
+
+	row, err := h.queries.CreateTheme(ctx, db.CreateThemeParams{
+		ID:          xid.New().String(),
+		PersonID:    personID,
+		Text:        text,
+		Description: description,
+		ManagerID:   managerID,
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return &api.CreateThemeBadRequest{
+				Message: "A framework theme with this text already exists",
+				Code:    "VALIDATION_ERROR",
+			}, nil
+		}
+		zap.L().Error("error creating theme", zap.Error(err))
+		return &api.CreateThemeInternalServerError{
+			Message: "Failed to create theme",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	theme := convertToAPIThemeDetail(row.Theme, 0, 0)
+	return &theme, nil
+}
+
+func (h *ThemeHandler) GetThemeById(ctx context.Context, params api.GetThemeByIdParams) (api.GetThemeByIdRes, error) {
+	row, err := h.queries.GetThemeWithCounts(ctx, db.GetThemeWithCountsParams{
+		ID:        params.ID,
+		ManagerID: auth.ManagerID(ctx),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &api.GetThemeByIdNotFound{
+				Message: "Theme not found",
+				Code:    "NOT_FOUND",
+			}, nil
+		}
+		zap.L().Error("error getting theme", zap.Error(err))
+		return &api.GetThemeByIdInternalServerError{
+			Message: "Failed to get theme",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	theme := convertToAPIThemeDetail(row.Theme, row.ActionCount, row.ConversationCount)
+	return &theme, nil
+}
+
+// UpdateTheme renames a theme and sets its archived state; re-archiving keeps the original time
+func (h *ThemeHandler) UpdateTheme(ctx context.Context, req *api.UpdateThemeRequest, params api.UpdateThemeParams) (api.UpdateThemeRes, error) {
+	text := strings.TrimSpace(req.Text)
+	if text == "" {
+		return &api.UpdateThemeBadRequest{
+			Message: "Text is required",
+			Code:    "VALIDATION_ERROR",
+		}, nil
+	}
+
+	managerID := auth.ManagerID(ctx)
+
+	if _, err := h.queries.UpdateTheme(ctx, db.UpdateThemeParams{
+		ID:        params.ID,
+		Text:      text,
+		Archived:  req.Archived,
+		ManagerID: managerID,
+	}); err != nil {
+		if err == sql.ErrNoRows {
+			return &api.UpdateThemeNotFound{
+				Message: "Theme not found",
+				Code:    "NOT_FOUND",
+			}, nil
+		}
+		if isUniqueViolation(err) {
+			return &api.UpdateThemeBadRequest{
+				Message: "A framework theme with this text already exists",
+				Code:    "VALIDATION_ERROR",
+			}, nil
+		}
+		zap.L().Error("error updating theme", zap.Error(err))
+		return &api.UpdateThemeInternalServerError{
+			Message: "Failed to update theme",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	row, err := h.queries.GetThemeWithCounts(ctx, db.GetThemeWithCountsParams{
+		ID:        params.ID,
+		ManagerID: managerID,
+	})
+	if err != nil {
+		zap.L().Error("error getting theme", zap.Error(err))
+		return &api.UpdateThemeInternalServerError{
+			Message: "Failed to update theme",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	theme := convertToAPIThemeDetail(row.Theme, row.ActionCount, row.ConversationCount)
+	return &theme, nil
+}
+
+// DeleteTheme moves a theme to the trash. Its links to actions and conversations
+// are kept, hidden, so restoring it puts it back everywhere it was used.
+func (h *ThemeHandler) DeleteTheme(ctx context.Context, params api.DeleteThemeParams) (api.DeleteThemeRes, error) {
+	deleted, err := h.queries.TrashTheme(ctx, db.TrashThemeParams{
+		ID:        params.ID,
+		ManagerID: auth.ManagerID(ctx),
+	})
+	if err != nil {
+		zap.L().Error("error deleting theme", zap.Error(err))
+		return &api.DeleteThemeInternalServerError{
+			Message: "Failed to delete theme",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+	if deleted == 0 {
+		return &api.DeleteThemeNotFound{
+			Message: "Theme not found",
+			Code:    "NOT_FOUND",
+		}, nil
+	}
+
+	return &api.DeleteThemeNoContent{}, nil
+}
+
+// MergeThemes folds the source themes into the target. Links the target already has are
+// skipped rather than duplicated. The sources go to the trash with no links left, so
+// restoring one brings back the theme but not what was merged out of it.
+func (h *ThemeHandler) MergeThemes(ctx context.Context, req *api.MergeThemesRequest, params api.MergeThemesParams) (api.MergeThemesRes, error) {
+	managerID := auth.ManagerID(ctx)
+
+	tx, err := h.conn.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return &api.MergeThemesInternalServerError{
+			Message: "Failed to merge themes",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+	defer tx.Rollback()
+	qtx := h.queries.WithTx(tx)
+
+	target, err := qtx.GetThemeByID(ctx, db.GetThemeByIDParams{
+		ID:        params.ID,
+		ManagerID: managerID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &api.MergeThemesNotFound{
+				Message: "Theme not found",
+				Code:    "NOT_FOUND",
+			}, nil
+		}
+		zap.L().Error("error getting theme", zap.Error(err))
+		return &api.MergeThemesInternalServerError{
+			Message: "Failed to merge themes",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	seen := map[string]bool{}
+	for _, sourceID := range req.SourceIds {
+		if seen[sourceID] {
+			continue
+		}
+		seen[sourceID] = true
+
+		if sourceID == params.ID {
+			return &api.MergeThemesBadRequest{
+				Message: "A theme cannot be merged into itself",
+				Code:    "VALIDATION_ERROR",
+			}, nil
+		}
+
+		source, err := qtx.GetThemeByID(ctx, db.GetThemeByIDParams{
+			ID:        sourceID,
+			ManagerID: managerID,
+		})
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return &api.MergeThemesBadRequest{
+					Message: "Theme not found: " + sourceID,
+					Code:    "INVALID_THEME",
+				}, nil
+			}
+			zap.L().Error("error getting theme", zap.Error(err))
+			return &api.MergeThemesInternalServerError{
+				Message: "Failed to merge themes",
+				Code:    "INTERNAL_ERROR",
+			}, nil
+		}
+		// Anything can fold into a framework theme, but a person's theme only takes
+		// that person's own themes
+		if !target.Theme.PersonID.IsNil() && source.Theme.PersonID != target.Theme.PersonID {
+			return &api.MergeThemesBadRequest{
+				Message: "Only themes for the same person can be merged into a person's theme",
+				Code:    "INVALID_THEME",
+			}, nil
+		}
+
+		if err := qtx.MoveActionThemes(ctx, db.MoveActionThemesParams{
+			TargetThemeID: params.ID,
+			ManagerID:     managerID,
+			SourceThemeID: sourceID,
+		}); err != nil {
+			zap.L().Error("error moving action themes", zap.Error(err))
+			return &api.MergeThemesInternalServerError{
+				Message: "Failed to merge themes",
+				Code:    "INTERNAL_ERROR",
+			}, nil
+		}
+		if err := qtx.MoveConversationThemes(ctx, db.MoveConversationThemesParams{
+			TargetThemeID: params.ID,
+			ManagerID:     managerID,
+			SourceThemeID: sourceID,
+		}); err != nil {
+			zap.L().Error("error moving conversation themes", zap.Error(err))
+			return &api.MergeThemesInternalServerError{
+				Message: "Failed to merge themes",
+				Code:    "INTERNAL_ERROR",
+			}, nil
+		}
+		if _, err := qtx.TrashTheme(ctx, db.TrashThemeParams{
+			ID:        sourceID,
+			ManagerID: managerID,
+		}); err != nil {
+			zap.L().Error("error deleting merged theme", zap.Error(err))
+			return &api.MergeThemesInternalServerError{
+				Message: "Failed to merge themes",
+				Code:    "INTERNAL_ERROR",
+			}, nil
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		zap.L().Error("error committing theme merge", zap.Error(err))
+		return &api.MergeThemesInternalServerError{
+			Message: "Failed to merge themes",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	row, err := h.queries.GetThemeWithCounts(ctx, db.GetThemeWithCountsParams{
+		ID:        params.ID,
+		ManagerID: managerID,
+	})
+	if err != nil {
+		zap.L().Error("error getting theme", zap.Error(err))
+		return &api.MergeThemesInternalServerError{
+			Message: "Failed to merge themes",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	theme := convertToAPIThemeDetail(row.Theme, row.ActionCount, row.ConversationCount)
+	return &theme, nil
+}
+
+// SplitTheme creates a sibling theme for the same person and moves the selected actions
+// onto it. Every action must currently carry the theme being split, or nothing changes.
+func (h *ThemeHandler) SplitTheme(ctx context.Context, req *api.SplitThemeRequest, params api.SplitThemeParams) (api.SplitThemeRes, error) {
+	text := strings.TrimSpace(req.Text)
+	if text == "" {
+		return &api.SplitThemeBadRequest{
+			Message: "Text is required",
+			Code:    "VALIDATION_ERROR",
+		}, nil
+	}
+
+	managerID := auth.ManagerID(ctx)
+
+	tx, err := h.conn.BeginTx(ctx, nil)
+	if err != nil {
+		zap.L().Error("error starting transaction", zap.Error(err))
+		return &api.SplitThemeInternalServerError{
+			Message: "Failed to split theme",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+	defer tx.Rollback()
+	qtx := h.queries.WithTx(tx)
+
+	source, err := qtx.GetThemeByID(ctx, db.GetThemeByIDParams{
+		ID:        params.ID,
+		ManagerID: managerID,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return &api.SplitThemeNotFound{
+				Message: "Theme not found",
+				Code:    "NOT_FOUND",
+			}, nil
+		}
+		zap.L().Error("error getting theme", zap.Error(err))
+		return &api.SplitThemeInternalServerError{
+			Message: "Failed to split theme",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	// The new theme lives alongside the source: for the same person, or in the framework
+	personID := sql.NullString{}
+	if !source.Theme.PersonID.IsNil() {
+		personID = sql.NullString{String: source.Theme.PersonID.String(), Valid: true}
+	}
+
+	created, err := qtx.CreateTheme(ctx, db.CreateThemeParams{
+		ID:        xid.New().String(),
+		PersonID:  personID,
+		Text:      text,
+		ManagerID: managerID,
+	})
+	if err != nil {
+		if isUniqueViolation(err) {
+			return &api.SplitThemeBadRequest{
+				Message: "A framework theme with this text already exists",
+				Code:    "VALIDATION_ERROR",
+			}, nil
+		}
+		zap.L().Error("error creating theme", zap.Error(err))
+		return &api.SplitThemeInternalServerError{
+			Message: "Failed to split theme",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+	newID := created.Theme.ID.String()
+
+	seen := map[string]bool{}
+	for _, actionID := range req.ActionIds {
+		if seen[actionID] {
+			continue
+		}
+		seen[actionID] = true
+
+		moved, err := qtx.MoveActionToTheme(ctx, db.MoveActionToThemeParams{
+			TargetThemeID: newID,
+			ActionID:      actionID,
+			SourceThemeID: params.ID,
+			ManagerID:     managerID,
+		})
+		if err != nil {
+			zap.L().Error("error moving action theme", zap.Error(err))
+			return &api.SplitThemeInternalServerError{
+				Message: "Failed to split theme",
+				Code:    "INTERNAL_ERROR",
+			}, nil
+		}
+		if moved == 0 {
+			return &api.SplitThemeBadRequest{
+				Message: "Action is not tagged with this theme: " + actionID,
+				Code:    "INVALID_ACTION",
+			}, nil
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		zap.L().Error("error committing theme split", zap.Error(err))
+		return &api.SplitThemeInternalServerError{
+			Message: "Failed to split theme",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	row, err := h.queries.GetThemeWithCounts(ctx, db.GetThemeWithCountsParams{
+		ID:        newID,
+		ManagerID: managerID,
+	})
+	if err != nil {
+		zap.L().Error("error getting theme", zap.Error(err))
+		return &api.SplitThemeInternalServerError{
+			Message: "Failed to split theme",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	theme := convertToAPIThemeDetail(row.Theme, row.ActionCount, row.ConversationCount)
+	return &theme, nil
+}
@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"database/sql"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"pepo/internal/auth"
+	"pepo/internal/db"
+	"pepo/templates"
+
+	"go.uber.org/zap"
+)
+
+type TokenHandler struct {
+	queries *db.Queries
+	tokens  *auth.Tokens
+}
+
+func NewTokenHandler(queries *db.Queries, tokens *auth.Tokens) *TokenHandler {
+	return &TokenHandler{
+		queries: queries,
+		tokens:  tokens,
+	}
+}
+
+// HandleTokens lists the manager's API tokens on GET and issues a new one on POST
+func (h *TokenHandler) HandleTokens(w http.ResponseWriter, r *http.Request) {
+	if !h.requireSession(w, r) {
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		h.renderTokens(w, r, http.StatusOK, "", "")
+	case http.MethodPost:
+		if err := r.ParseForm(); err != nil {
+			h.renderTokens(w, r, http.StatusBadRequest, "", "Invalid form data")
+			return
+		}
+
+		name := strings.TrimSpace(r.FormValue("name"))
+		if name == "" {
+			h.renderTokens(w, r, http.StatusBadRequest, "", "Name is required")
+			return
+		}
+
+		scope := db.ApiTokenScope(r.FormValue("scope"))
+		if scope == "" {
+			scope = db.ApiTokenScopeRead
+		}
+		if !scope.Valid() {
+			h.renderTokens(w, r, http.StatusBadRequest, "", "Scope must be read or write")
+			return
+		}
+
+		var expiresAt sql.NullTime
+		if days := strings.TrimSpace(r.FormValue("expires_in_days")); days != "" {
+			n, err := strconv.Atoi(days)
+			if err != nil || n <= 0 {
+				h.renderTokens(w, r, http.StatusBadRequest, "", "Expiry must be a positive number of days")
+				return
+			}
+			expiresAt = sql.NullTime{Time: time.Now().AddDate(0, 0, n), Valid: true}
+		}
+
+		token, err := h.tokens.Issue(r.Context(), auth.ManagerID(r.Context()), name, scope, expiresAt)
+		if err != nil {
+			zap.L().Error("error issuing API token", zap.Error(err))
+			h.renderTokens(w, r, http.StatusInternalServerError, "", "Failed to create token")
+			return
+		}
+
+		h.renderTokens(w, r, http.StatusCreated, token, "")
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// HandleRevokeToken revokes a token via POST /settings/tokens/{id}/revoke
+func (h *TokenHandler) HandleRevokeToken(w http.ResponseWriter, r *http.Request) {
+	if !h.requireSession(w, r) {
+		return
+	}
+
+	id, ok := strings.CutSuffix(strings.TrimPrefix(r.URL.Path, "/settings/tokens/"), "/revoke")
+	if !ok || id == "" || strings.Contains(id, "/") {
+		http.NotFound(w, r)
+		return
+	}
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", "POST")
+		http.Error(w, "Method Not Allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if err := h.queries.RevokeAPIToken(r.Context(), db.RevokeAPITokenParams{
+		ID:        id,
+		ManagerID: auth.ManagerID(r.Context()),
+	}); err != nil {
+		zap.L().Error("error revoking API token", zap.Error(err))
+		h.renderTokens(w, r, http.StatusInternalServerError, "", "Failed to revoke token")
+		return
+	}
+
+	http.Redirect(w, r, "/settings/tokens", http.StatusSeeOther)
+}
+
+// requireSession keeps token management behind an interactive sign-in so that
+// a leaked token can't be used to mint or revoke other tokens.
+func (h *TokenHandler) requireSession(w http.ResponseWriter, r *http.Request) bool {
+	if _, ok := auth.TokenScopeFromContext(r.Context()); ok {
+		http.Error(w, "API tokens can't manage API tokens", http.StatusForbidden)
+		return false
+	}
+	return true
+}
+
+func (h *TokenHandler) renderTokens(w http.ResponseWriter, r *http.Request, status int, newToken, errorMessage string) {
+	rows, err := h.queries.ListAPITokens(r.Context(), auth.ManagerID(r.Context()))
+	if err != nil {
+		zap.L().Error("error listing API tokens", zap.Error(err))
+		status = http.StatusInternalServerError
+		errorMessage = "Failed to load tokens"
+	}
+
+	tokens := make([]templates.APIToken, len(rows))
+	for i, row := range rows {
+		t := row.ApiToken
+		token := templates.APIToken{
+			ID:        t.ID.String(),
+			Name:      t.Name,
+			Scope:     string(t.Scope),
+			CreatedAt: t.CreatedAt,
+		}
+		if t.ExpiresAt.Valid {
+			token.ExpiresAt = &t.ExpiresAt.Time
+		}
+		if t.LastUsedAt.Valid {
+			token.LastUsedAt = &t.LastUsedAt.Time
+		}
+		if t.RevokedAt.Valid {
+			token.RevokedAt = &t.RevokedAt.Time
+		}
+		tokens[i] = token
+	}
+
+	w.Header().Set("Content-Type", "text/html")
+	w.WriteHeader(status)
+	if err := templates.TokensPage(tokens, newToken, errorMessage).Render(r.Context(), w); err != nil {
+		zap.L().Error("error rendering tokens page", zap.Error(err))
+	}
+}
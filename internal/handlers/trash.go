@@ -0,0 +1,154 @@
+package handlers
+
+import (
+	"context"
+	"database/sql"
+
+	"go.uber.org/zap"
+
+	"pepo/internal/api"
+	"pepo/internal/auth"
+	"pepo/internal/blobstore"
+	"pepo/internal/db"
+)
+
+type TrashHandler struct {
+	queries *db.Queries
+	blobs   blobstore.Store
+}
+
+func NewTrashHandler(queries *db.Queries, blobs blobstore.Store) *TrashHandler {
+	return &TrashHandler{queries: queries, blobs: blobs}
+}
+
+// ListTrash returns trashed items, most recently deleted first. Things that went
+// to the trash along with their person are listed under the person only.
+func (h *TrashHandler) ListTrash(ctx context.Context, params api.ListTrashParams) (api.ListTrashRes, error) {
+	rows, err := h.queries.ListTrash(ctx, db.ListTrashParams{
+		ManagerID: auth.ManagerID(ctx),
+		Limit:     int32(params.Limit.Or(50)),
+		Offset:    int32(params.Offset.Or(0)),
+	})
+	if err != nil {
+		zap.L().Error("error listing trash", zap.Error(err))
+		return &api.Error{
+			Message: "Failed to list trash",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	items := make([]api.TrashItem, len(rows))
+	for i, row := range rows {
+		items[i] = api.TrashItem{
+			Type:       api.TrashItemType(row.Kind),
+			ID:         row.ID,
+			Label:      row.Label,
+			PersonName: row.PersonName,
+			DeletedAt:  row.DeletedAt,
+		}
+	}
+	return &api.ListTrashOKApplicationJSON{Items: items}, nil
+}
+
+// PurgeTrashItem deletes a trashed item for good, along with any attachment files
+// that hung off it
+func (h *TrashHandler) PurgeTrashItem(ctx context.Context, params api.PurgeTrashItemParams) (api.PurgeTrashItemRes, error) {
+	managerID := auth.ManagerID(ctx)
+
+	// Keys are collected first because the attachment rows cascade with the purge
+	var keys []string
+	var err error
+	switch params.Type {
+	case api.PurgeTrashItemTypePerson:
+		keys, err = h.queries.ListPersonAttachmentKeys(ctx, db.ListPersonAttachmentKeysParams{
+			ManagerID: managerID,
+			PersonID:  params.ID,
+		})
+	case api.PurgeTrashItemTypeAction:
+		keys, err = attachmentKeys(ctx, h.queries, db.ListAttachmentsParams{
+			ManagerID: managerID,
+			ActionID:  sql.NullString{String: params.ID, Valid: true},
+		})
+	case api.PurgeTrashItemTypeConversation:
+		keys, err = attachmentKeys(ctx, h.queries, db.ListAttachmentsParams{
+			ManagerID:      managerID,
+			ConversationID: sql.NullString{String: params.ID, Valid: true},
+		})
+	}
+	if err != nil {
+		zap.L().Error("error listing attachments to purge", zap.Error(err))
+		return &api.PurgeTrashItemInternalServerError{
+			Message: "Failed to purge item",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+
+	var purged int64
+	switch params.Type {
+	case api.PurgeTrashItemTypePerson:
+		purged, err = h.queries.PurgePerson(ctx, db.PurgePersonParams{ID: params.ID, ManagerID: managerID})
+	case api.PurgeTrashItemTypeAction:
+		purged, err = h.queries.PurgeAction(ctx, db.PurgeActionParams{ID: params.ID, ManagerID: managerID})
+	case api.PurgeTrashItemTypeConversation:
+		purged, err = h.queries.PurgeConversation(ctx, db.PurgeConversationParams{ID: params.ID, ManagerID: managerID})
+	case api.PurgeTrashItemTypeTheme:
+		purged, err = h.queries.PurgeTheme(ctx, db.PurgeThemeParams{ID: params.ID, ManagerID: managerID})
+	}
+	if err != nil {
+		zap.L().Error("error purging trash item", zap.String("type", string(params.Type)), zap.Error(err))
+		return &api.PurgeTrashItemInternalServerError{
+			Message: "Failed to purge item",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+	if purged == 0 {
+		return &api.PurgeTrashItemNotFound{
+			Message: "Item not found in trash",
+			Code:    "NOT_FOUND",
+		}, nil
+	}
+
+	removeBlobs(ctx, h.blobs, keys)
+	return &api.PurgeTrashItemNoContent{}, nil
+}
+
+// RestoreTrashItem takes an item back out of the trash. Items whose person is
+// still trashed are reported as not found; restoring the person brings them back.
+func (h *TrashHandler) RestoreTrashItem(ctx context.Context, params api.RestoreTrashItemParams) (api.RestoreTrashItemRes, error) {
+	managerID := auth.ManagerID(ctx)
+
+	var restored int64
+	var err error
+	switch params.Type {
+	case api.RestoreTrashItemTypePerson:
+		restored, err = h.queries.RestorePerson(ctx, db.RestorePersonParams{ID: params.ID, ManagerID: managerID})
+	case api.RestoreTrashItemTypeAction:
+		restored, err = h.queries.RestoreAction(ctx, db.RestoreActionParams{ID: params.ID, ManagerID: managerID})
+	case api.RestoreTrashItemTypeConversation:
+		restored, err = h.queries.RestoreConversation(ctx, db.RestoreConversationParams{ID: params.ID, ManagerID: managerID})
+	case api.RestoreTrashItemTypeTheme:
+		restored, err = h.queries.RestoreTheme(ctx, db.RestoreThemeParams{ID: params.ID, ManagerID: managerID})
+	}
+	if err != nil {
+		// Trashed framework themes give up their text, so another may now hold it
+		if isUniqueViolation(err) {
+			return &api.RestoreTrashItemConflict{
+				Message: "A framework theme with this text already exists",
+				Code:    "VALIDATION_ERROR",
+			}, nil
+		}
+		zap.L().Error("error restoring trash item", zap.String("type", string(params.Type)), zap.Error(err))
+		return &api.RestoreTrashItemInternalServerError{
+			Message: "Failed to restore item",
+			Code:    "INTERNAL_ERROR",
+		}, nil
+	}
+	if restored == 0 {
+		return &api.RestoreTrashItemNotFound{
+			Message: "Item not found in trash",
+			Code:    "NOT_FOUND",
+		}, nil
+	}
+
+	return &api.RestoreTrashItemNoContent{}, nil
+}
@@ -5,8 +5,11 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
+
+	"pepo/internal/references"
 )
 
 // FormToJSONAdapter middleware converts HTML form submissions to JSON requests
@@ -64,6 +67,11 @@ func (f *FormToJSONAdapter) convertFormToJSON(r *http.Request) ([]byte, error) {
 	}
 
 	switch {
+	case strings.Contains(path, "/revisions/"):
+		// Restoring a revision has no body to convert
+		return nil, nil
+	case strings.HasPrefix(path, "/people") && strings.HasSuffix(path, "/ladder"):
+		return f.convertLadderForm(r)
 	case strings.HasPrefix(path, "/people"):
 		return f.convertPersonForm(r)
 	case strings.HasPrefix(path, "/actions"):
@@ -72,6 +80,10 @@ func (f *FormToJSONAdapter) convertFormToJSON(r *http.Request) ([]byte, error) {
 		return f.convertConversationForm(r)
 	case strings.HasPrefix(path, "/forms/themes"):
 		return f.convertThemeForm(r)
+	case path == "/follow-ups":
+		return f.convertFollowUpForm(r)
+	case strings.HasPrefix(path, "/themes"):
+		return f.convertThemeManagementForm(r, path)
 	default:
 		// Unknown form type - skip conversion
 		return nil, nil
@@ -89,6 +101,36 @@ func (f *FormToJSONAdapter) convertPersonForm(r *http.Request) ([]byte, error) {
 		"name": name,
 	}
 
+	// Optional reporting line; an empty selection means a top-level report
+	if reportsTo := strings.TrimSpace(r.FormValue("reports_to")); reportsTo != "" {
+		data["reports_to"] = reportsTo
+	}
+
+	// Optional 1:1 cadence in days; an empty value means no cadence
+	if cadence := strings.TrimSpace(r.FormValue("cadence_days")); cadence != "" {
+		days, err := strconv.Atoi(cadence)
+		if err != nil || days < 1 {
+			return nil, &FormError{Field: "cadence_days", Message: "Cadence must be a positive number of days"}
+		}
+		data["cadence_days"] = days
+	}
+
+	return json.Marshal(data)
+}
+
+// convertLadderForm converts the level pickers on the career ladder page to JSON;
+// "Not set" clears a level
+func (f *FormToJSONAdapter) convertLadderForm(r *http.Request) ([]byte, error) {
+	data := map[string]interface{}{
+		"current_level_id": nil,
+		"target_level_id":  nil,
+	}
+	for field := range data {
+		if id := strings.TrimSpace(r.FormValue(field)); id != "" {
+			data[field] = id
+		}
+	}
+
 	return json.Marshal(data)
 }
 
@@ -145,10 +187,24 @@ func (f *FormToJSONAdapter) convertActionForm(r *http.Request) ([]byte, error) {
 		"valence":     valence,
 	}
 
-	// Optional references field
-	references := strings.TrimSpace(r.FormValue("references"))
-	if references != "" {
-		data["references"] = references
+	// References are entered one per line; an empty box clears them on update
+	refs := []map[string]interface{}{}
+	for _, e := range references.ParseLines(r.FormValue("references")) {
+		ref := map[string]interface{}{"kind": string(e.Kind), "value": e.Value}
+		if e.Title != "" {
+			ref["title"] = e.Title
+		}
+		refs = append(refs, ref)
+	}
+	data["references"] = refs
+
+	// Optional impact; "Not rated" clears it
+	switch impact := strings.TrimSpace(r.FormValue("impact")); impact {
+	case "":
+	case "low", "medium", "high":
+		data["impact"] = impact
+	default:
+		return nil, &FormError{Field: "impact", Message: "Impact must be low, medium, or high"}
 	}
 
 	// Optional themes field
@@ -165,6 +221,20 @@ func (f *FormToJSONAdapter) convertActionForm(r *http.Request) ([]byte, error) {
 		}
 	}
 
+	// Optional conversations the action was discussed in
+	if conversations := r.Form["conversations"]; len(conversations) > 0 {
+		clean := make([]string, 0, len(conversations))
+		for _, c := range conversations {
+			c = strings.TrimSpace(c)
+			if c != "" {
+				clean = append(clean, c)
+			}
+		}
+		if len(clean) > 0 {
+			data["conversations"] = clean
+		}
+	}
+
 	return json.Marshal(data)
 }
 
@@ -272,6 +342,103 @@ func (f *FormToJSONAdapter) convertConversationForm(r *http.Request) ([]byte, er
 	return json.Marshal(data)
 }
 
+// convertThemeManagementForm converts the rename, archive, merge and split forms on the
+// theme management page to JSON
+func (f *FormToJSONAdapter) convertThemeManagementForm(r *http.Request, path string) ([]byte, error) {
+	switch {
+	case strings.HasSuffix(path, "/merge"):
+		sources := make([]string, 0, len(r.Form["source_ids"]))
+		for _, id := range r.Form["source_ids"] {
+			id = strings.TrimSpace(id)
+			if id != "" {
+				sources = append(sources, id)
+			}
+		}
+		if len(sources) == 0 {
+			return nil, &FormError{Field: "source_ids", Message: "Select at least one theme to merge"}
+		}
+		return json.Marshal(map[string]interface{}{
+			"source_ids": sources,
+		})
+
+	case strings.HasSuffix(path, "/split"):
+		text := strings.TrimSpace(r.FormValue("text"))
+		if text == "" {
+			return nil, &FormError{Field: "text", Message: "Text is required"}
+		}
+		actions := make([]string, 0, len(r.Form["action_ids"]))
+		for _, id := range r.Form["action_ids"] {
+			id = strings.TrimSpace(id)
+			if id != "" {
+				actions = append(actions, id)
+			}
+		}
+		if len(actions) == 0 {
+			return nil, &FormError{Field: "action_ids", Message: "Select at least one action to move"}
+		}
+		return json.Marshal(map[string]interface{}{
+			"text":       text,
+			"action_ids": actions,
+		})
+	}
+
+	text := strings.TrimSpace(r.FormValue("text"))
+	if text == "" {
+		return nil, &FormError{Field: "text", Message: "Text is required"}
+	}
+
+	data := map[string]interface{}{
+		"text": text,
+	}
+	if r.Method == "PUT" {
+		data["archived"] = r.FormValue("archived") == "true"
+	} else {
+		// No person means a framework theme
+		if personID := strings.TrimSpace(r.FormValue("person_id")); personID != "" {
+			data["person_id"] = personID
+		}
+		if description := strings.TrimSpace(r.FormValue("description")); description != "" {
+			data["description"] = description
+		}
+	}
+
+	return json.Marshal(data)
+}
+
+// convertFollowUpForm converts follow-up form data to JSON
+func (f *FormToJSONAdapter) convertFollowUpForm(r *http.Request) ([]byte, error) {
+	conversationID := strings.TrimSpace(r.FormValue("conversation_id"))
+	if conversationID == "" {
+		return nil, &FormError{Field: "conversation_id", Message: "Conversation ID is required"}
+	}
+
+	description := strings.TrimSpace(r.FormValue("description"))
+	if description == "" {
+		return nil, &FormError{Field: "description", Message: "Description is required"}
+	}
+
+	owner := strings.TrimSpace(r.FormValue("owner"))
+	if owner != "manager" && owner != "report" {
+		return nil, &FormError{Field: "owner", Message: "Owner must be manager or report"}
+	}
+
+	data := map[string]interface{}{
+		"conversation_id": conversationID,
+		"description":     description,
+		"owner":           owner,
+	}
+
+	// Optional due date from an HTML date input: 2006-01-02
+	if dueOn := strings.TrimSpace(r.FormValue("due_on")); dueOn != "" {
+		if _, err := time.Parse("2006-01-02", dueOn); err != nil {
+			return nil, &FormError{Field: "due_on", Message: "Invalid date format"}
+		}
+		data["due_on"] = dueOn
+	}
+
+	return json.Marshal(data)
+}
+
 // createJSONRequest creates a new request with JSON data
 func (f *FormToJSONAdapter) createJSONRequest(r *http.Request, jsonData []byte) *http.Request {
 	// Create new request with JSON body
@@ -52,3 +52,64 @@ func TestFormToJSONAdapterHandlesAPIPrefix(t *testing.T) {
 		t.Errorf("occurred_at missing from payload")
 	}
 }
+
+func TestFormToJSONAdapterSendsActionConversations(t *testing.T) {
+	form := url.Values{}
+	form.Set("person_id", "abc123")
+	form.Set("description", "test action")
+	form.Set("valence", "positive")
+	form.Add("conversations", "conv1")
+	form.Add("conversations", " conv2 ")
+	form.Add("conversations", "")
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/actions/act1", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var captured *http.Request
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		captured = r
+	})
+
+	middleware.NewFormToJSONAdapter(handler).ServeHTTP(httptest.NewRecorder(), req)
+
+	if captured == nil {
+		t.Fatalf("handler was not called")
+	}
+
+	var payload map[string]any
+	if err := json.NewDecoder(captured.Body).Decode(&payload); err != nil {
+		t.Fatalf("failed to decode JSON: %v", err)
+	}
+
+	conversations, ok := payload["conversations"].([]any)
+	if !ok {
+		t.Fatalf("conversations missing from payload: %v", payload)
+	}
+	if len(conversations) != 2 || conversations[0] != "conv1" || conversations[1] != "conv2" {
+		t.Errorf("unexpected conversations: %v", conversations)
+	}
+}
+
+func TestFormToJSONAdapterPassesRevisionRestoreThrough(t *testing.T) {
+	for _, path := range []string{
+		"/api/v1/actions/act1/revisions/rev1/restore",
+		"/api/v1/conversations/conv1/revisions/rev1/restore",
+	} {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(""))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+		var captured *http.Request
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			captured = r
+		})
+
+		rec := httptest.NewRecorder()
+		middleware.NewFormToJSONAdapter(handler).ServeHTTP(rec, req)
+
+		if captured == nil {
+			t.Fatalf("%s: handler was not called, got status %d: %s", path, rec.Code, rec.Body.String())
+		}
+		if ct := captured.Header.Get("Content-Type"); ct != "application/x-www-form-urlencoded" {
+			t.Errorf("%s: expected the request unchanged, got Content-Type %s", path, ct)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+// Package period buckets timestamps into the calendar periods a timeline can be
+// grouped by.
+package period
+
+import (
+	"fmt"
+	"time"
+)
+
+type Unit string
+
+const (
+	Week    Unit = "week"
+	Month   Unit = "month"
+	Quarter Unit = "quarter"
+)
+
+// Start returns the first day of the period containing t, taken in UTC. Weeks
+// start on Monday, as they do for Postgres's date_trunc.
+func Start(u Unit, t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	switch u {
+	case Week:
+		return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+	case Quarter:
+		return time.Date(t.Year(), t.Month()-(t.Month()-1)%3, 1, 0, 0, 0, 0, time.UTC)
+	}
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// Label names the period beginning at start
+func Label(u Unit, start time.Time) string {
+	switch u {
+	case Week:
+		return "Week of " + start.Format("Jan 2, 2006")
+	case Quarter:
+		return fmt.Sprintf("Q%d %d", (int(start.Month())-1)/3+1, start.Year())
+	}
+	return start.Format("January 2006")
+}
@@ -0,0 +1,44 @@
+package period
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStart(t *testing.T) {
+	// 2025-08-14 is a Thursday
+	at := time.Date(2025, 8, 14, 23, 30, 0, 0, time.FixedZone("x", -3*3600))
+
+	tests := []struct {
+		unit Unit
+		want time.Time
+	}{
+		// 23:30 at UTC-3 is already the 15th in UTC
+		{Week, time.Date(2025, 8, 11, 0, 0, 0, 0, time.UTC)},
+		{Month, time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC)},
+		{Quarter, time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		if got := Start(tt.unit, at); !got.Equal(tt.want) {
+			t.Errorf("Start(%s) = %v, want %v", tt.unit, got, tt.want)
+		}
+	}
+
+	sunday := time.Date(2025, 8, 17, 12, 0, 0, 0, time.UTC)
+	if got := Start(Week, sunday); !got.Equal(time.Date(2025, 8, 11, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Start(week) of a Sunday = %v, want the Monday before", got)
+	}
+}
+
+func TestLabel(t *testing.T) {
+	start := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+	for unit, want := range map[Unit]string{
+		Week:    "Week of Jul 1, 2025",
+		Month:   "July 2025",
+		Quarter: "Q3 2025",
+	} {
+		if got := Label(unit, start); got != want {
+			t.Errorf("Label(%s) = %q, want %q", unit, got, want)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// Package references classifies the links, ticket keys, pull requests and notes
+// recorded against an action.
+package references
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type Kind string
+
+const (
+	KindURL         Kind = "url"
+	KindTicket      Kind = "ticket"
+	KindPullRequest Kind = "pull_request"
+	KindNote        Kind = "note"
+)
+
+// Entry is one reference; Title is optional
+type Entry struct {
+	Kind  Kind
+	Value string
+	Title string
+}
+
+// These mirror the patterns the create_action_reference migration used to split
+// the old free-text column, so new and migrated entries are classified alike.
+var (
+	urlPattern         = regexp.MustCompile(`^https?://\S+$`)
+	pullRequestPattern = regexp.MustCompile(`^(?:[A-Za-z0-9_.-]+/[A-Za-z0-9_.-]+#[0-9]+|([Pp][Rr]\s*)?#[0-9]+)$`)
+	ticketPattern      = regexp.MustCompile(`^[A-Z][A-Z0-9]+-[0-9]+$`)
+	repoPullPattern    = regexp.MustCompile(`^([A-Za-z0-9_.-]+/[A-Za-z0-9_.-]+)#([0-9]+)$`)
+)
+
+// Classify guesses the kind of a single reference value
+func Classify(value string) Kind {
+	value = strings.TrimSpace(value)
+	switch {
+	case urlPattern.MatchString(value):
+		return KindURL
+	case pullRequestPattern.MatchString(value):
+		return KindPullRequest
+	case ticketPattern.MatchString(value):
+		return KindTicket
+	}
+	return KindNote
+}
+
+// ParseLines reads one reference per line. A line may carry a title before a
+// " | " separator, as in "Design doc | https://example.com/doc".
+func ParseLines(text string) []Entry {
+	var entries []Entry
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		entry := Entry{Value: line}
+		if title, value, ok := strings.Cut(line, " | "); ok && strings.TrimSpace(value) != "" {
+			entry.Title = strings.TrimSpace(title)
+			entry.Value = strings.TrimSpace(value)
+		}
+		entry.Kind = Classify(entry.Value)
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// Line formats an entry the way ParseLines reads it back
+func (e Entry) Line() string {
+	if e.Title == "" {
+		return e.Value
+	}
+	return e.Title + " | " + e.Value
+}
+
+// Href returns where the reference points, or "" when it is not a link. Only
+// pull requests written as owner/repo#123 can be resolved to a GitHub URL.
+func (e Entry) Href() string {
+	switch e.Kind {
+	case KindURL:
+		return e.Value
+	case KindPullRequest:
+		if m := repoPullPattern.FindStringSubmatch(e.Value); m != nil {
+			return fmt.Sprintf("https://github.com/%s/pull/%s", m[1], m[2])
+		}
+	}
+	return ""
+}
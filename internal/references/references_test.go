@@ -0,0 +1,43 @@
+package references
+
+import "testing"
+
+func TestClassify(t *testing.T) {
+	tests := map[string]Kind{
+		"https://example.com/doc":  KindURL,
+		"http://example.com":       KindURL,
+		"acme/web#42":              KindPullRequest,
+		"#42":                      KindPullRequest,
+		"PR #42":                   KindPullRequest,
+		"ENG-1234":                 KindTicket,
+		"eng-1234":                 KindNote,
+		"Design review notes":      KindNote,
+		"https://example.com a b":  KindNote,
+		"  https://example.com/x ": KindURL,
+	}
+	for value, want := range tests {
+		if got := Classify(value); got != want {
+			t.Errorf("Classify(%q) = %q, want %q", value, got, want)
+		}
+	}
+}
+
+func TestParseLines(t *testing.T) {
+	entries := ParseLines("Design doc | https://example.com/doc\n\n  ENG-12 \nacme/web#7")
+	if len(entries) != 3 {
+		t.Fatalf("Expected 3 entries, got %d: %+v", len(entries), entries)
+	}
+
+	if entries[0].Title != "Design doc" || entries[0].Value != "https://example.com/doc" || entries[0].Kind != KindURL {
+		t.Errorf("Unexpected first entry: %+v", entries[0])
+	}
+	if entries[1].Title != "" || entries[1].Value != "ENG-12" || entries[1].Kind != KindTicket {
+		t.Errorf("Unexpected second entry: %+v", entries[1])
+	}
+	if got := entries[2].Href(); got != "https://github.com/acme/web/pull/7" {
+		t.Errorf("Expected pull request link, got %q", got)
+	}
+	if got := entries[0].Line(); got != "Design doc | https://example.com/doc" {
+		t.Errorf("Line did not round trip, got %q", got)
+	}
+}
@@ -0,0 +1,59 @@
+// Package revisions works out what changed between two saved versions of an
+// action or conversation.
+package revisions
+
+// Change is one field that differs between revisions. Single-valued fields
+// fill From and To; list fields fill Added and Removed.
+type Change struct {
+	Field   string
+	From    string
+	To      string
+	Added   []string
+	Removed []string
+}
+
+// Item is one member of a list field. Key decides whether two items are the
+// same, so a theme renamed between revisions is not reported as swapped out.
+type Item struct {
+	Key   string
+	Label string
+}
+
+// Diff collects changes one field at a time, in the order they are compared
+type Diff struct {
+	Changes []Change
+}
+
+// Value records a change when a single-valued field differs
+func (d *Diff) Value(field, from, to string) {
+	if from != to {
+		d.Changes = append(d.Changes, Change{Field: field, From: from, To: to})
+	}
+}
+
+// List records the items added to and removed from a list field. Order is
+// ignored; a repeated item counts once per occurrence.
+func (d *Diff) List(field string, from, to []Item) {
+	added := missing(to, from)
+	removed := missing(from, to)
+	if len(added) > 0 || len(removed) > 0 {
+		d.Changes = append(d.Changes, Change{Field: field, Added: added, Removed: removed})
+	}
+}
+
+// missing returns the labels of items in a that b does not account for
+func missing(a, b []Item) []string {
+	have := map[string]int{}
+	for _, item := range b {
+		have[item.Key]++
+	}
+	var labels []string
+	for _, item := range a {
+		if have[item.Key] > 0 {
+			have[item.Key]--
+			continue
+		}
+		labels = append(labels, item.Label)
+	}
+	return labels
+}
@@ -0,0 +1,50 @@
+package revisions
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValue(t *testing.T) {
+	var d Diff
+	d.Value("description", "Shipped it", "Shipped it")
+	d.Value("valence", "positive", "negative")
+
+	want := []Change{{Field: "valence", From: "positive", To: "negative"}}
+	if !reflect.DeepEqual(d.Changes, want) {
+		t.Errorf("Expected %+v, got %+v", want, d.Changes)
+	}
+}
+
+func TestList(t *testing.T) {
+	var d Diff
+	d.List("themes",
+		[]Item{{Key: "a", Label: "Ownership"}, {Key: "b", Label: "Mentoring"}},
+		[]Item{{Key: "b", Label: "Coaching"}, {Key: "c", Label: "Delivery"}},
+	)
+
+	want := []Change{{Field: "themes", Added: []string{"Delivery"}, Removed: []string{"Ownership"}}}
+	if !reflect.DeepEqual(d.Changes, want) {
+		t.Errorf("Expected %+v, got %+v", want, d.Changes)
+	}
+}
+
+func TestListIgnoresOrderButCountsRepeats(t *testing.T) {
+	var d Diff
+	d.List("references",
+		[]Item{{Key: "x", Label: "x"}, {Key: "y", Label: "y"}},
+		[]Item{{Key: "y", Label: "y"}, {Key: "x", Label: "x"}},
+	)
+	if len(d.Changes) != 0 {
+		t.Fatalf("Expected no changes for a reorder, got %+v", d.Changes)
+	}
+
+	d.List("references",
+		[]Item{{Key: "x", Label: "x"}},
+		[]Item{{Key: "x", Label: "x"}, {Key: "x", Label: "x"}},
+	)
+	want := []Change{{Field: "references", Added: []string{"x"}}}
+	if !reflect.DeepEqual(d.Changes, want) {
+		t.Errorf("Expected %+v, got %+v", want, d.Changes)
+	}
+}
@@ -12,6 +12,8 @@ import (
 	"time"
 
 	"pepo/internal/api"
+	"pepo/internal/audit"
+	"pepo/internal/auth"
 	"pepo/internal/config"
 	"pepo/internal/handlers"
 	"pepo/internal/middleware"
@@ -26,18 +28,19 @@ type Server struct {
 }
 
 // New creates a new server instance
-func New(cfg *config.Config, apiHandler *handlers.CombinedAPIHandler, personHandler *handlers.PersonHandler, actionHandler *handlers.ActionHandler) (*Server, error) {
+func New(cfg *config.Config, apiHandler *handlers.CombinedAPIHandler, personHandler *handlers.PersonHandler, actionHandler *handlers.ActionHandler, conversationHandler *handlers.ConversationHandler, authHandler *handlers.AuthHandler, tokenHandler *handlers.TokenHandler, frameworkHandler *handlers.FrameworkHandler, attachmentHandler *handlers.AttachmentHandler, sessions *auth.Sessions, tokens *auth.Tokens, auditLog *audit.Log) (*Server, error) {
 	// Create content negotiating handler
 	contentHandler := handlers.NewContentNegotiatingHandler(apiHandler)
 
-	// Create ogen server with content negotiating handler
-	apiServer, err := api.NewServer(contentHandler)
+	// Create ogen server with content negotiating handler; every operation it
+	// serves is written to the audit log
+	apiServer, err := api.NewServer(contentHandler, auth.APISecurity{}, api.WithMiddleware(auditLog.Middleware()))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create API server: %w", err)
 	}
 
 	// Setup routes
-	mux := setupRoutes(apiServer, personHandler, actionHandler)
+	mux := setupRoutes(apiServer, personHandler, actionHandler, conversationHandler, authHandler, tokenHandler, frameworkHandler, attachmentHandler, auditLog)
 
 	// Wrap with middleware
 	handler := middleware.Chain(mux,
@@ -46,6 +49,7 @@ func New(cfg *config.Config, apiHandler *handlers.CombinedAPIHandler, personHand
 		middleware.RecoveryMiddleware,
 		middleware.LoggingMiddleware,
 		middleware.SecurityHeadersMiddleware,
+		auth.Require(sessions, tokens),
 	)
 
 	// Add CORS middleware in development
@@ -115,7 +119,7 @@ func (s *Server) Shutdown(ctx context.Context) error {
 }
 
 // setupRoutes configures all HTTP routes
-func setupRoutes(apiServer *api.Server, personHandler *handlers.PersonHandler, actionHandler *handlers.ActionHandler) *http.ServeMux {
+func setupRoutes(apiServer *api.Server, personHandler *handlers.PersonHandler, actionHandler *handlers.ActionHandler, conversationHandler *handlers.ConversationHandler, authHandler *handlers.AuthHandler, tokenHandler *handlers.TokenHandler, frameworkHandler *handlers.FrameworkHandler, attachmentHandler *handlers.AttachmentHandler, auditLog *audit.Log) *http.ServeMux {
 	mux := http.NewServeMux()
 
 	// Health check endpoint (both at root and API level)
@@ -123,14 +127,33 @@ func setupRoutes(apiServer *api.Server, personHandler *handlers.PersonHandler, a
 	mux.HandleFunc("/health", healthHandler)
 	mux.HandleFunc("/api/v1/health", healthHandler)
 
+	// Manager sign in and sign out
+	mux.HandleFunc("/login", authHandler.HandleLogin)
+	mux.HandleFunc("/logout", authHandler.HandleLogout)
+
+	// Routes outside the API router are audited here; the API's own operations
+	// are recorded by the middleware on apiServer
+
+	// Personal API token management
+	mux.HandleFunc("/settings/tokens", auditLog.Handler("Tokens", tokenHandler.HandleTokens))
+	mux.HandleFunc("/settings/tokens/", auditLog.Handler("RevokeToken", tokenHandler.HandleRevokeToken))
+
+	// Competency framework shared across all reports
+	mux.HandleFunc("/framework", auditLog.Handler("Framework", frameworkHandler.HandleFramework))
+
+	// Files attached to actions and conversations
+	mux.HandleFunc("/attachments", auditLog.Handler("Attachments", attachmentHandler.HandleAttachments))
+	mux.HandleFunc("/attachments/", auditLog.Handler("Attachment", attachmentHandler.HandleAttachment))
+
 	// Root endpoint - serve the main HTML page using templ
 	mux.HandleFunc("/", handleRootPage)
 
 	// Legacy form handlers for HTMX compatibility (keeping for now)
-	mux.HandleFunc("/forms/people/select", personHandler.HandleGetPersonsForSelect)
-	mux.HandleFunc("/forms/actions/select", actionHandler.HandleGetActionsForSelect)
-	mux.HandleFunc("/forms/themes/select", actionHandler.HandleGetThemesForSelect)
-	mux.HandleFunc("/forms/themes/create", actionHandler.HandleCreateTheme)
+	mux.HandleFunc("/forms/people/select", auditLog.Handler("GetPersonsForSelect", personHandler.HandleGetPersonsForSelect))
+	mux.HandleFunc("/forms/actions/select", auditLog.Handler("GetActionsForSelect", actionHandler.HandleGetActionsForSelect))
+	mux.HandleFunc("/forms/themes/select", auditLog.Handler("GetThemesForSelect", actionHandler.HandleGetThemesForSelect))
+	mux.HandleFunc("/forms/themes/create", auditLog.Handler("CreateThemeForm", actionHandler.HandleCreateTheme))
+	mux.HandleFunc("/forms/conversations/select", auditLog.Handler("GetConversationsForSelect", conversationHandler.HandleGetConversationsForSelect))
 
 	// Consolidated API routes with content negotiation (supports both JSON and HTML)
 	mux.Handle("/api/v1/", http.StripPrefix("/api/v1", apiServer))
@@ -138,10 +161,16 @@ func setupRoutes(apiServer *api.Server, personHandler *handlers.PersonHandler, a
 	// Convenience routes that serve the same endpoints without /api/v1 prefix
 	mux.Handle("/people/", createConvenienceHandler(apiServer, "/people"))
 	mux.Handle("/people", createConvenienceHandler(apiServer, "/people"))
-	mux.HandleFunc("/actions/", createActionHandler(apiServer, actionHandler))
+	mux.HandleFunc("/actions/", createActionHandler(apiServer, actionHandler, auditLog))
 	mux.Handle("/actions", createConvenienceHandler(apiServer, "/actions"))
-	mux.HandleFunc("/conversations/", createConversationHandler(apiServer, personHandler))
+	mux.HandleFunc("/conversations/", createConversationHandler(apiServer, personHandler, conversationHandler, auditLog))
 	mux.Handle("/conversations", createConvenienceHandler(apiServer, "/conversations"))
+	mux.Handle("/org", createConvenienceHandler(apiServer, "/org"))
+	mux.Handle("/follow-ups/", createConvenienceHandler(apiServer, "/follow-ups"))
+	mux.Handle("/follow-ups", createConvenienceHandler(apiServer, "/follow-ups"))
+	mux.Handle("/search", createConvenienceHandler(apiServer, "/search"))
+	mux.Handle("/trash", createConvenienceHandler(apiServer, "/trash"))
+	mux.Handle("/audit", createConvenienceHandler(apiServer, "/audit"))
 
 	// Static file serving for development
 	mux.Handle("/static/", http.StripPrefix("/static/", http.FileServer(http.Dir("static"))))
@@ -159,8 +188,9 @@ func createConvenienceHandler(apiServer *api.Server, prefix string) http.Handler
 }
 
 // createActionHandler handles action routes and serves a dedicated edit page
-// while forwarding other requests to the API server.
-func createActionHandler(apiServer *api.Server, actionHandler *handlers.ActionHandler) http.HandlerFunc {
+// while forwarding other requests to the API server. The edit page reads the
+// action without going through apiServer, so it records the read itself.
+func createActionHandler(apiServer *api.Server, actionHandler *handlers.ActionHandler, auditLog *audit.Log) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		if r.Method == http.MethodGet {
 			switch {
@@ -168,6 +198,7 @@ func createActionHandler(apiServer *api.Server, actionHandler *handlers.ActionHa
 				id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/actions/"), "/edit")
 				params := api.GetActionByIdParams{ID: id}
 				res, err := actionHandler.GetActionById(r.Context(), params)
+				auditLog.RecordCall(r, "GetActionById", map[string]any{"id": id}, res, err)
 				if err != nil {
 					log.Printf("Error getting action: %v", err)
 					http.Error(w, "Internal Server Error", http.StatusInternalServerError)
@@ -180,8 +211,9 @@ func createActionHandler(apiServer *api.Server, actionHandler *handlers.ActionHa
 						PersonID:    action.PersonID,
 						OccurredAt:  action.OccurredAt,
 						Description: action.Description,
-						References:  action.References.Or(""),
+						References:  handlers.ToTemplateReferences(action.References),
 						Valence:     string(action.Valence),
+						Impact:      string(action.Impact.Value),
 						CreatedAt:   action.CreatedAt,
 						UpdatedAt:   action.UpdatedAt,
 					}
@@ -214,17 +246,53 @@ func createActionHandler(apiServer *api.Server, actionHandler *handlers.ActionHa
 	}
 }
 
-func createConversationHandler(apiServer *api.Server, personHandler *handlers.PersonHandler) http.HandlerFunc {
+// createConversationHandler handles conversation routes, serving the record and
+// edit pages and forwarding other requests to the API server. Like the action
+// edit page, both pages audit the reads they make directly.
+func createConversationHandler(apiServer *api.Server, personHandler *handlers.PersonHandler, conversationHandler *handlers.ConversationHandler, auditLog *audit.Log) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet && strings.HasSuffix(r.URL.Path, "/edit") {
+			id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/conversations/"), "/edit")
+			res, err := conversationHandler.GetConversationById(r.Context(), api.GetConversationByIdParams{ID: id})
+			auditLog.RecordCall(r, "GetConversationById", map[string]any{"id": id}, res, err)
+			if err != nil {
+				log.Printf("Error getting conversation: %v", err)
+				http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+				return
+			}
+			switch conv := res.(type) {
+			case *api.Conversation:
+				tmplConv := templates.Conversation{
+					ID:          conv.ID,
+					PersonID:    conv.PersonID,
+					OccurredAt:  conv.OccurredAt,
+					Description: conv.Description,
+					CreatedAt:   conv.CreatedAt,
+					UpdatedAt:   conv.UpdatedAt,
+				}
+				w.Header().Set("Content-Type", "text/html")
+				w.WriteHeader(http.StatusOK)
+				if err := templates.EditConversationPage(tmplConv).Render(r.Context(), w); err != nil {
+					log.Printf("Error rendering template: %v", err)
+				}
+				return
+			case *api.GetConversationByIdNotFound:
+				http.NotFound(w, r)
+				return
+			default:
+				http.Error(w, "Internal Server Error", http.StatusInternalServerError)
+				return
+			}
+		}
 		if r.Method == http.MethodGet && r.URL.Path == "/conversations/new" {
 			personID := r.URL.Query().Get("person_id")
 			personName := ""
 			if personID != "" {
 				params := api.GetPersonByIdParams{ID: personID}
-				if res, err := personHandler.GetPersonById(r.Context(), params); err == nil {
-					if p, ok := res.(*api.Person); ok {
-						personName = p.Name
-					}
+				res, err := personHandler.GetPersonById(r.Context(), params)
+				auditLog.RecordCall(r, "GetPersonById", map[string]any{"id": personID}, res, err)
+				if p, ok := res.(*api.Person); ok && err == nil {
+					personName = p.Name
 				}
 			}
 			w.Header().Set("Content-Type", "text/html")
@@ -53,7 +53,7 @@ func Index() templ.Component {
 					}()
 				}
 				ctx = templ.InitializeContext(ctx)
-				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<div class=\"mb-4 flex space-x-4\"><a href=\"/actions/new\" class=\"bg-blue-500 hover:bg-blue-600 text-white px-4 py-2 rounded\">Add Action</a> <a href=\"/conversations/new\" class=\"bg-green-500 hover:bg-green-600 text-white px-4 py-2 rounded\">Add Conversation</a></div><div id=\"people-table\" hx-get=\"/api/v1/people\" hx-trigger=\"load\"><p class=\"text-gray-500\">Loading...</p></div>")
+				templ_7745c5c3_Err = templruntime.WriteString(templ_7745c5c3_Buffer, 1, "<div class=\"mb-4 flex space-x-4\"><a href=\"/actions/new\" class=\"bg-blue-500 hover:bg-blue-600 text-white px-4 py-2 rounded\">Add Action</a> <a href=\"/conversations/new\" class=\"bg-green-500 hover:bg-green-600 text-white px-4 py-2 rounded\">Add Conversation</a> <a href=\"/org\" class=\"bg-gray-500 hover:bg-gray-600 text-white px-4 py-2 rounded\">Org Chart</a></div><div class=\"mb-2 flex space-x-4 text-sm\"><button hx-get=\"/api/v1/people\" hx-target=\"#people-table\" class=\"text-blue-600 hover:underline\">Everyone</button> <button hx-get=\"/api/v1/people?overdue=true\" hx-target=\"#people-table\" class=\"text-red-600 hover:underline\">Overdue 1:1s</button></div><div id=\"people-table\" hx-get=\"/api/v1/people\" hx-trigger=\"load\"><p class=\"text-gray-500\">Loading...</p></div><div class=\"bg-white rounded-lg shadow p-6 mt-6\"><h2 class=\"text-xl font-semibold text-gray-900 mb-4\">Open Follow-ups</h2><div hx-get=\"/api/v1/follow-ups?status=open&limit=100\" hx-trigger=\"load\"><p class=\"text-gray-500\">Loading...</p></div></div>")
 				if templ_7745c5c3_Err != nil {
 					return templ_7745c5c3_Err
 				}
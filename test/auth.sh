@@ -0,0 +1,14 @@
+#!/bin/bash
+
+# Sourced by the test scripts. Every route except /health needs a signed-in
+# manager, so each curl call sends the API token in PEPO_API_TOKEN. Create a
+# read-write token at /settings/tokens on the server the script talks to.
+
+if [ -z "$PEPO_API_TOKEN" ]; then
+    echo "PEPO_API_TOKEN must be set to a read-write API token (create one at /settings/tokens)" >&2
+    exit 1
+fi
+
+curl() {
+    command curl -H "Authorization: Bearer $PEPO_API_TOKEN" "$@"
+}
@@ -12,6 +12,9 @@ NC='\033[0m' # No Color
 BASE_URL="http://localhost:8081"
 API_URL="${BASE_URL}/api/v1"
 
+# Authenticate every request with PEPO_API_TOKEN
+source "$(dirname "$0")/auth.sh"
+
 # Function to print colored output
 print_status() {
     echo -e "${GREEN}[INFO]${NC} $1"
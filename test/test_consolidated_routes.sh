@@ -10,6 +10,9 @@ BASE_URL="http://localhost:8000"
 API_BASE_URL="$BASE_URL/api/v1"
 CONVENIENCE_BASE_URL="$BASE_URL"
 
+# Authenticate every request with PEPO_API_TOKEN
+source "$(dirname "$0")/auth.sh"
+
 # Colors for output
 RED='\033[0;31m'
 GREEN='\033[0;32m'
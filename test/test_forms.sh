@@ -6,6 +6,9 @@ set -e
 BASE_URL="http://localhost:8080"
 FORMS_URL="$BASE_URL/forms"
 
+# Authenticate every request with PEPO_API_TOKEN
+source "$(dirname "$0")/auth.sh"
+
 # Colors for output
 RED='\033[0;31m'
 GREEN='\033[0;32m'
@@ -9,6 +9,9 @@ set -e  # Exit on any error
 BASE_URL="http://localhost:8000"
 API_BASE_URL="$BASE_URL/api/v1"
 
+# Authenticate every request with PEPO_API_TOKEN
+source "$(dirname "$0")/auth.sh"
+
 # Colors for output
 RED='\033[0;31m'
 GREEN='\033[0;32m'
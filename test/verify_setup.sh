@@ -302,22 +302,27 @@ verify_api_functionality() {
         return 1
     fi
 
-    # Test API endpoint
-    if curl -s -f "http://localhost:8080/api/v1/persons" >/dev/null; then
-        print_success "API endpoint responds"
+    # The API needs a signed-in manager, so the rest is only checked with a token
+    if [ -z "$PEPO_API_TOKEN" ]; then
+        print_warning "PEPO_API_TOKEN not set; skipping authenticated API checks"
     else
-        print_error "API endpoint failed"
-        kill $SERVER_PID 2>/dev/null || true
-        return 1
-    fi
+        # Test API endpoint
+        if curl -s -f -H "Authorization: Bearer $PEPO_API_TOKEN" "http://localhost:8080/api/v1/persons" >/dev/null; then
+            print_success "API endpoint responds"
+        else
+            print_error "API endpoint failed"
+            kill $SERVER_PID 2>/dev/null || true
+            return 1
+        fi
 
-    # Test XID generation
-    if curl -s -f "http://localhost:8080/api/v1/demo/xid" >/dev/null; then
-        print_success "XID generation works"
-    else
-        print_error "XID generation failed"
-        kill $SERVER_PID 2>/dev/null || true
-        return 1
+        # Test XID generation
+        if curl -s -f -H "Authorization: Bearer $PEPO_API_TOKEN" "http://localhost:8080/api/v1/demo/xid" >/dev/null; then
+            print_success "XID generation works"
+        else
+            print_error "XID generation failed"
+            kill $SERVER_PID 2>/dev/null || true
+            return 1
+        fi
     fi
 
     # Stop server